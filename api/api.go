@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/hashing/factory"
 	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
@@ -14,7 +15,6 @@ import (
 	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
-	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -23,37 +23,30 @@ import (
 
 var log = logger.GetOrCreate("api")
 
+const (
+	// BasicAuthentication authenticates the requests towards the secured routes by the credentials from the
+	// credentials file
+	BasicAuthentication = "basic"
+
+	// JwtAuthentication authenticates the requests towards the secured routes by JWT bearer tokens
+	JwtAuthentication = "jwt"
+)
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
 }
 
-// CreateServer creates a HTTP server
-func CreateServer(
-	versionsRegistry data.VersionsRegistryHandler,
-	port int,
-	apiLoggingConfig config.ApiLoggingConfig,
-	credentialsConfig config.CredentialsConfig,
-	statusMetricsExtractor middleware.StatusMetricsExtractor,
-	rateLimitTimeWindowInSeconds int,
-	isProfileModeActivated bool,
-) (*http.Server, error) {
-	ws := gin.Default()
-	ws.Use(cors.Default())
-
+// CreateServer creates a HTTP server which serves the requests with the provided handler
+func CreateServer(port int, handler http.Handler) (*http.Server, error) {
 	err := registerValidators()
 	if err != nil {
 		return nil, err
 	}
 
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated)
-	if err != nil {
-		return nil, err
-	}
-
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
-		Handler: ws,
+		Handler: handler,
 	}
 
 	return httpServer, nil
@@ -76,16 +69,21 @@ func registerValidators() error {
 
 func registerRoutes(
 	ws *gin.Engine,
-	versionsRegistry data.VersionsRegistryHandler,
+	versionsMap map[string]*data.VersionData,
 	apiLoggingConfig config.ApiLoggingConfig,
 	credentialsConfig config.CredentialsConfig,
+	tokenValidator middleware.TokenValidatorHandler,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
 	rateLimitTimeWindowInSeconds int,
+	rateLimiterConfig config.RateLimiterConfig,
+	apiKeysConfig config.ApiKeysConfig,
+	apiKeysRegistry middleware.ApiKeysRegistryHandler,
+	stateStore middleware.StateStoreHandler,
+	sessionAffinityConfig config.SessionAffinityConfig,
 	isProfileModeActivated bool,
 ) error {
-	versionsMap, err := versionsRegistry.GetAllVersions()
-	if err != nil {
-		return err
+	if rateLimitTimeWindowInSeconds <= 0 {
+		return fmt.Errorf("%w: %d seconds", ErrInvalidRateLimitWindowDuration, rateLimitTimeWindowInSeconds)
 	}
 
 	if apiLoggingConfig.LoggingEnabled {
@@ -93,6 +91,14 @@ func registerRoutes(
 		ws.Use(responseLoggerMiddleware.MiddlewareHandlerFunc())
 	}
 
+	if sessionAffinityConfig.Enabled && len(sessionAffinityConfig.SessionHeader) > 0 {
+		sessionMiddleware, err := middleware.NewSessionMiddleware(sessionAffinityConfig.SessionHeader)
+		if err != nil {
+			return err
+		}
+		ws.Use(sessionMiddleware.MiddlewareHandlerFunc())
+	}
+
 	// TODO: maybe add a flag when starting proxy if metrics should be exposed or not
 	metricsMiddleware, err := middleware.NewMetricsMiddleware(statusMetricsExtractor)
 	if err != nil {
@@ -100,20 +106,47 @@ func registerRoutes(
 	}
 
 	for version, versionData := range versionsMap {
-		limitsMap := getLimitsMapForVersion(versionData)
 		rateLimitTimeWindowDuration := time.Duration(rateLimitTimeWindowInSeconds) * time.Second
-		rateLimiter, err := middleware.NewRateLimiter(limitsMap, rateLimitTimeWindowDuration)
+		rateLimiter, err := middleware.NewRateLimiter(middleware.ArgsRateLimiter{
+			Limits:       getLimitsMapForVersion(versionData, rateLimitTimeWindowDuration),
+			LimitBy:      rateLimiterConfig.LimitBy,
+			ApiKeyHeader: rateLimiterConfig.ApiKeyHeader,
+			StateStore:   stateStore,
+		})
 		if err != nil {
 			return err
 		}
-		startRateLimiterReset(rateLimitTimeWindowInSeconds, rateLimiter, version)
 		versionGroup := ws.Group(version)
+		if apiKeysConfig.Enabled {
+			apiKeysMiddleware, errCreate := middleware.NewApiKeysMiddleware(middleware.ArgsApiKeysMiddleware{
+				Registry:               apiKeysRegistry,
+				StatusMetricsExtractor: statusMetricsExtractor,
+				StateStore:             stateStore,
+				Header:                 apiKeysConfig.Header,
+				IsApiKeyRequired:       apiKeysConfig.IsApiKeyRequired,
+				RoutePrefix:            versionGroup.BasePath(),
+			})
+			if errCreate != nil {
+				return errCreate
+			}
+			versionGroup.Use(apiKeysMiddleware.MiddlewareHandlerFunc())
+		}
+
+		authenticationFunc, err := createAuthenticationFunc(
+			versionData.ApiConfig.AuthenticationType,
+			credentialsConfig,
+			tokenValidator,
+			versionGroup.BasePath(),
+		)
+		if err != nil {
+			return fmt.Errorf("%w for version %s", err, version)
+		}
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
 			subGroup := versionGroup.Group(path)
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
-				getAuthenticationFunc(credentialsConfig),
+				authenticationFunc,
 				rateLimiter.MiddlewareHandlerFunc(),
 				metricsMiddleware.MiddlewareHandlerFunc(),
 			)
@@ -127,6 +160,32 @@ func registerRoutes(
 	return nil
 }
 
+// createAuthenticationFunc returns the authenticator of the secured routes selected in the API config of a version
+func createAuthenticationFunc(
+	authenticationType string,
+	credentialsConfig config.CredentialsConfig,
+	tokenValidator middleware.TokenValidatorHandler,
+	routePrefix string,
+) (gin.HandlerFunc, error) {
+	switch authenticationType {
+	case "", BasicAuthentication:
+		return getAuthenticationFunc(credentialsConfig), nil
+	case JwtAuthentication:
+		if check.IfNil(tokenValidator) {
+			return nil, ErrJwtAuthenticationNotEnabled
+		}
+
+		jwtAuthenticator, err := middleware.NewJwtAuthenticator(tokenValidator, routePrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		return jwtAuthenticator.MiddlewareHandlerFunc(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAuthenticationType, authenticationType)
+	}
+}
+
 func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
 	if len(credentialsConfig.Credentials) == 0 {
 		return func(c *gin.Context) {
@@ -183,34 +242,40 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 			})
 			return
 		}
+
+		c.Set(gin.AuthUserKey, user)
 	}
 
 	return authenticationFunction
 }
 
-func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
-	limitsMap := make(map[string]uint64)
+// getLimitsMapForVersion returns the limits of each route. A route which only has the RateLimit set can make RateLimit
+// requests in a sliding window of the provided duration
+func getLimitsMapForVersion(versionData *data.VersionData, rateLimitTimeWindow time.Duration) map[string]middleware.RouteLimits {
+	limitsMap := make(map[string]middleware.RouteLimits)
 	for packageName, packageConfig := range versionData.ApiConfig.APIPackages {
 		for _, routeConfig := range packageConfig.Routes {
-			if routeConfig.RateLimit > 0 {
-				mapKey := fmt.Sprintf("/%s%s", packageName, routeConfig.Name)
-				limitsMap[mapKey] = routeConfig.RateLimit
+			limits := middleware.RouteLimits{
+				Burst:                 routeConfig.Burst,
+				RatePerSecond:         routeConfig.RatePerSecond,
+				MaxConcurrentRequests: routeConfig.MaxConcurrentRequests,
+			}
+			if limits.Burst == 0 && routeConfig.RateLimit > 0 {
+				limits.Burst = routeConfig.RateLimit
+				limits.RatePerSecond = float64(routeConfig.RateLimit) / rateLimitTimeWindow.Seconds()
 			}
+			if limits.Burst == 0 && limits.MaxConcurrentRequests == 0 {
+				continue
+			}
+
+			mapKey := fmt.Sprintf("/%s%s", packageName, routeConfig.Name)
+			limitsMap[mapKey] = limits
 		}
 	}
 
 	return limitsMap
 }
 
-func startRateLimiterReset(rateLimiterDuration int, rl middleware.RateLimiterHandler, version string) {
-	go func() {
-		for {
-			time.Sleep(time.Duration(rateLimiterDuration) * time.Second)
-			rl.ResetMap(version)
-		}
-	}()
-}
-
 // skValidator validates a secret key from user input for correctness
 func skValidator(
 	_ *validator.Validate,
@@ -13,3 +13,21 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrInvalidAuthenticationType signals that an unknown authentication type has been provided in an API config
+var ErrInvalidAuthenticationType = errors.New("invalid authentication type")
+
+// ErrJwtAuthenticationNotEnabled signals that an API config uses the JWT authentication, which is not enabled
+var ErrJwtAuthenticationNotEnabled = errors.New("JWT authentication is not enabled in config.toml")
+
+// ErrInvalidRateLimitWindowDuration signals that an invalid rate limit window duration has been provided
+var ErrInvalidRateLimitWindowDuration = errors.New("invalid RateLimitWindowDurationSeconds, it must be greater than zero")
+
+// ErrNilVersionsRegistry signals that a nil versions registry has been provided
+var ErrNilVersionsRegistry = errors.New("nil versions registry")
+
+// ErrNilApiConfigParser signals that a nil API config parser has been provided
+var ErrNilApiConfigParser = errors.New("nil API config parser")
+
+// ErrNilConfig signals that a nil config has been provided
+var ErrNilConfig = errors.New("nil config")
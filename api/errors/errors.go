@@ -68,6 +68,27 @@ var ErrValidatorQueryParameterCheckSignature = errors.New("invalid query paramet
 // ErrInvalidSignatureHex signals a wrong hex value was provided for the signature
 var ErrInvalidSignatureHex = errors.New("invalid signature, could not decode hex value")
 
+// ErrInvalidChainID signals that the chain ID of a transaction does not match the network's chain ID
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidTransactionVersion signals that the version of a transaction is below the network's minimum version
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInsufficientGasPrice signals that the gas price of a transaction is below the network's minimum gas price
+var ErrInsufficientGasPrice = errors.New("insufficient gas price")
+
+// ErrInsufficientGasLimit signals that the gas limit of a transaction is below the minimum needed for its data field
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
+
+// ErrDataFieldTooLarge signals that the data field of a transaction exceeds the maximum size accepted by the nodes
+var ErrDataFieldTooLarge = errors.New("data field too large")
+
+// ErrInvalidTransactionValue signals that the value of a transaction is not a non-negative number
+var ErrInvalidTransactionValue = errors.New("invalid transaction value")
+
+// ErrInvalidSignature signals that the signature of a transaction is not valid for its sender and payload
+var ErrInvalidSignature = errors.New("invalid signature")
+
 // ErrTxGenerationFailed signals an error generating a transaction
 var ErrTxGenerationFailed = errors.New("transaction generation failed")
 
@@ -132,3 +153,27 @@ type ErrInvalidTxFields struct {
 func (eitx *ErrInvalidTxFields) Error() string {
 	return fmt.Sprintf("%s : %s", eitx.Message, eitx.Reason)
 }
+
+// ErrTooManyHyperblocksSubscribers signals that the maximum number of hyperblocks subscribers has been reached
+var ErrTooManyHyperblocksSubscribers = errors.New("too many hyperblocks subscribers")
+
+// ErrResumeNonceTooOld signals that a hyperblocks subscription cannot be resumed from a nonce so far behind
+var ErrResumeNonceTooOld = errors.New("the hyperblocks subscription cannot be resumed from the provided nonce")
+
+// ErrHyperblocksSubscriptionsNotEnabled signals that the hyperblocks subscriptions are not enabled
+var ErrHyperblocksSubscriptionsNotEnabled = errors.New("hyperblocks subscriptions are not enabled")
+
+// ErrTooManyTrackedTransactions signals that the maximum number of transactions waited for has been reached
+var ErrTooManyTrackedTransactions = errors.New("too many transactions waited for")
+
+// ErrTransactionsTrackerNotEnabled signals that waiting for the transactions to complete is not enabled
+var ErrTransactionsTrackerNotEnabled = errors.New("waiting for transactions completion is not enabled")
+
+// ErrTooManyNonceReservations signals that the maximum number of nonces held for a sender has been reached
+var ErrTooManyNonceReservations = errors.New("too many nonce reservations for the sender")
+
+// ErrTooManyNonceReservationSenders signals that the maximum number of senders with nonce reservations has been reached
+var ErrTooManyNonceReservationSenders = errors.New("too many senders with nonce reservations")
+
+// ErrNonceReservationsNotEnabled signals that the nonce reservations are not enabled
+var ErrNonceReservationsNotEnabled = errors.New("nonce reservations are not enabled")
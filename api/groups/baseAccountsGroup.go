@@ -32,6 +32,7 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/balance", Handler: ag.getBalance, Method: http.MethodGet},
 		{Path: "/:address/username", Handler: ag.getUsername, Method: http.MethodGet},
 		{Path: "/:address/nonce", Handler: ag.getNonce, Method: http.MethodGet},
+		{Path: "/:address/next-nonce", Handler: ag.reserveNextNonce, Method: http.MethodPost},
 		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet},
 		{Path: "/:address/transactions", Handler: ag.getTransactions, Method: http.MethodGet},
 		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet},
@@ -57,7 +58,7 @@ func (group *accountsGroup) respondWithAccount(c *gin.Context, transform func(*d
 		return
 	}
 
-	model, err := group.facade.GetAccount(address, options)
+	model, err := group.facade.GetAccount(c.Request.Context(), address, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
 		return
@@ -106,6 +107,31 @@ func (group *accountsGroup) getNonce(c *gin.Context) {
 	})
 }
 
+// reserveNextNonce reserves the next nonce for the address parameter, so the concurrent clients of the same sender get
+// different nonces
+func (group *accountsGroup) reserveNextNonce(c *gin.Context) {
+	address := c.Param("address")
+
+	reservation, err := group.facade.ReserveNonce(c.Request.Context(), address)
+	if err != nil {
+		shared.RespondWith(c, getNonceReservationErrorStatusCode(err), nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"reservation": reservation}, "", data.ReturnCodeSuccess)
+}
+
+func getNonceReservationErrorStatusCode(err error) int {
+	switch err {
+	case errors.ErrTooManyNonceReservations:
+		return http.StatusTooManyRequests
+	case errors.ErrTooManyNonceReservationSenders, errors.ErrNonceReservationsNotEnabled:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // getTransactions returns the transactions for the address parameter
 func (group *accountsGroup) getTransactions(c *gin.Context) {
 	transactions, status, err := group.getTransactionsFromFacade(c)
@@ -131,7 +157,7 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	keyValuePairs, err := group.facade.GetKeyValuePairs(addr, options)
+	keyValuePairs, err := group.facade.GetKeyValuePairs(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
 		return
@@ -160,7 +186,7 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := group.facade.GetValueForKey(addr, key, options)
+	value, err := group.facade.GetValueForKey(c.Request.Context(), addr, key, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetValueForKey, err)
 		return
@@ -212,7 +238,7 @@ func (group *accountsGroup) getESDTTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTTokenData(addr, tokenIdentifier, options)
+	esdtTokenResponse, err := group.facade.GetESDTTokenData(c.Request.Context(), addr, tokenIdentifier, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrEmptyTokenIdentifier, err)
 	}
@@ -233,7 +259,7 @@ func (group *accountsGroup) getESDTsRoles(c *gin.Context) {
 		return
 	}
 
-	tokensRoles, err := group.facade.GetESDTsRoles(addr, options)
+	tokensRoles, err := group.facade.GetESDTsRoles(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrEmptyTokenIdentifier, err)
 		return
@@ -262,7 +288,7 @@ func (group *accountsGroup) getESDTsWithRole(c *gin.Context) {
 		return
 	}
 
-	esdtsWithRole, err := group.facade.GetESDTsWithRole(addr, role, options)
+	esdtsWithRole, err := group.facade.GetESDTsWithRole(c.Request.Context(), addr, role, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTsWithRole, err)
 		return
@@ -285,7 +311,7 @@ func (group *accountsGroup) getRegisteredNFTs(c *gin.Context) {
 		return
 	}
 
-	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(addr, options)
+	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetNFTTokenIDsRegisteredByAddress, err)
 		return
@@ -320,7 +346,7 @@ func (group *accountsGroup) getESDTNftTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(addr, tokenIdentifier, nonce, options)
+	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(c.Request.Context(), addr, tokenIdentifier, nonce, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -342,7 +368,7 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
 		return
 	}
-	tokens, err := group.facade.GetAllESDTTokens(addr, options)
+	tokens, err := group.facade.GetAllESDTTokens(c.Request.Context(), addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
 		return
@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -137,6 +138,15 @@ type nonceResponse struct {
 	Data nonceResponseData
 }
 
+type nonceReservationResponseData struct {
+	Reservation data.NonceReservation `json:"reservation"`
+}
+
+type nonceReservationResponse struct {
+	GeneralResponse
+	Data nonceReservationResponseData
+}
+
 func TestNewAccountGroup_WrongFacadeShouldErr(t *testing.T) {
 	wrongFacade := &mock.WrongFacade{}
 	group, err := groups.NewAccountsGroup(wrongFacade)
@@ -320,6 +330,70 @@ func TestGetNonce_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, nonceResponse.Error)
 }
 
+// ---- ReserveNextNonce
+
+func TestReserveNextNonce_FailWhenFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	testReserveNextNonceError(t, errors.New("cannot get account"), http.StatusInternalServerError)
+	testReserveNextNonceError(t, apiErrors.ErrTooManyNonceReservations, http.StatusTooManyRequests)
+	testReserveNextNonceError(t, apiErrors.ErrTooManyNonceReservationSenders, http.StatusServiceUnavailable)
+	testReserveNextNonceError(t, apiErrors.ErrNonceReservationsNotEnabled, http.StatusServiceUnavailable)
+}
+
+func testReserveNextNonceError(t *testing.T, expectedErr error, expectedStatusCode int) {
+	facade := &mock.Facade{
+		ReserveNonceCalled: func(_ context.Context, _ string) (*data.NonceReservation, error) {
+			return nil, expectedErr
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("POST", "/address/test/next-nonce", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	reservationResponse := nonceReservationResponse{}
+	loadResponse(resp.Body, &reservationResponse)
+
+	assert.Equal(t, expectedStatusCode, resp.Code)
+	assert.Equal(t, expectedErr.Error(), reservationResponse.Error)
+}
+
+func TestReserveNextNonce_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedReservation := data.NonceReservation{
+		Nonce:        7,
+		FillsGap:     true,
+		AccountNonce: 5,
+		NonceGaps:    []data.NonceGap{{From: 7, To: 8}},
+		ExpiresAt:    1000,
+	}
+	facade := &mock.Facade{
+		ReserveNonceCalled: func(_ context.Context, address string) (*data.NonceReservation, error) {
+			assert.Equal(t, "test", address)
+			return &expectedReservation, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("POST", "/address/test/next-nonce", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	reservationResponse := nonceReservationResponse{}
+	loadResponse(resp.Body, &reservationResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedReservation, reservationResponse.Data.Reservation)
+	assert.Empty(t, reservationResponse.Error)
+}
+
 // ---- GetShard
 
 func TestGetShard_FailWhenFacadeErrors(t *testing.T) {
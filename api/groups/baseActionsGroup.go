@@ -28,6 +28,7 @@ func NewActionsGroup(facadeHandler data.FacadeHandler) (*actionsGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/reload-observers", Handler: ng.updateObservers, Method: http.MethodPost},
 		{Path: "/reload-full-history-observers", Handler: ng.updateFullHistoryObservers, Method: http.MethodPost},
+		{Path: "/reload-config", Handler: ng.reloadConfig, Method: http.MethodPost},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -44,6 +45,11 @@ func (group *actionsGroup) updateFullHistoryObservers(c *gin.Context) {
 	group.handleUpdateResponding(result, c)
 }
 
+func (group *actionsGroup) reloadConfig(c *gin.Context) {
+	result := group.facade.ReloadConfig()
+	group.handleUpdateResponding(result, c)
+}
+
 func (group *actionsGroup) handleUpdateResponding(result data.NodesReloadResponse, c *gin.Context) {
 	if result.Error != "" {
 		httpCode := http.StatusInternalServerError
@@ -205,3 +205,62 @@ func TestActions_ReloadFullHistoryObserversShouldWork(t *testing.T) {
 	assert.Equal(t, description, response.Data.(string))
 	assert.Equal(t, "", response.Error)
 }
+
+func TestActions_ReloadConfigFailWithBadRequest(t *testing.T) {
+	t.Parallel()
+
+	expectedErrMsg := "invalid cache validity duration for heartbeats"
+	facade := &mock.Facade{
+		ReloadConfigCalled: func() data.NodesReloadResponse {
+			return data.NodesReloadResponse{
+				OkRequest:   false,
+				Error:       expectedErrMsg,
+				Description: "not reloaded",
+			}
+		},
+	}
+
+	actionsGroup, err := groups.NewActionsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(actionsGroup, actionsPath)
+
+	req, _ := http.NewRequest("POST", "/actions/reload-config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	response := &data.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, "not reloaded", response.Data.(string))
+	assert.Equal(t, expectedErrMsg, response.Error)
+}
+
+func TestActions_ReloadConfigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ReloadConfigCalled: func() data.NodesReloadResponse {
+			return data.NodesReloadResponse{
+				OkRequest:   true,
+				Error:       "",
+				Description: "configs reloaded",
+			}
+		},
+	}
+
+	actionsGroup, err := groups.NewActionsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(actionsGroup, actionsPath)
+
+	req, _ := http.NewRequest("POST", "/actions/reload-config", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	response := &data.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, "configs reloaded", response.Data.(string))
+	assert.Equal(t, "", response.Error)
+}
@@ -1,16 +1,25 @@
 package groups
 
 import (
+	"context"
+	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/gin-gonic/gin"
 )
 
 var log = logger.GetOrCreate("api/groups")
 
+// idempotentPostGroups holds the groups whose POST endpoints do not alter any state
+var idempotentPostGroups = map[string]struct{}{
+	"vm-values": {},
+}
+
 type baseGroup struct {
 	endpoints []*data.EndpointHandlerData
 	sync.RWMutex
@@ -20,7 +29,10 @@ type endpointProperties struct {
 	isOpen           bool
 	isSecured        bool
 	isFoundInConfig  bool
-	rateLimiterPerIP uint64
+	isRateLimited    bool
+	timeout          time.Duration
+	isHedgingEnabled bool
+	groupName        string
 }
 
 // AddEndpoint will add the handler data for the given path inside the map
@@ -103,11 +115,23 @@ func (bg *baseGroup) RegisterRoutes(
 		}
 
 		middlewares := make([]gin.HandlerFunc, 0)
+		if properties.timeout > 0 {
+			middlewares = append(middlewares, requestDeadlineHandler(properties.timeout))
+		}
+
+		if properties.isHedgingEnabled {
+			if isHedgingAllowedForEndpoint(properties.groupName, handlerData.Method) {
+				middlewares = append(middlewares, hedgingHandler)
+			} else {
+				log.Warn("hedging is not allowed for non-idempotent endpoints", "path", handlerData.Path)
+			}
+		}
+
 		if properties.isSecured {
 			middlewares = append(middlewares, authenticationFunc)
 		}
 
-		if properties.rateLimiterPerIP > 0 {
+		if properties.isRateLimited {
 			middlewares = append(middlewares, rateLimiter)
 		}
 
@@ -138,7 +162,10 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiR
 				isOpen:           route.Open,
 				isSecured:        route.Secured,
 				isFoundInConfig:  true,
-				rateLimiterPerIP: route.RateLimit,
+				isRateLimited:    route.RateLimit > 0 || route.Burst > 0 || route.MaxConcurrentRequests > 0,
+				timeout:          time.Duration(route.TimeoutSec) * time.Second,
+				isHedgingEnabled: route.Hedging,
+				groupName:        basePath,
 			}
 		}
 	}
@@ -148,6 +175,36 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiR
 	}
 }
 
+// requestDeadlineHandler returns a handler that bounds the request's context to the provided timeout, so all the
+// requests towards the observers made on its behalf will be aborted once the deadline is reached
+func requestDeadlineHandler(timeout time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// hedgingHandler marks the request as idempotent, so the requests towards the observers made on its behalf can be hedged
+func hedgingHandler(c *gin.Context) {
+	c.Request = c.Request.WithContext(common.WithHedgingAllowed(c.Request.Context()))
+	c.Next()
+}
+
+// isHedgingAllowedForEndpoint returns true for the endpoints that only read data: the GET endpoints and the smart
+// contract queries. The other POST endpoints (such as the transactions sending) must never be hedged
+func isHedgingAllowedForEndpoint(groupName string, method string) bool {
+	if method == http.MethodGet {
+		return true
+	}
+
+	_, isIdempotentGroup := idempotentPostGroups[groupName]
+
+	return isIdempotentGroup
+}
+
 func (bg *baseGroup) isEndpointRegistered(endpoint string) bool {
 	bg.RLock()
 	defer bg.RUnlock()
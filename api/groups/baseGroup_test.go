@@ -1,8 +1,12 @@
 package groups
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -74,3 +78,115 @@ func TestCrudOperationsBaseGroup(t *testing.T) {
 	assert.Equal(t, hd1.Path, bg.endpoints[1].Path)
 	assert.Equal(t, hd4.Path, bg.endpoints[2].Path)
 }
+
+func TestBaseGroup_RegisterRoutesShouldApplyConfiguredTimeout(t *testing.T) {
+	t.Parallel()
+
+	hasDeadline := make(map[string]bool)
+	ginHandler := func(c *gin.Context) {
+		_, hasDeadline[c.FullPath()] = c.Request.Context().Deadline()
+	}
+	bg := &baseGroup{
+		endpoints: []*data.EndpointHandlerData{
+			{Path: "/with-timeout", Handler: ginHandler, Method: http.MethodGet},
+			{Path: "/without-timeout", Handler: ginHandler, Method: http.MethodGet},
+		},
+	}
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"group": {
+				Routes: []data.RouteConfig{
+					{Name: "/with-timeout", Open: true, TimeoutSec: 5},
+					{Name: "/without-timeout", Open: true},
+				},
+			},
+		},
+	}
+
+	ws := gin.New()
+	emptyHandler := func(c *gin.Context) {}
+	bg.RegisterRoutes(ws.Group("/group"), apiConfig, emptyHandler, emptyHandler, emptyHandler)
+
+	for _, path := range []string{"/group/with-timeout", "/group/without-timeout"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+	}
+
+	assert.True(t, hasDeadline["/group/with-timeout"])
+	assert.False(t, hasDeadline["/group/without-timeout"])
+}
+
+func TestRequestDeadlineHandler_ShouldSetDeadline(t *testing.T) {
+	t.Parallel()
+
+	var deadline time.Time
+	ws := gin.New()
+	ws.GET("/path", requestDeadlineHandler(time.Minute), func(c *gin.Context) {
+		deadline, _ = c.Request.Context().Deadline()
+	})
+
+	startTime := time.Now()
+	req, _ := http.NewRequest(http.MethodGet, "/path", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.True(t, deadline.After(startTime))
+	assert.True(t, deadline.Before(startTime.Add(time.Minute+time.Second)))
+}
+
+func TestBaseGroup_RegisterRoutesShouldAllowHedgingOnlyForIdempotentEndpoints(t *testing.T) {
+	t.Parallel()
+
+	isHedgingAllowed := make(map[string]bool)
+	ginHandler := func(c *gin.Context) {
+		isHedgingAllowed[c.FullPath()] = common.IsHedgingAllowed(c.Request.Context())
+	}
+	routes := []data.RouteConfig{
+		{Name: "/get", Open: true, Hedging: true},
+		{Name: "/send", Open: true, Hedging: true},
+		{Name: "/query", Open: true, Hedging: true},
+		{Name: "/get-not-hedged", Open: true},
+	}
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"transaction": {Routes: routes},
+			"vm-values":   {Routes: routes},
+		},
+	}
+
+	txGroup := &baseGroup{
+		endpoints: []*data.EndpointHandlerData{
+			{Path: "/get", Handler: ginHandler, Method: http.MethodGet},
+			{Path: "/send", Handler: ginHandler, Method: http.MethodPost},
+			{Path: "/get-not-hedged", Handler: ginHandler, Method: http.MethodGet},
+		},
+	}
+	vmValuesGroup := &baseGroup{
+		endpoints: []*data.EndpointHandlerData{
+			{Path: "/query", Handler: ginHandler, Method: http.MethodPost},
+		},
+	}
+
+	ws := gin.New()
+	emptyHandler := func(c *gin.Context) {}
+	txGroup.RegisterRoutes(ws.Group("/transaction"), apiConfig, emptyHandler, emptyHandler, emptyHandler)
+	vmValuesGroup.RegisterRoutes(ws.Group("/vm-values"), apiConfig, emptyHandler, emptyHandler, emptyHandler)
+
+	requests := map[string]string{
+		"/transaction/get":            http.MethodGet,
+		"/transaction/send":           http.MethodPost,
+		"/transaction/get-not-hedged": http.MethodGet,
+		"/vm-values/query":            http.MethodPost,
+	}
+	for path, method := range requests {
+		req, _ := http.NewRequest(method, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+	}
+
+	assert.True(t, isHedgingAllowed["/transaction/get"])
+	assert.False(t, isHedgingAllowed["/transaction/send"])
+	assert.False(t, isHedgingAllowed["/transaction/get-not-hedged"])
+	assert.True(t, isHedgingAllowed["/vm-values/query"])
+}
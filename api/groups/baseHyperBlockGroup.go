@@ -1,13 +1,31 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	lastEventIDHeader           = "Last-Event-ID"
+	hyperblockEventName         = "hyperblock"
+	subscriptionEndedEventName  = "end"
+	subscriptionKeepAlivePeriod = 30 * time.Second
+	webSocketWriteTimeout       = 10 * time.Second
 )
 
 type hyperBlockGroup struct {
@@ -30,6 +48,7 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
 		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/subscribe", Handler: hbg.hyperBlocksSubscriptionHandler, Method: http.MethodGet},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -51,7 +70,7 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash, options)
+	blockByHashResponse, err := group.facade.GetHyperBlockByHash(c.Request.Context(), hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -74,7 +93,7 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce, options)
+	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(c.Request.Context(), nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -82,3 +101,158 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
+
+// hyperBlocksSubscriptionHandler handles "subscribe" requests. The new fully synchronized hyperblocks are sent over a
+// WebSocket connection if the client asks for an upgrade, or as server-sent events otherwise
+func (group *hyperBlockGroup) hyperBlocksSubscriptionHandler(c *gin.Context) {
+	options, err := parseHyperblocksSubscriptionOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	isWebSocket := isWebSocketUpgrade(c.Request)
+	lastEventID := c.GetHeader(lastEventIDHeader)
+	if !isWebSocket && len(lastEventID) > 0 {
+		// the reconnecting event sources resume after the last received hyperblock, regardless of the original URL
+		lastNonce, errParse := strconv.ParseUint(lastEventID, 10, 64)
+		if errParse != nil {
+			shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, errParse)
+			return
+		}
+
+		options.FromNonce = core.OptionalUint64{Value: lastNonce + 1, HasValue: true}
+	}
+
+	subscription, err := group.facade.SubscribeToHyperblocks(c.Request.Context(), options)
+	if err != nil {
+		respondWithSubscriptionError(c, err)
+		return
+	}
+	defer subscription.Close()
+
+	if isWebSocket {
+		streamHyperblocksOverWebSocket(c, subscription)
+		return
+	}
+
+	streamHyperblocksAsServerSentEvents(c, subscription)
+}
+
+func isWebSocketUpgrade(request *http.Request) bool {
+	return strings.EqualFold(request.Header.Get("Upgrade"), "websocket")
+}
+
+func respondWithSubscriptionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, apiErrors.ErrResumeNonceTooOld):
+		shared.RespondWithBadRequest(c, err.Error())
+	case errors.Is(err, apiErrors.ErrTooManyHyperblocksSubscribers),
+		errors.Is(err, apiErrors.ErrHyperblocksSubscriptionsNotEnabled):
+		shared.RespondWith(c, http.StatusServiceUnavailable, nil, err.Error(), data.ReturnCodeInternalError)
+	default:
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+	}
+}
+
+func streamHyperblocksOverWebSocket(c *gin.Context, subscription data.HyperblocksSubscriptionHandler) {
+	server := websocket.Server{
+		// the origin is not checked, as the API allows all the origins
+		Handshake: func(_ *websocket.Config, _ *http.Request) error {
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				// the messages from the client are ignored, the read only detects the closed connections
+				_, _ = io.Copy(io.Discard, conn)
+				cancel()
+			}()
+
+			for {
+				select {
+				case hyperblock, ok := <-subscription.Hyperblocks():
+					if !ok {
+						_ = sendOverWebSocket(conn, newSubscriptionEndedResponse(subscription.Err()))
+						return
+					}
+
+					err := sendOverWebSocket(conn, data.NewHyperblockApiResponse(*hyperblock))
+					if err != nil {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		},
+	}
+
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+func sendOverWebSocket(conn *websocket.Conn, response interface{}) error {
+	err := conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+	if err != nil {
+		return err
+	}
+
+	return websocket.JSON.Send(conn, response)
+}
+
+func streamHyperblocksAsServerSentEvents(c *gin.Context, subscription data.HyperblocksSubscriptionHandler) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	keepAliveTicker := time.NewTicker(subscriptionKeepAlivePeriod)
+	defer keepAliveTicker.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case hyperblock, ok := <-subscription.Hyperblocks():
+			if !ok {
+				_ = writeServerSentEvent(w, "", subscriptionEndedEventName, newSubscriptionEndedResponse(subscription.Err()))
+				return false
+			}
+
+			id := strconv.FormatUint(hyperblock.Nonce, 10)
+			err := writeServerSentEvent(w, id, hyperblockEventName, data.NewHyperblockApiResponse(*hyperblock))
+			return err == nil
+		case <-keepAliveTicker.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func writeServerSentEvent(w io.Writer, id string, event string, response interface{}) error {
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	if len(id) > 0 {
+		_, err = fmt.Fprintf(w, "id: %s\n", id)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+func newSubscriptionEndedResponse(err error) data.GenericAPIResponse {
+	if err == nil {
+		return data.GenericAPIResponse{Code: data.ReturnCodeSuccess}
+	}
+
+	return data.GenericAPIResponse{
+		Error: err.Error(),
+		Code:  data.ReturnCodeInternalError,
+	}
+}
@@ -1,16 +1,24 @@
 package groups_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
 	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
 )
 
 const hyperBlockPath = "/hyperblock"
@@ -94,6 +102,156 @@ func TestGetHyperblockByNonce(t *testing.T) {
 	require.Equal(t, "invalid block hash parameter", response.Error)
 }
 
+func createSubscriptionFacade(t *testing.T, expectedOptions common.HyperblocksSubscriptionOptions, numClosed *uint32) *mock.Facade {
+	return &mock.Facade{
+		SubscribeToHyperblocksCalled: func(_ context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+			require.Equal(t, expectedOptions, options)
+
+			hyperblocks := make(chan *data.Hyperblock, 2)
+			hyperblocks <- &data.Hyperblock{Nonce: expectedOptions.FromNonce.Value}
+			hyperblocks <- &data.Hyperblock{Nonce: expectedOptions.FromNonce.Value + 1}
+			close(hyperblocks)
+
+			return &mock.HyperblocksSubscriptionStub{
+				HyperblocksCalled: func() <-chan *data.Hyperblock {
+					return hyperblocks
+				},
+				ErrCalled: func() error {
+					return errors.New("subscriber too slow")
+				},
+				CloseCalled: func() {
+					atomic.AddUint32(numClosed, 1)
+				},
+			}, nil
+		},
+	}
+}
+
+func startHyperblocksTestServer(t *testing.T, facade interface{}) *httptest.Server {
+	hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(startProxyServer(hyperBlockGroup, hyperBlockPath))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSubscribeToHyperblocks_ServerSentEvents(t *testing.T) {
+	t.Parallel()
+
+	numClosed := uint32(0)
+	expectedOptions := common.HyperblocksSubscriptionOptions{
+		WithLogs:  true,
+		FromNonce: core.OptionalUint64{Value: 5, HasValue: true},
+	}
+	server := startHyperblocksTestServer(t, createSubscriptionFacade(t, expectedOptions, &numClosed))
+
+	// the Last-Event-ID header of a reconnecting event source takes precedence over the original URL
+	httpRequest, _ := http.NewRequest(http.MethodGet, server.URL+"/hyperblock/subscribe?withLogs=true&fromNonce=2", nil)
+	httpRequest.Header.Set("Last-Event-ID", "4")
+	resp, err := http.DefaultClient.Do(httpRequest)
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	events := strings.Split(strings.TrimSpace(string(body)), "\n\n")
+	require.Equal(t, 3, len(events))
+	require.True(t, strings.HasPrefix(events[0], "id: 5\nevent: hyperblock\ndata: "))
+	require.Contains(t, events[0], `"nonce":5`)
+	require.True(t, strings.HasPrefix(events[1], "id: 6\nevent: hyperblock\ndata: "))
+	require.Contains(t, events[1], `"nonce":6`)
+	require.True(t, strings.HasPrefix(events[2], "event: end\ndata: "))
+	require.Contains(t, events[2], "subscriber too slow")
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numClosed))
+}
+
+func TestSubscribeToHyperblocks_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	numClosed := uint32(0)
+	expectedOptions := common.HyperblocksSubscriptionOptions{
+		FromNonce: core.OptionalUint64{Value: 3, HasValue: true},
+	}
+	server := startHyperblocksTestServer(t, createSubscriptionFacade(t, expectedOptions, &numClosed))
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/hyperblock/subscribe?fromNonce=3"
+	conn, err := websocket.Dial(url, "", server.URL)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	for _, expectedNonce := range []uint64{3, 4} {
+		response := data.HyperblockApiResponse{}
+		err = websocket.JSON.Receive(conn, &response)
+		require.NoError(t, err)
+		require.Equal(t, data.ReturnCodeSuccess, response.Code)
+		require.Equal(t, expectedNonce, response.Data.Hyperblock.Nonce)
+	}
+
+	endResponse := data.GenericAPIResponse{}
+	err = websocket.JSON.Receive(conn, &endResponse)
+	require.NoError(t, err)
+	require.Equal(t, data.ReturnCodeInternalError, endResponse.Code)
+	require.Equal(t, "subscriber too slow", endResponse.Error)
+
+	err = websocket.JSON.Receive(conn, &endResponse)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestSubscribeToHyperblocks_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid from nonce should err", func(t *testing.T) {
+		t.Parallel()
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, &mock.Facade{}, "/hyperblock/subscribe?fromNonce=abc", &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code)
+	})
+
+	testSubscribeError := func(subscribeErr error, expectedStatusCode int) {
+		facade := &mock.Facade{
+			SubscribeToHyperblocksCalled: func(_ context.Context, _ common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+				return nil, subscribeErr
+			},
+		}
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, facade, "/hyperblock/subscribe?fromNonce=1", &response)
+		require.Equal(t, expectedStatusCode, statusCode)
+		require.Contains(t, response.Error, subscribeErr.Error())
+	}
+	t.Run("resume nonce too old should err", func(t *testing.T) {
+		t.Parallel()
+
+		testSubscribeError(fmt.Errorf("%w: latest 200", apiErrors.ErrResumeNonceTooOld), http.StatusBadRequest)
+	})
+	t.Run("too many subscribers should err", func(t *testing.T) {
+		t.Parallel()
+
+		testSubscribeError(apiErrors.ErrTooManyHyperblocksSubscribers, http.StatusServiceUnavailable)
+	})
+	t.Run("subscriptions not enabled should err", func(t *testing.T) {
+		t.Parallel()
+
+		testSubscribeError(apiErrors.ErrHyperblocksSubscriptionsNotEnabled, http.StatusServiceUnavailable)
+	})
+	t.Run("other error should err", func(t *testing.T) {
+		t.Parallel()
+
+		testSubscribeError(errors.New("observers offline"), http.StatusInternalServerError)
+	})
+}
+
 func doGet(t *testing.T, facade interface{}, url string, response interface{}) int {
 	hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
 	require.NoError(t, err)
@@ -70,7 +70,7 @@ func (group *internalGroup) internalBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetInternalBlockByHash(shardID, hash, common.Internal)
+	blockByHashResponse, err := group.facade.GetInternalBlockByHash(c.Request.Context(), shardID, hash, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -105,7 +105,7 @@ func (group *internalGroup) internalBlockbyNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(shardID, nonce, common.Internal)
+	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(c.Request.Context(), shardID, nonce, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -141,7 +141,7 @@ func (group *internalGroup) rawBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetInternalBlockByHash(shardID, hash, common.Proto)
+	blockByHashResponse, err := group.facade.GetInternalBlockByHash(c.Request.Context(), shardID, hash, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -176,7 +176,7 @@ func (group *internalGroup) rawBlockbyNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(shardID, nonce, common.Proto)
+	blockByNonceResponse, err := group.facade.GetInternalBlockByNonce(c.Request.Context(), shardID, nonce, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -224,7 +224,7 @@ func (group *internalGroup) internalMiniBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(shardID, hash, epoch, common.Internal)
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(c.Request.Context(), shardID, hash, epoch, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -272,7 +272,7 @@ func (group *internalGroup) rawMiniBlockbyHashHandler(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(shardID, hash, epoch, common.Proto)
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHash(c.Request.Context(), shardID, hash, epoch, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -295,7 +295,7 @@ func (group *internalGroup) internalStartOfEpochMetaBlock(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(epoch, common.Internal)
+	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(c.Request.Context(), epoch, common.Internal)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -318,7 +318,7 @@ func (group *internalGroup) rawStartOfEpochMetaBlock(c *gin.Context) {
 		return
 	}
 
-	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(epoch, common.Proto)
+	miniBlockByHashResponse, err := group.facade.GetInternalStartOfEpochMetaBlock(c.Request.Context(), epoch, common.Proto)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
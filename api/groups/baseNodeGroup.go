@@ -37,7 +37,7 @@ func NewNodeGroup(facadeHandler data.FacadeHandler) (*nodeGroup, error) {
 
 // getHeartbeatData will expose heartbeat status from an observer (if any available) in json format
 func (group *nodeGroup) getHeartbeatData(c *gin.Context) {
-	heartbeatResults, err := group.facade.GetHeartbeatData()
+	heartbeatResults, err := group.facade.GetHeartbeatData(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -61,7 +61,7 @@ func (group *nodeGroup) isOldStorageForToken(c *gin.Context) {
 		)
 		return
 	}
-	isOldStorage, err := group.facade.IsOldStorageForToken(token, nonce)
+	isOldStorage, err := group.facade.IsOldStorageForToken(c.Request.Context(), token, nonce)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -50,7 +50,7 @@ func (pg *proofGroup) getProof(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProof(rootHash, address)
+	getProofResp, err := pg.facade.GetProof(c.Request.Context(), rootHash, address)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -66,7 +66,7 @@ func (pg *proofGroup) getProofCurrentRootHash(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProofCurrentRootHash(address)
+	getProofResp, err := pg.facade.GetProofCurrentRootHash(c.Request.Context(), address)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -89,7 +89,7 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 		return
 	}
 
-	verifyProofResp, err := pg.facade.VerifyProof(proofParams.RootHash, proofParams.Address, proofParams.Proof)
+	verifyProofResp, err := pg.facade.VerifyProof(c.Request.Context(), proofParams.RootHash, proofParams.Address, proofParams.Proof)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -28,17 +28,28 @@ func NewStatusGroup(facadeHandler data.FacadeHandler) (*statusGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/metrics", Handler: ng.getMetrics, Method: http.MethodGet},
 		{Path: "/prometheus-metrics", Handler: ng.getPrometheusMetrics, Method: http.MethodGet},
+		{Path: "/circuit-breakers", Handler: ng.getCircuitBreakers, Method: http.MethodGet},
+		{Path: "/quarantined-nodes", Handler: ng.getQuarantinedNodes, Method: http.MethodGet},
+		{Path: "/nodes", Handler: ng.getNodesHealth, Method: http.MethodGet},
+		{Path: "/api-keys", Handler: ng.getApiKeysUsage, Method: http.MethodGet},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
 	return ng, nil
 }
 
-// getMetrics will expose endpoints statistics in json format
+// getMetrics will expose endpoints statistics and the number of coalesced requests towards each node in json format
 func (group *statusGroup) getMetrics(c *gin.Context) {
 	metricsResults := group.facade.GetMetrics()
+	coalescedRequests := group.facade.GetCoalescedRequestsMetrics()
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"metrics": metricsResults}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"metrics": metricsResults, "coalescedRequests": coalescedRequests},
+		"",
+		data.ReturnCodeSuccess,
+	)
 }
 
 // getPrometheusMetrics will expose proxy metrics in prometheus format
@@ -47,3 +58,31 @@ func (group *statusGroup) getPrometheusMetrics(c *gin.Context) {
 
 	c.String(http.StatusOK, metricsResults)
 }
+
+// getCircuitBreakers will expose the statuses of the observers' circuit breakers
+func (group *statusGroup) getCircuitBreakers(c *gin.Context) {
+	statuses := group.facade.GetCircuitBreakersStatuses()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"circuitBreakers": statuses}, "", data.ReturnCodeSuccess)
+}
+
+// getQuarantinedNodes will expose the nodes which are not used because they do not match their configuration
+func (group *statusGroup) getQuarantinedNodes(c *gin.Context) {
+	quarantinedNodes := group.facade.GetQuarantinedNodes()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"quarantinedNodes": quarantinedNodes}, "", data.ReturnCodeSuccess)
+}
+
+// getNodesHealth will expose the health of all the observers and of all the full history nodes
+func (group *statusGroup) getNodesHealth(c *gin.Context) {
+	nodes := group.facade.GetNodesHealth()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"nodes": nodes}, "", data.ReturnCodeSuccess)
+}
+
+// getApiKeysUsage will expose the usage of each API key
+func (group *statusGroup) getApiKeysUsage(c *gin.Context) {
+	usage := group.facade.GetApiKeysUsage()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"apiKeys": usage}, "", data.ReturnCodeSuccess)
+}
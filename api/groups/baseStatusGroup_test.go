@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
@@ -14,7 +15,40 @@ import (
 
 type statusMetricsResponse struct {
 	Data struct {
-		Metrics map[string]*data.EndpointMetrics `json:"metrics"`
+		Metrics           map[string]*data.EndpointMetrics `json:"metrics"`
+		CoalescedRequests map[string]uint64                `json:"coalescedRequests"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type circuitBreakersResponse struct {
+	Data struct {
+		CircuitBreakers []*data.NodeCircuitBreakerStatus `json:"circuitBreakers"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type quarantinedNodesResponse struct {
+	Data struct {
+		QuarantinedNodes []*data.QuarantinedNode `json:"quarantinedNodes"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type nodesHealthResponse struct {
+	Data struct {
+		Nodes []*data.NodeHealthStatus `json:"nodes"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type apiKeysUsageResponse struct {
+	Data struct {
+		ApiKeys map[string]*data.ApiKeyUsage `json:"apiKeys"`
 	}
 	Error string `json:"error"`
 	Code  string `json:"code"`
@@ -43,10 +77,16 @@ func TestGetMetrics_ShouldWork(t *testing.T) {
 			HighestResponseTime: 50,
 		},
 	}
+	expectedCoalescedRequests := map[string]uint64{
+		"http://observer0": 7,
+	}
 	facade := &mock.Facade{
 		GetMetricsCalled: func() map[string]*data.EndpointMetrics {
 			return expectedMetrics
 		},
+		GetCoalescedRequestsMetricsCalled: func() map[string]uint64 {
+			return expectedCoalescedRequests
+		},
 	}
 
 	statusGroup, err := groups.NewStatusGroup(facade)
@@ -62,6 +102,7 @@ func TestGetMetrics_ShouldWork(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.Code)
 
 	require.Equal(t, expectedMetrics, apiResp.Data.Metrics)
+	require.Equal(t, expectedCoalescedRequests, apiResp.Data.CoalescedRequests)
 }
 
 func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
@@ -88,3 +129,138 @@ func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.Code)
 	require.Equal(t, expectedMetrics, string(bodyBytes))
 }
+
+func TestGetCircuitBreakers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStatuses := []*data.NodeCircuitBreakerStatus{
+		{
+			Address:             "http://observer:8080",
+			State:               data.CircuitBreakerOpen,
+			ConsecutiveFailures: 5,
+			LastStateChange:     time.Unix(1000, 0).UTC(),
+		},
+	}
+	facade := &mock.Facade{
+		GetCircuitBreakersStatusesCalled: func() []*data.NodeCircuitBreakerStatus {
+			return expectedStatuses
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/circuit-breakers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp circuitBreakersResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedStatuses, apiResp.Data.CircuitBreakers)
+}
+
+func TestGetQuarantinedNodes_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedNodes := []*data.QuarantinedNode{
+		{
+			Address:         "http://observer:8080",
+			ConfiguredShard: 1,
+			Reason:          "reports shard 0, configured shard 1",
+			QuarantinedAt:   time.Unix(1000, 0).UTC(),
+		},
+	}
+	facade := &mock.Facade{
+		GetQuarantinedNodesCalled: func() []*data.QuarantinedNode {
+			return expectedNodes
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/quarantined-nodes", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp quarantinedNodesResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedNodes, apiResp.Data.QuarantinedNodes)
+}
+
+func TestGetNodesHealth_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedNodes := []*data.NodeHealthStatus{
+		{
+			Address:      "http://observer:8080",
+			Type:         data.Observer,
+			ShardId:      1,
+			IsSynced:     true,
+			Nonce:        100,
+			NonceLag:     2,
+			LastCheck:    time.Unix(1000, 0).UTC(),
+			NumRequests:  10,
+			LatencyP50Ms: 1.5,
+		},
+	}
+	facade := &mock.Facade{
+		GetNodesHealthCalled: func() []*data.NodeHealthStatus {
+			return expectedNodes
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/nodes", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp nodesHealthResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedNodes, apiResp.Data.Nodes)
+}
+
+func TestGetApiKeysUsage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedUsage := map[string]*data.ApiKeyUsage{
+		"alice": {
+			NumRequests:          3,
+			NumErrors:            1,
+			LastRequestTimestamp: 1000,
+			Endpoints: map[string]uint64{
+				"/v1.0/network/config": 3,
+			},
+		},
+	}
+	facade := &mock.Facade{
+		GetApiKeysUsageCalled: func() map[string]*data.ApiKeyUsage {
+			return expectedUsage
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/api-keys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp apiKeysUsageResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedUsage, apiResp.Data.ApiKeys)
+}
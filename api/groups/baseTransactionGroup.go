@@ -36,6 +36,7 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
 		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
+		{Path: "/:txhash/wait", Handler: tg.waitForTransactionCompletion, Method: http.MethodGet},
 		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
 		{Path: "/pool", Handler: tg.getTransactionsPool, Method: http.MethodGet},
 	}
@@ -44,7 +45,8 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	return tg, nil
 }
 
-// sendTransaction will receive a transaction from the client and propagate it for processing
+// sendTransaction will receive a transaction from the client and propagate it for processing. If requested, it also
+// waits for the transaction to complete
 func (group *transactionGroup) sendTransaction(c *gin.Context) {
 	var tx = data.Transaction{}
 	err := c.ShouldBindJSON(&tx)
@@ -59,13 +61,47 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	statusCode, txHash, err := group.facade.SendTransaction(&tx)
+	waitForCompletion, err := parseBoolUrlParam(c, common.UrlParameterWaitForCompletion)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	waitOptions, err := parseTransactionWaitOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	statusCode, txHash, err := group.facade.SendTransaction(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
+	if !waitForCompletion {
+		shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
+		return
+	}
+
+	result, err := group.facade.WaitForTransactionCompletion(c.Request.Context(), txHash, waitOptions)
+	if err != nil {
+		// the transaction was sent, so its hash is returned along with the error
+		shared.RespondWith(c, getTransactionWaitErrorStatusCode(err), gin.H{"txHash": txHash}, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"txHash":      txHash,
+			"transaction": result.Transaction,
+			"status":      result.Status,
+			"completed":   result.Completed,
+		},
+		"",
+		data.ReturnCodeSuccess,
+	)
 }
 
 // sendUserFunds will receive an address from the client and propagate a transaction for sending some ERD to that address
@@ -94,7 +130,7 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	err = group.facade.SendUserFunds(gtx.Receiver, gtx.Value)
+	err = group.facade.SendUserFunds(c.Request.Context(), gtx.Receiver, gtx.Value)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -124,7 +160,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	response, err := group.facade.SendMultipleTransactions(txs)
+	response, err := group.facade.SendMultipleTransactions(c.Request.Context(), txs)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -142,6 +178,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		gin.H{
 			"numOfSentTxs": response.NumOfTxs,
 			"txsHashes":    response.TxsHashes,
+			"txsResults":   response.TxsResults,
 		},
 		"",
 		data.ReturnCodeSuccess,
@@ -169,7 +206,7 @@ func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	simulationResponse, err := group.facade.SimulateTransaction(&tx, options.CheckSignature)
+	simulationResponse, err := group.facade.SimulateTransaction(c.Request.Context(), &tx, options.CheckSignature)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -196,7 +233,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 		return
 	}
 
-	cost, err := group.facade.TransactionCostRequest(&tx)
+	cost, err := group.facade.TransactionCostRequest(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -209,7 +246,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
 	sender := c.Request.URL.Query().Get("sender")
-	txStatus, err := group.facade.GetTransactionStatus(txHash, sender)
+	txStatus, err := group.facade.GetTransactionStatus(c.Request.Context(), txHash, sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -218,6 +255,48 @@ func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"status": txStatus}, "", data.ReturnCodeSuccess)
 }
 
+// waitForTransactionCompletion waits until the transaction completes or the timeout elapses and returns its latest
+// known state
+func (group *transactionGroup) waitForTransactionCompletion(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	options, err := parseTransactionWaitOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	result, err := group.facade.WaitForTransactionCompletion(c.Request.Context(), txHash, options)
+	if err != nil {
+		shared.RespondWith(c, getTransactionWaitErrorStatusCode(err), nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"transaction": result.Transaction,
+			"status":      result.Status,
+			"completed":   result.Completed,
+		},
+		"",
+		data.ReturnCodeSuccess,
+	)
+}
+
+func getTransactionWaitErrorStatusCode(err error) int {
+	if err == errors.ErrTooManyTrackedTransactions || err == errors.ErrTransactionsTrackerNotEnabled {
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
+
 // getTransaction should return a transaction from observer
 func (group *transactionGroup) getTransaction(c *gin.Context) {
 	txHash := c.Param("txhash")
@@ -238,7 +317,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, err := group.facade.GetTransaction(txHash, options.WithResults)
+	tx, err := group.facade.GetTransaction(c.Request.Context(), txHash, options.WithResults)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -248,7 +327,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 }
 
 func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(c.Request.Context(), txHash, sndAddr, withEvents)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
@@ -344,7 +423,7 @@ func validateFields(fields string) error {
 }
 
 func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
-	txPool, err := ef.GetTransactionsPool(fields)
+	txPool, err := ef.GetTransactionsPool(c.Request.Context(), fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -354,7 +433,7 @@ func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
 }
 
 func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string) {
-	txPool, err := ef.GetTransactionsPoolForShard(shardID, fields)
+	txPool, err := ef.GetTransactionsPoolForShard(c.Request.Context(), shardID, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -364,7 +443,7 @@ func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint
 }
 
 func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	lastNonce, err := ef.GetLastPoolNonceForSender(sender)
+	lastNonce, err := ef.GetLastPoolNonceForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -374,7 +453,7 @@ func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(sender)
+	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -384,7 +463,7 @@ func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string) {
-	txPool, err := ef.GetTransactionsPoolForSender(sender, fields)
+	txPool, err := ef.GetTransactionsPoolForSender(c.Request.Context(), sender, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
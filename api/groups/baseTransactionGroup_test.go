@@ -2,16 +2,20 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,7 +34,8 @@ type TxHashResponse struct {
 }
 
 type numOfSentTxsResponseData struct {
-	Num uint64 `json:"numOfSentTxs"`
+	Num     uint64                               `json:"numOfSentTxs"`
+	Results []*data.MultipleTransactionsTxResult `json:"txsResults"`
 }
 
 // MultiTxsResponse structure
@@ -39,6 +44,18 @@ type MultiTxsResponse struct {
 	Data numOfSentTxsResponseData `json:"data"`
 }
 
+type transactionWaitResponseData struct {
+	TxHash      string                            `json:"txHash"`
+	Transaction *transaction.ApiTransactionResult `json:"transaction"`
+	Status      string                            `json:"status"`
+	Completed   bool                              `json:"completed"`
+}
+
+type transactionWaitResponse struct {
+	GeneralResponse
+	Data transactionWaitResponseData `json:"data"`
+}
+
 type txPool struct {
 	TxPool data.TransactionsPool `json:"txPool"`
 }
@@ -191,6 +208,144 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, string(data.ReturnCodeSuccess), response.GeneralResponse.Code)
 }
 
+func TestSendTransaction_WaitForCompletion(t *testing.T) {
+	t.Parallel()
+
+	txHash := "tx hash"
+	jsonStr := `{"nonce": 1, "sender": "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43", "receiver": "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43", "value": "10"}`
+
+	t.Run("should return the completed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.Facade{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return 0, txHash, nil
+			},
+			WaitForTransactionCompletionCalled: func(_ context.Context, hash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+				require.Equal(t, txHash, hash)
+				require.Equal(t, 30*time.Second, options.Timeout)
+				return &data.TransactionWaitResult{
+					Transaction: &transaction.ApiTransactionResult{Hash: hash, Status: transaction.TxStatusSuccess},
+					Status:      string(transaction.TxStatusSuccess),
+					Completed:   true,
+				}, nil
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?waitForCompletion=true&timeout=30", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionWaitResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, txHash, response.Data.TxHash)
+		assert.True(t, response.Data.Completed)
+		assert.Equal(t, string(transaction.TxStatusSuccess), response.Data.Status)
+		assert.Equal(t, txHash, response.Data.Transaction.Hash)
+	})
+	t.Run("wait error should still return the hash", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.Facade{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return 0, txHash, nil
+			},
+			WaitForTransactionCompletionCalled: func(_ context.Context, _ string, _ common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+				return nil, apiErrors.ErrTransactionsTrackerNotEnabled
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?waitForCompletion=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionWaitResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, txHash, response.Data.TxHash)
+		assert.Equal(t, apiErrors.ErrTransactionsTrackerNotEnabled.Error(), response.Error)
+	})
+	t.Run("invalid timeout should not send the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.Facade{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				require.Fail(t, "the transaction should not have been sent")
+				return 0, txHash, nil
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?waitForCompletion=true&timeout=-1", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+func TestWaitForTransactionCompletion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the latest state of the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.Facade{
+			WaitForTransactionCompletionCalled: func(_ context.Context, hash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+				require.Equal(t, "aabb", hash)
+				require.Equal(t, time.Duration(0), options.Timeout)
+				return &data.TransactionWaitResult{
+					Transaction: &transaction.ApiTransactionResult{Hash: hash, Status: transaction.TxStatusPending},
+					Status:      string(transaction.TxStatusPending),
+				}, nil
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/wait", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionWaitResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.False(t, response.Data.Completed)
+		assert.Equal(t, string(transaction.TxStatusPending), response.Data.Status)
+		assert.Equal(t, "aabb", response.Data.Transaction.Hash)
+	})
+	t.Run("too many tracked transactions should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.Facade{
+			WaitForTransactionCompletionCalled: func(_ context.Context, _ string, _ common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+				return nil, apiErrors.ErrTooManyTrackedTransactions
+			},
+		}
+		transactionsGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/wait?timeout=10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, apiErrors.ErrTooManyTrackedTransactions.Error(), response.Error)
+	})
+}
+
 func TestSimulateTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -362,6 +517,9 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 			return data.MultipleTransactionsResponseData{
 				NumOfTxs:  10,
 				TxsHashes: nil,
+				TxsResults: []*data.MultipleTransactionsTxResult{
+					{Index: 0, Status: data.TxSendStatusRejectedLocally, Reason: "invalid sender"},
+				},
 			}, nil
 		},
 	}
@@ -390,6 +548,9 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Empty(t, response.Error)
 	assert.Equal(t, uint64(10), response.Data.Num)
+	require.Equal(t, 1, len(response.Data.Results))
+	assert.Equal(t, data.TxSendStatusRejectedLocally, response.Data.Results[0].Status)
+	assert.Equal(t, "invalid sender", response.Data.Results[0].Reason)
 }
 
 func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
@@ -106,7 +106,7 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 		return nil, err
 	}
 
-	vmOutput, err := group.facade.ExecuteSCQuery(command)
+	vmOutput, err := group.facade.ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -11,36 +12,37 @@ import (
 
 // AccountsFacadeHandler interface defines methods that can be used from the facade
 type AccountsFacadeHandler interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetTransactions(address string) ([]data.DatabaseTransaction, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
-	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error)
 }
 
 // BlockFacadeHandler interface defines methods that can be used from the facade
 type BlockFacadeHandler interface {
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 }
 
 // BlocksFacadeHandler interface defines methods that can be used from the facade
 type BlocksFacadeHandler interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // InternalFacadeHandler interface defines methods that can be used from facade context variable
 type InternalFacadeHandler interface {
-	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalBlockByNonce(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
-	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByNonce(ctx context.Context, shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 }
 
 // BlockAtlasFacadeHandler interface defines methods that can be used from facade context variable
@@ -50,75 +52,83 @@ type BlockAtlasFacadeHandler interface {
 
 // HyperBlockFacadeHandler defines the actions needed for fetching the hyperblocks from the nodes
 type HyperBlockFacadeHandler interface {
-	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	SubscribeToHyperblocks(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error)
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from the facade
 type NetworkFacadeHandler interface {
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
-	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
-	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
-	GetDelegatedInfo() (*data.GenericAPIResponse, error)
-	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
-	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
-	GetRatingsConfig() (*data.GenericAPIResponse, error)
-	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
-	GetGasConfigs() (*data.GenericAPIResponse, error)
-	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error)
+	GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error)
+	GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
 }
 
 // NodeFacadeHandler interface defines methods that can be used from the facade
 type NodeFacadeHandler interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
-	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
+	IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error)
 }
 
 // StatusFacadeHandler interface defines methods that can be used from the facade
 type StatusFacadeHandler interface {
 	GetMetrics() map[string]*data.EndpointMetrics
+	GetCoalescedRequestsMetrics() map[string]uint64
+	GetApiKeysUsage() map[string]*data.ApiKeyUsage
 	GetMetricsForPrometheus() string
+	GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodes() []*data.QuarantinedNode
+	GetNodesHealth() []*data.NodeHealthStatus
 }
 
 // TransactionFacadeHandler interface defines methods that can be used from the facade
 type TransactionFacadeHandler interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	IsFaucetEnabled() bool
-	SendUserFunds(receiver string, value *big.Int) error
-	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
-	GetLastPoolNonceForSender(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	SendUserFunds(ctx context.Context, receiver string, value *big.Int) error
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	WaitForTransactionCompletion(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
 }
 
 // ProofFacadeHandler interface defines methods that can be used from the facade
 type ProofFacadeHandler interface {
-	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
-	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
-	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
+	GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // ValidatorFacadeHandler interface defines methods that can be used from the facade
 type ValidatorFacadeHandler interface {
-	ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatistics(ctx context.Context) (map[string]*data.ValidatorApiResponse, error)
 }
 
 // VmValuesFacadeHandler interface defines methods that can be used from the facade
 type VmValuesFacadeHandler interface {
-	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error)
 }
 
 // ActionsFacadeHandler interface defines methods that can be used from the facade
 type ActionsFacadeHandler interface {
 	ReloadObservers() data.NodesReloadResponse
 	ReloadFullHistoryObservers() data.NodesReloadResponse
+	ReloadConfig() data.NodesReloadResponse
 }
@@ -3,6 +3,7 @@ package groups
 import (
 	"encoding/hex"
 	"strconv"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/common"
@@ -34,6 +35,24 @@ func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions,
 	return options, nil
 }
 
+func parseHyperblocksSubscriptionOptions(c *gin.Context) (common.HyperblocksSubscriptionOptions, error) {
+	withLogs, err := parseBoolUrlParam(c, common.UrlParameterWithLogs)
+	if err != nil {
+		return common.HyperblocksSubscriptionOptions{}, err
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return common.HyperblocksSubscriptionOptions{}, err
+	}
+
+	options := common.HyperblocksSubscriptionOptions{
+		WithLogs:  withLogs,
+		FromNonce: fromNonce,
+	}
+	return options, nil
+}
+
 func parseAccountQueryOptions(c *gin.Context) (common.AccountQueryOptions, error) {
 	onFinalBlock, err := parseBoolUrlParam(c, common.UrlParameterOnFinalBlock)
 	if err != nil {
@@ -87,6 +106,16 @@ func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOption
 	return options, nil
 }
 
+func parseTransactionWaitOptions(c *gin.Context) (common.TransactionWaitOptions, error) {
+	timeoutInSeconds, err := parseUint32UrlParam(c, common.UrlParameterTimeout)
+	if err != nil {
+		return common.TransactionWaitOptions{}, err
+	}
+
+	options := common.TransactionWaitOptions{Timeout: time.Duration(timeoutInSeconds.Value) * time.Second}
+	return options, nil
+}
+
 func parseTransactionSimulationOptions(c *gin.Context) (common.TransactionSimulationOptions, error) {
 	checkSignature, err := parseBoolUrlParamWithDefault(c, common.UrlParameterCheckSignature, true)
 	if err != nil {
@@ -1,5 +1,13 @@
 package api
 
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
 // ElrondProxyHandler interface defines methods that can be used from facade context variable
 type ElrondProxyHandler interface {
 }
+
+// ApiConfigParser defines what an API config parser should be able to do
+type ApiConfigParser interface {
+	GetConfigForVersion(version string) (*data.ApiRoutesConfig, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// apiKeysLimiterKeyPrefix is prepended to the keys of the API keys requests counters held in the state store
+	apiKeysLimiterKeyPrefix = "apikeys:"
+
+	limitByApiKeyPlan = "api-key-plan"
+)
+
+// ArgsApiKeysMiddleware holds the arguments needed for creating a new API keys middleware. The RoutePrefix is the
+// path of the routes group (for example, /v1.0) which is removed from the requested path in order to obtain the route
+// in the /package/route form, as defined in the API config files
+type ArgsApiKeysMiddleware struct {
+	Registry               ApiKeysRegistryHandler
+	StatusMetricsExtractor StatusMetricsExtractor
+	StateStore             StateStoreHandler
+	Header                 string
+	IsApiKeyRequired       bool
+	RoutePrefix            string
+}
+
+// apiKeysMiddleware authenticates the requests made with an API key, checks that the key's plan allows the requested
+// route and applies the plan's limits. The requests made with each key are accounted in the status metrics
+type apiKeysMiddleware struct {
+	registry               ApiKeysRegistryHandler
+	statusMetricsExtractor StatusMetricsExtractor
+	stateStore             StateStoreHandler
+	header                 string
+	isApiKeyRequired       bool
+	routePrefix            string
+	getTimeHandler         func() time.Time
+}
+
+// NewApiKeysMiddleware returns a new instance of apiKeysMiddleware
+func NewApiKeysMiddleware(args ArgsApiKeysMiddleware) (*apiKeysMiddleware, error) {
+	if check.IfNil(args.Registry) {
+		return nil, ErrNilApiKeysRegistry
+	}
+	if check.IfNil(args.StatusMetricsExtractor) {
+		return nil, ErrNilStatusMetricsExtractor
+	}
+	if check.IfNil(args.StateStore) {
+		return nil, ErrNilStateStore
+	}
+	if len(args.Header) == 0 {
+		return nil, ErrEmptyApiKeyHeader
+	}
+
+	return &apiKeysMiddleware{
+		registry:               args.Registry,
+		statusMetricsExtractor: args.StatusMetricsExtractor,
+		stateStore:             args.StateStore,
+		header:                 args.Header,
+		isApiKeyRequired:       args.IsApiKeyRequired,
+		routePrefix:            strings.TrimSuffix(args.RoutePrefix, "/"),
+		getTimeHandler:         time.Now,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware for authenticating the requests made with an API key
+func (akm *apiKeysMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := c.GetHeader(akm.header)
+		if len(apiKey) == 0 {
+			if akm.isApiKeyRequired {
+				abortWithStatus(c, http.StatusUnauthorized, fmt.Sprintf("this endpoint requires an API key in the %s header", akm.header))
+			}
+			return
+		}
+
+		route := strings.TrimPrefix(c.FullPath(), akm.routePrefix)
+		authorization, found := akm.registry.AuthorizeRequest(apiKey, route)
+		if !found {
+			abortWithStatus(c, http.StatusUnauthorized, "invalid API key")
+			return
+		}
+
+		defer func() {
+			withError := c.Writer.Status() != http.StatusOK
+			akm.statusMetricsExtractor.AddApiKeyRequest(authorization.KeyName, c.FullPath(), withError)
+		}()
+
+		if !authorization.IsRouteAllowed {
+			abortWithStatus(c, http.StatusForbidden, fmt.Sprintf("the %s plan of the API key does not allow this endpoint", authorization.PlanName))
+			return
+		}
+
+		if authorization.Burst > 0 {
+			isAllowed := akm.applyPlanLimits(c, route, authorization)
+			if !isAllowed {
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// applyPlanLimits counts the request made with the API key and sets the RateLimit headers. It returns false if the
+// request was rejected
+func (akm *apiKeysMiddleware) applyPlanLimits(c *gin.Context, route string, authorization *data.ApiKeyAuthorization) bool {
+	limits := RouteLimits{
+		Burst:         authorization.Burst,
+		RatePerSecond: authorization.RatePerSecond,
+	}
+	keyPrefix := fmt.Sprintf("%s%s_%s_", apiKeysLimiterKeyPrefix, route, authorization.KeyName)
+	result, err := countRequestInSlidingWindow(c.Request.Context(), akm.stateStore, akm.getTimeHandler(), keyPrefix, limits, limitByApiKeyPlan)
+	if err != nil {
+		// the request is allowed, as the state store failure should not make the endpoint unavailable
+		log.Debug("API keys middleware: cannot count request", "route", route, "error", err)
+		return true
+	}
+
+	setRateLimitHeaders(c, result)
+	if result.isAllowed {
+		return true
+	}
+
+	printMessage := fmt.Sprintf("the %s plan of the API key allows %d requests in %v for this endpoint",
+		authorization.PlanName,
+		limits.Burst,
+		computeWindow(limits),
+	)
+	abortWithTooManyRequests(c, printMessage)
+
+	return false
+}
+
+func abortWithStatus(c *gin.Context, status int, printMessage string) {
+	c.AbortWithStatusJSON(status, shared.GenericAPIResponse{
+		Data:  nil,
+		Error: printMessage,
+		Code:  shared.ReturnCodeRequestError,
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akm *apiKeysMiddleware) IsInterfaceNil() bool {
+	return akm == nil
+}
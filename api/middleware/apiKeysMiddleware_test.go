@@ -0,0 +1,245 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type apiKeyRequest struct {
+	keyName   string
+	path      string
+	withError bool
+}
+
+type apiKeyRequestsRecorder struct {
+	mutRequests sync.Mutex
+	requests    []apiKeyRequest
+}
+
+func (recorder *apiKeyRequestsRecorder) record(keyName string, path string, withError bool) {
+	recorder.mutRequests.Lock()
+	recorder.requests = append(recorder.requests, apiKeyRequest{keyName: keyName, path: path, withError: withError})
+	recorder.mutRequests.Unlock()
+}
+
+func (recorder *apiKeyRequestsRecorder) getRequests() []apiKeyRequest {
+	recorder.mutRequests.Lock()
+	defer recorder.mutRequests.Unlock()
+
+	return append([]apiKeyRequest(nil), recorder.requests...)
+}
+
+func createMockArgsApiKeysMiddleware() ArgsApiKeysMiddleware {
+	return ArgsApiKeysMiddleware{
+		Registry:               &mock.ApiKeysRegistryStub{},
+		StatusMetricsExtractor: &mock.StatusMetricsExporterStub{},
+		StateStore:             statestore.NewMemoryStore(),
+		Header:                 "X-Api-Key",
+		RoutePrefix:            "/v1.0",
+	}
+}
+
+func startProxyServerWithApiKeys(t *testing.T, args ArgsApiKeysMiddleware) (*gin.Engine, *apiKeyRequestsRecorder) {
+	recorder := &apiKeyRequestsRecorder{}
+	args.StatusMetricsExtractor = &mock.StatusMetricsExporterStub{
+		AddApiKeyRequestCalled: recorder.record,
+	}
+	akm, err := NewApiKeysMiddleware(args)
+	require.NoError(t, err)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+
+	ws := gin.New()
+	versionGroup := ws.Group("/v1.0")
+	versionGroup.Use(akm.MiddlewareHandlerFunc())
+	routes := versionGroup.Group("/address")
+	addressGroup.RegisterRoutes(routes, createApiConfig(data.RouteConfig{}), emptyGinHandler, emptyGinHandler, emptyGinHandler)
+
+	return ws, recorder
+}
+
+func sendRequestWithApiKey(ws *gin.Engine, apiKey string) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1.0/address/test", nil)
+	if len(apiKey) > 0 {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewApiKeysMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil registry should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysMiddleware()
+		args.Registry = nil
+		akm, err := NewApiKeysMiddleware(args)
+		require.Equal(t, ErrNilApiKeysRegistry, err)
+		require.True(t, check.IfNil(akm))
+	})
+	t.Run("nil status metrics extractor should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysMiddleware()
+		args.StatusMetricsExtractor = nil
+		akm, err := NewApiKeysMiddleware(args)
+		require.Equal(t, ErrNilStatusMetricsExtractor, err)
+		require.True(t, check.IfNil(akm))
+	})
+	t.Run("nil state store should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysMiddleware()
+		args.StateStore = nil
+		akm, err := NewApiKeysMiddleware(args)
+		require.Equal(t, ErrNilStateStore, err)
+		require.True(t, check.IfNil(akm))
+	})
+	t.Run("empty header should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysMiddleware()
+		args.Header = ""
+		akm, err := NewApiKeysMiddleware(args)
+		require.Equal(t, ErrEmptyApiKeyHeader, err)
+		require.True(t, check.IfNil(akm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		akm, err := NewApiKeysMiddleware(createMockArgsApiKeysMiddleware())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(akm))
+	})
+}
+
+func TestApiKeysMiddleware_RequestsWithoutApiKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("API key not required should allow", func(t *testing.T) {
+		t.Parallel()
+
+		ws, recorder := startProxyServerWithApiKeys(t, createMockArgsApiKeysMiddleware())
+		resp := sendRequestWithApiKey(ws, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, recorder.getRequests())
+	})
+	t.Run("API key required should reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsApiKeysMiddleware()
+		args.IsApiKeyRequired = true
+		ws, recorder := startProxyServerWithApiKeys(t, args)
+		resp := sendRequestWithApiKey(ws, "")
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Contains(t, resp.Body.String(), "this endpoint requires an API key in the X-Api-Key header")
+		assert.Empty(t, recorder.getRequests())
+	})
+}
+
+func TestApiKeysMiddleware_UnknownApiKeyShouldReject(t *testing.T) {
+	t.Parallel()
+
+	ws, recorder := startProxyServerWithApiKeys(t, createMockArgsApiKeysMiddleware())
+	resp := sendRequestWithApiKey(ws, "unknown")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), "invalid API key")
+	assert.Empty(t, recorder.getRequests())
+}
+
+func TestApiKeysMiddleware_RouteNotAllowedByPlanShouldReject(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsApiKeysMiddleware()
+	args.Registry = &mock.ApiKeysRegistryStub{
+		AuthorizeRequestCalled: func(apiKey string, route string) (*data.ApiKeyAuthorization, bool) {
+			assert.Equal(t, "key0", apiKey)
+			assert.Equal(t, "/address/:address", route)
+
+			return &data.ApiKeyAuthorization{KeyName: "alice", PlanName: "free"}, true
+		},
+	}
+	ws, recorder := startProxyServerWithApiKeys(t, args)
+
+	resp := sendRequestWithApiKey(ws, "key0")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Contains(t, resp.Body.String(), "the free plan of the API key does not allow this endpoint")
+	assert.Equal(t, []apiKeyRequest{{keyName: "alice", path: "/v1.0/address/:address", withError: true}}, recorder.getRequests())
+}
+
+func TestApiKeysMiddleware_AllowedRequestsShouldBeAccounted(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsApiKeysMiddleware()
+	args.Registry = &mock.ApiKeysRegistryStub{
+		AuthorizeRequestCalled: func(apiKey string, route string) (*data.ApiKeyAuthorization, bool) {
+			return &data.ApiKeyAuthorization{KeyName: "alice", PlanName: "partner", IsRouteAllowed: true}, true
+		},
+	}
+	ws, recorder := startProxyServerWithApiKeys(t, args)
+
+	for i := 0; i < 3; i++ {
+		resp := sendRequestWithApiKey(ws, "key0")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(headerRateLimitLimit))
+	}
+
+	expectedRequest := apiKeyRequest{keyName: "alice", path: "/v1.0/address/:address", withError: false}
+	assert.Equal(t, []apiKeyRequest{expectedRequest, expectedRequest, expectedRequest}, recorder.getRequests())
+}
+
+func TestApiKeysMiddleware_PlanLimitsShouldApply(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsApiKeysMiddleware()
+	args.Registry = &mock.ApiKeysRegistryStub{
+		AuthorizeRequestCalled: func(apiKey string, route string) (*data.ApiKeyAuthorization, bool) {
+			return &data.ApiKeyAuthorization{
+				KeyName:        apiKey,
+				PlanName:       "free",
+				IsRouteAllowed: true,
+				Burst:          2,
+				RatePerSecond:  2.0 / 60,
+			}, true
+		},
+	}
+	ws, recorder := startProxyServerWithApiKeys(t, args)
+
+	resp := sendRequestWithApiKey(ws, "key0")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "2", resp.Header().Get(headerRateLimitLimit))
+	assert.Equal(t, "1", resp.Header().Get(headerRateLimitRemaining))
+
+	resp = sendRequestWithApiKey(ws, "key0")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = sendRequestWithApiKey(ws, "key0")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.NotEmpty(t, resp.Header().Get(headerRetryAfter))
+	assert.Contains(t, resp.Body.String(), "the free plan of the API key allows 2 requests in 1m0s for this endpoint")
+
+	// each key has its own limits
+	resp = sendRequestWithApiKey(ws, "key1")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	requests := recorder.getRequests()
+	require.Equal(t, 4, len(requests))
+	assert.True(t, requests[2].withError)
+	assert.Equal(t, "key1", requests[3].keyName)
+}
@@ -7,3 +7,24 @@ var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
 
 // ErrNilStatusMetricsExtractor signals that a nil status metrics extractor has been provided
 var ErrNilStatusMetricsExtractor = errors.New("nil status metrics extractor")
+
+// ErrInvalidRouteLimits signals that invalid route limits have been provided
+var ErrInvalidRouteLimits = errors.New("invalid route limits: burst and rate must be both set or both unset")
+
+// ErrInvalidLimitBy signals that an unknown client identity to limit the requests by has been provided
+var ErrInvalidLimitBy = errors.New("invalid limit by value")
+
+// ErrEmptyApiKeyHeader signals that the requests should be limited by API key, but no API key header was provided
+var ErrEmptyApiKeyHeader = errors.New("empty API key header")
+
+// ErrNilStateStore signals that a nil state store has been provided
+var ErrNilStateStore = errors.New("nil state store")
+
+// ErrNilApiKeysRegistry signals that a nil API keys registry has been provided
+var ErrNilApiKeysRegistry = errors.New("nil API keys registry")
+
+// ErrNilTokenValidator signals that a nil token validator has been provided
+var ErrNilTokenValidator = errors.New("nil token validator")
+
+// ErrEmptySessionHeader signals that an empty session header has been provided
+var ErrEmptySessionHeader = errors.New("empty session header")
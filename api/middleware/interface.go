@@ -1,19 +1,40 @@
 package middleware
 
 import (
+	"context"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
 // RateLimiterHandler defines the actions that an implementation of rate limiter handler should do
 type RateLimiterHandler interface {
 	shared.MiddlewareProcessor
-	ResetMap(version string)
+}
+
+// StateStoreHandler defines the state store operations needed for counting the requests
+type StateStoreHandler interface {
+	Increment(ctx context.Context, key string, expiration time.Duration) (uint64, error)
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	IsInterfaceNil() bool
 }
 
 // StatusMetricsExtractor defines what a status metrics extractor should do
 type StatusMetricsExtractor interface {
 	AddRequestData(path string, withError bool, duration time.Duration)
+	AddApiKeyRequest(keyName string, path string, withError bool)
+	IsInterfaceNil() bool
+}
+
+// ApiKeysRegistryHandler defines what an API keys registry should be able to do
+type ApiKeysRegistryHandler interface {
+	AuthorizeRequest(apiKey string, route string) (*data.ApiKeyAuthorization, bool)
+	IsInterfaceNil() bool
+}
+
+// TokenValidatorHandler defines what a bearer tokens validator should be able to do
+type TokenValidatorHandler interface {
+	AuthorizeRequest(token string, route string) (*data.TokenAuthorization, error)
 	IsInterfaceNil() bool
 }
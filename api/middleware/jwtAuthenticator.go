@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerScheme        = "bearer"
+)
+
+// jwtAuthenticator authenticates the requests towards the secured routes by JWT bearer tokens. The token's subject is
+// set as the authenticated user, so the requests can be limited by username
+type jwtAuthenticator struct {
+	tokenValidator TokenValidatorHandler
+	routePrefix    string
+}
+
+// NewJwtAuthenticator returns a new instance of jwtAuthenticator. The routePrefix is the path of the routes group (for
+// example, /v1.0) which is removed from the requested path in order to obtain the route in the /package/route form, as
+// defined in the API config files
+func NewJwtAuthenticator(tokenValidator TokenValidatorHandler, routePrefix string) (*jwtAuthenticator, error) {
+	if check.IfNil(tokenValidator) {
+		return nil, ErrNilTokenValidator
+	}
+
+	return &jwtAuthenticator{
+		tokenValidator: tokenValidator,
+		routePrefix:    strings.TrimSuffix(routePrefix, "/"),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware for authenticating the requests by bearer tokens
+func (ja *jwtAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, found := getBearerToken(c)
+		if !found {
+			abortWithStatus(c, http.StatusUnauthorized, "this endpoint requires a bearer token")
+			return
+		}
+
+		route := strings.TrimPrefix(c.FullPath(), ja.routePrefix)
+		authorization, err := ja.tokenValidator.AuthorizeRequest(token, route)
+		if err != nil {
+			abortWithStatus(c, http.StatusUnauthorized, "invalid bearer token: "+err.Error())
+			return
+		}
+		if !authorization.IsRouteAllowed {
+			abortWithStatus(c, http.StatusForbidden, "the bearer token does not allow this endpoint")
+			return
+		}
+
+		c.Set(gin.AuthUserKey, authorization.Subject)
+	}
+}
+
+func getBearerToken(c *gin.Context) (string, bool) {
+	parts := strings.SplitN(c.GetHeader(authorizationHeader), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], bearerScheme) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(parts[1])
+
+	return token, len(token) > 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ja *jwtAuthenticator) IsInterfaceNil() bool {
+	return ja == nil
+}
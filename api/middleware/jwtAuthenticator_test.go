@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startProxyServerWithJwtAuthenticator(t *testing.T, tokenValidator TokenValidatorHandler) *gin.Engine {
+	ja, err := NewJwtAuthenticator(tokenValidator, "/v1.0")
+	require.NoError(t, err)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+
+	ws := gin.New()
+	routes := ws.Group("/v1.0").Group("/address")
+	usernameChecker := func(c *gin.Context) {
+		assert.Equal(t, "internal-service", c.GetString(gin.AuthUserKey))
+	}
+	addressGroup.RegisterRoutes(routes, createApiConfig(data.RouteConfig{Secured: true}), ja.MiddlewareHandlerFunc(), usernameChecker, emptyGinHandler)
+
+	return ws
+}
+
+func sendRequestWithAuthorization(ws *gin.Engine, authorization string) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1.0/address/test", nil)
+	if len(authorization) > 0 {
+		req.Header.Set("Authorization", authorization)
+	}
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewJwtAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	ja, err := NewJwtAuthenticator(nil, "/v1.0")
+	require.Equal(t, ErrNilTokenValidator, err)
+	require.True(t, check.IfNil(ja))
+
+	ja, err = NewJwtAuthenticator(&mock.TokenValidatorStub{}, "/v1.0")
+	require.NoError(t, err)
+	require.False(t, check.IfNil(ja))
+}
+
+func TestJwtAuthenticator_MissingTokenShouldReject(t *testing.T) {
+	t.Parallel()
+
+	ws := startProxyServerWithJwtAuthenticator(t, &mock.TokenValidatorStub{
+		AuthorizeRequestCalled: func(token string, route string) (*data.TokenAuthorization, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	})
+
+	for _, authorization := range []string{"", "Basic dXNlcjpwYXNz", "Bearer", "Bearer  "} {
+		resp := sendRequestWithAuthorization(ws, authorization)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code, authorization)
+		assert.Contains(t, resp.Body.String(), "this endpoint requires a bearer token", authorization)
+	}
+}
+
+func TestJwtAuthenticator_InvalidTokenShouldReject(t *testing.T) {
+	t.Parallel()
+
+	ws := startProxyServerWithJwtAuthenticator(t, &mock.TokenValidatorStub{
+		AuthorizeRequestCalled: func(token string, route string) (*data.TokenAuthorization, error) {
+			return nil, errors.New("token expired")
+		},
+	})
+
+	resp := sendRequestWithAuthorization(ws, "Bearer token")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), "invalid bearer token: token expired")
+}
+
+func TestJwtAuthenticator_RouteNotAllowedShouldReject(t *testing.T) {
+	t.Parallel()
+
+	ws := startProxyServerWithJwtAuthenticator(t, &mock.TokenValidatorStub{
+		AuthorizeRequestCalled: func(token string, route string) (*data.TokenAuthorization, error) {
+			return &data.TokenAuthorization{Subject: "internal-service"}, nil
+		},
+	})
+
+	resp := sendRequestWithAuthorization(ws, "Bearer token")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Contains(t, resp.Body.String(), "the bearer token does not allow this endpoint")
+}
+
+func TestJwtAuthenticator_ValidTokenShouldAllow(t *testing.T) {
+	t.Parallel()
+
+	ws := startProxyServerWithJwtAuthenticator(t, &mock.TokenValidatorStub{
+		AuthorizeRequestCalled: func(token string, route string) (*data.TokenAuthorization, error) {
+			assert.Equal(t, "token", token)
+			assert.Equal(t, "/address/:address", route)
+
+			return &data.TokenAuthorization{Subject: "internal-service", IsRouteAllowed: true}, nil
+		},
+	})
+
+	resp := sendRequestWithAuthorization(ws, "bearer token")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -1,82 +1,368 @@
 package middleware
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"net/http"
-	"sync"
+	"strconv"
 	"time"
 
-	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// rateLimiterKeyPrefix is prepended to the keys of the requests counters held in the state store
+	rateLimiterKeyPrefix = "ratelimit:"
+
+	// LimitByIP makes the requests be limited for each client IP
+	LimitByIP = "ip"
+
+	// LimitByApiKey makes the requests be limited for each API key, as provided in the API key header
+	LimitByApiKey = "api-key"
+
+	// LimitByUsername makes the requests of the secured endpoints be limited for each authenticated username
+	LimitByUsername = "username"
+)
+
+const (
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+var limitDescriptions = map[string]string{
+	LimitByIP:       "your IP",
+	LimitByApiKey:   "your API key",
+	LimitByUsername: "your user",
+}
+
+// RouteLimits holds the limits applied to the requests towards a route
+type RouteLimits struct {
+	// Burst represents the maximum number of requests a client can make at once. Together with RatePerSecond, it
+	// defines a sliding window of Burst/RatePerSecond seconds in which a client can make at most Burst requests
+	Burst uint64
+	// RatePerSecond represents the sustained number of requests per second a client can make
+	RatePerSecond float64
+	// MaxConcurrentRequests represents the maximum number of requests, of all the clients, handled at once
+	MaxConcurrentRequests uint32
+}
+
+// ArgsRateLimiter holds the arguments needed for creating a new rate limiter. An empty LimitBy list means that the
+// requests are limited by IP
+type ArgsRateLimiter struct {
+	Limits       map[string]RouteLimits
+	LimitBy      []string
+	ApiKeyHeader string
+	StateStore   StateStoreHandler
+}
+
+type slidingWindowResult struct {
+	limitBy    string
+	limit      uint64
+	remaining  uint64
+	reset      time.Duration
+	retryAfter time.Duration
+	isAllowed  bool
+}
+
+// rateLimiter limits the requests towards the endpoints. Each client (identified by its IP, API key or username) can
+// make, on each endpoint, at most Burst requests in a sliding window of Burst/RatePerSecond seconds. The requests are
+// counted in the state store, so the limits can be shared by several proxy instances. The sliding window is
+// approximated from the counters of the current and of the previous fixed windows, which are aligned to the wall
+// clock. Also, the number of requests handled at once by an endpoint can be capped
 type rateLimiter struct {
-	requestsMap    map[string]uint64
-	mutRequestsMap sync.RWMutex
-	limits         map[string]uint64
-	countDuration  time.Duration
+	limits             map[string]RouteLimits
+	limitBy            []string
+	apiKeyHeader       string
+	stateStore         StateStoreHandler
+	concurrentRequests map[string]chan struct{}
+	getTimeHandler     func() time.Time
 }
 
 // NewRateLimiter returns a new instance of rateLimiter
-func NewRateLimiter(limits map[string]uint64, countDuration time.Duration) (*rateLimiter, error) {
-	if limits == nil {
-		return nil, ErrNilLimitsMapForEndpoints
+func NewRateLimiter(args ArgsRateLimiter) (*rateLimiter, error) {
+	err := checkArgsRateLimiter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	limitBy := args.LimitBy
+	if len(limitBy) == 0 {
+		limitBy = []string{LimitByIP}
 	}
+
+	concurrentRequests := make(map[string]chan struct{})
+	for endpoint, limits := range args.Limits {
+		if limits.MaxConcurrentRequests > 0 {
+			concurrentRequests[endpoint] = make(chan struct{}, limits.MaxConcurrentRequests)
+		}
+	}
+
 	return &rateLimiter{
-		requestsMap:   make(map[string]uint64),
-		limits:        limits,
-		countDuration: countDuration,
+		limits:             args.Limits,
+		limitBy:            limitBy,
+		apiKeyHeader:       args.ApiKeyHeader,
+		stateStore:         args.StateStore,
+		concurrentRequests: concurrentRequests,
+		getTimeHandler:     time.Now,
 	}, nil
 }
 
+func checkArgsRateLimiter(args ArgsRateLimiter) error {
+	if args.Limits == nil {
+		return ErrNilLimitsMapForEndpoints
+	}
+	for endpoint, limits := range args.Limits {
+		isBurstSet := limits.Burst > 0
+		isRateSet := limits.RatePerSecond > 0
+		if isBurstSet != isRateSet || limits.RatePerSecond < 0 {
+			return fmt.Errorf("%w for endpoint %s", ErrInvalidRouteLimits, endpoint)
+		}
+	}
+	for _, limitBy := range args.LimitBy {
+		_, isKnown := limitDescriptions[limitBy]
+		if !isKnown {
+			return fmt.Errorf("%w: %s", ErrInvalidLimitBy, limitBy)
+		}
+		if limitBy == LimitByApiKey && len(args.ApiKeyHeader) == 0 {
+			return ErrEmptyApiKeyHeader
+		}
+	}
+	if check.IfNil(args.StateStore) {
+		return ErrNilStateStore
+	}
+
+	return nil
+}
+
 // MiddlewareHandlerFunc returns the gin middleware for limiting the number of requests for a given endpoint
 func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		endpoint := c.FullPath()
 
-		limitForEndpoint, isEndpointLimited := rl.limits[endpoint]
+		limits, isEndpointLimited := rl.limits[endpoint]
 		if !isEndpointLimited {
 			return
 		}
 
-		clientIP := c.ClientIP()
-		key := fmt.Sprintf("%s_%s", endpoint, clientIP)
-
-		numRequests := rl.addInRequestsMap(key)
-		if numRequests >= limitForEndpoint {
-			printMessage := fmt.Sprintf("your IP exceeded the limit of %d requests in %v for this endpoint", limitForEndpoint, rl.countDuration)
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, shared.GenericAPIResponse{
-				Data:  nil,
-				Error: printMessage,
-				Code:  shared.ReturnCodeRequestError,
-			})
+		if limits.Burst > 0 {
+			isAllowed := rl.applyRequestsLimits(c, endpoint, limits)
+			if !isAllowed {
+				return
+			}
+		}
+
+		chConcurrentRequests, isConcurrencyLimited := rl.concurrentRequests[endpoint]
+		if !isConcurrencyLimited {
+			return
+		}
+
+		select {
+		case chConcurrentRequests <- struct{}{}:
+		default:
+			c.Header(headerRetryAfter, "1")
+			printMessage := fmt.Sprintf("the limit of %d concurrent requests for this endpoint was reached", limits.MaxConcurrentRequests)
+			abortWithTooManyRequests(c, printMessage)
+			return
+		}
+		defer func() {
+			<-chConcurrentRequests
+		}()
+
+		c.Next()
+	}
+}
+
+// applyRequestsLimits counts the request for each of the client's identities and sets the RateLimit headers. It
+// returns false if the request was rejected
+func (rl *rateLimiter) applyRequestsLimits(c *gin.Context, endpoint string, limits RouteLimits) bool {
+	var mostRestrictive *slidingWindowResult
+	for _, limitBy := range rl.limitBy {
+		identity, found := rl.getIdentity(c, limitBy)
+		if !found {
+			continue
+		}
+
+		result, err := rl.countRequest(c.Request.Context(), endpoint, limitBy, identity, limits)
+		if err != nil {
+			// the request is allowed, as the state store failure should not make the endpoint unavailable
+			log.Debug("rate limiter: cannot count request", "endpoint", endpoint, "error", err)
+			continue
+		}
+
+		if mostRestrictive == nil || isMoreRestrictive(result, mostRestrictive) {
+			mostRestrictive = result
+		}
+	}
+	if mostRestrictive == nil {
+		return true
+	}
+
+	setRateLimitHeaders(c, mostRestrictive)
+	if mostRestrictive.isAllowed {
+		return true
+	}
+
+	printMessage := fmt.Sprintf("%s exceeded the limit of %d requests in %v for this endpoint",
+		limitDescriptions[mostRestrictive.limitBy],
+		limits.Burst,
+		computeWindow(limits),
+	)
+	abortWithTooManyRequests(c, printMessage)
+
+	return false
+}
+
+// setRateLimitHeaders sets the RateLimit headers of the response and, if the request was rejected, the Retry-After header
+func setRateLimitHeaders(c *gin.Context, result *slidingWindowResult) {
+	c.Header(headerRateLimitLimit, strconv.FormatUint(result.limit, 10))
+	c.Header(headerRateLimitRemaining, strconv.FormatUint(result.remaining, 10))
+	c.Header(headerRateLimitReset, formatSeconds(result.reset))
+	if !result.isAllowed {
+		c.Header(headerRetryAfter, formatSeconds(result.retryAfter))
+	}
+}
+
+func isMoreRestrictive(result *slidingWindowResult, other *slidingWindowResult) bool {
+	if result.isAllowed != other.isAllowed {
+		return !result.isAllowed
+	}
+	if !result.isAllowed {
+		return result.retryAfter > other.retryAfter
+	}
+
+	return result.remaining < other.remaining
+}
+
+func (rl *rateLimiter) getIdentity(c *gin.Context, limitBy string) (string, bool) {
+	switch limitBy {
+	case LimitByIP:
+		return c.ClientIP(), true
+	case LimitByApiKey:
+		apiKey := c.GetHeader(rl.apiKeyHeader)
+		if len(apiKey) == 0 {
+			return "", false
+		}
+
+		// the API keys are secrets, so they are not used as such in the state store keys
+		hash := sha256.Sum256([]byte(apiKey))
+		return hex.EncodeToString(hash[:]), true
+	case LimitByUsername:
+		username := c.GetString(gin.AuthUserKey)
+		return username, len(username) > 0
+	default:
+		return "", false
+	}
+}
+
+// countRequest counts the request of the provided client identity towards the provided endpoint
+func (rl *rateLimiter) countRequest(
+	ctx context.Context,
+	endpoint string,
+	limitBy string,
+	identity string,
+	limits RouteLimits,
+) (*slidingWindowResult, error) {
+	keyPrefix := fmt.Sprintf("%s%s_%s_%s_", rateLimiterKeyPrefix, endpoint, limitBy, identity)
+
+	return countRequestInSlidingWindow(ctx, rl.stateStore, rl.getTimeHandler(), keyPrefix, limits, limitBy)
+}
+
+// countRequestInSlidingWindow increments the requests counter of the current fixed window and estimates the number of
+// requests in the sliding window by weighting the counter of the previous fixed window with its overlap with the
+// sliding window
+func countRequestInSlidingWindow(
+	ctx context.Context,
+	stateStore StateStoreHandler,
+	currentTime time.Time,
+	keyPrefix string,
+	limits RouteLimits,
+	limitBy string,
+) (*slidingWindowResult, error) {
+	window := computeWindow(limits)
+	now := currentTime.UnixNano()
+	windowIndex := now / int64(window)
+	elapsed := time.Duration(now - windowIndex*int64(window))
+
+	current, err := stateStore.Increment(ctx, keyPrefix+strconv.FormatInt(windowIndex, 10), 2*window)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := uint64(0)
+	buff, found, err := stateStore.Get(ctx, keyPrefix+strconv.FormatInt(windowIndex-1, 10))
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		previous, err = strconv.ParseUint(string(buff), 10, 64)
+		if err != nil {
+			return nil, err
 		}
 	}
+
+	return computeSlidingWindowResult(limits.Burst, window, elapsed, previous, current, limitBy), nil
 }
 
-func (rl *rateLimiter) addInRequestsMap(key string) uint64 {
-	rl.mutRequestsMap.Lock()
-	defer rl.mutRequestsMap.Unlock()
+func computeSlidingWindowResult(
+	limit uint64,
+	window time.Duration,
+	elapsed time.Duration,
+	previous uint64,
+	current uint64,
+	limitBy string,
+) *slidingWindowResult {
+	untilWindowEnd := window - elapsed
+	previousWeight := float64(untilWindowEnd) / float64(window)
+	estimated := float64(previous)*previousWeight + float64(current)
+
+	result := &slidingWindowResult{
+		limitBy:   limitBy,
+		limit:     limit,
+		reset:     untilWindowEnd,
+		isAllowed: estimated <= float64(limit),
+	}
+	if result.isAllowed {
+		result.remaining = limit - uint64(math.Ceil(estimated))
+		return result
+	}
 
-	_, ok := rl.requestsMap[key]
-	if !ok {
-		rl.requestsMap[key] = 1
-		return 1
+	if current < limit {
+		// the estimation drops below the limit while the previous window's requests leave the sliding window
+		neededDrop := float64(untilWindowEnd) - float64(limit-current)*float64(window)/float64(previous)
+		result.retryAfter = time.Duration(neededDrop)
+		return result
 	}
 
-	rl.requestsMap[key]++
+	// the estimation drops below the limit only while the current window's requests leave the sliding window
+	neededDrop := float64(window) * (1 - float64(limit)/float64(current))
+	result.retryAfter = untilWindowEnd + time.Duration(neededDrop)
 
-	return rl.requestsMap[key]
+	return result
 }
 
-// ResetMap has to be called from outside at a given interval so the requests map will be cleaned and older restrictions
-// would be erased
-func (rl *rateLimiter) ResetMap(version string) {
-	rl.mutRequestsMap.Lock()
-	rl.requestsMap = make(map[string]uint64)
-	rl.mutRequestsMap.Unlock()
+// computeWindow returns the duration of the sliding window in which at most Burst requests can be made
+func computeWindow(limits RouteLimits) time.Duration {
+	return time.Duration(float64(limits.Burst) / limits.RatePerSecond * float64(time.Second))
+}
+
+// formatSeconds returns the number of seconds of the provided duration, rounded up
+func formatSeconds(duration time.Duration) string {
+	seconds := int64(math.Ceil(duration.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	return strconv.FormatInt(seconds, 10)
+}
 
-	log.Info("rate limiter map has been reset", "version", version, "time", time.Now())
+func abortWithTooManyRequests(c *gin.Context, printMessage string) {
+	abortWithStatus(c, http.StatusTooManyRequests, printMessage)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
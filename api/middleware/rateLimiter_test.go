@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,34 +13,27 @@ import (
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
 	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	proxyTesting "github.com/ElrondNetwork/elrond-proxy-go/testing"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestNewRateLimiter_NilLimitsMapShouldErr(t *testing.T) {
-	t.Parallel()
-
-	rl, err := NewRateLimiter(nil, time.Millisecond)
-	require.Equal(t, ErrNilLimitsMapForEndpoints, err)
-	require.True(t, check.IfNil(rl))
-}
-
-func TestNewRateLimiter_ShouldWork(t *testing.T) {
-	t.Parallel()
+const limitedEndpoint = "/address/:address"
 
-	rl, err := NewRateLimiter(map[string]uint64{"abc": 5}, time.Millisecond)
-	require.NoError(t, err)
-	require.False(t, check.IfNil(rl))
+func createMockArgsRateLimiter(limits RouteLimits) ArgsRateLimiter {
+	return ArgsRateLimiter{
+		Limits:       map[string]RouteLimits{limitedEndpoint: limits},
+		LimitBy:      []string{LimitByIP},
+		ApiKeyHeader: "X-Api-Key",
+		StateStore:   statestore.NewMemoryStore(),
+	}
 }
 
-func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
-	t.Parallel()
-
-	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 2}, time.Millisecond)
-
-	facade := &mock.Facade{
+func createAccountsFacade() *mock.Facade {
+	return &mock.Facade{
 		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
@@ -49,88 +44,383 @@ func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
 			}, nil
 		},
 	}
-	addressGroup, err := groups.NewAccountsGroup(facade)
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil limits map should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter(RouteLimits{})
+		args.Limits = nil
+		rl, err := NewRateLimiter(args)
+		require.Equal(t, ErrNilLimitsMapForEndpoints, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("burst without rate should err", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(createMockArgsRateLimiter(RouteLimits{Burst: 5}))
+		require.True(t, errors.Is(err, ErrInvalidRouteLimits))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("rate without burst should err", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(createMockArgsRateLimiter(RouteLimits{RatePerSecond: 5}))
+		require.True(t, errors.Is(err, ErrInvalidRouteLimits))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("invalid limit by should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter(RouteLimits{Burst: 5, RatePerSecond: 1})
+		args.LimitBy = []string{LimitByIP, "cookie"}
+		rl, err := NewRateLimiter(args)
+		require.True(t, errors.Is(err, ErrInvalidLimitBy))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("limit by API key without header should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter(RouteLimits{Burst: 5, RatePerSecond: 1})
+		args.LimitBy = []string{LimitByApiKey}
+		args.ApiKeyHeader = ""
+		rl, err := NewRateLimiter(args)
+		require.Equal(t, ErrEmptyApiKeyHeader, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("nil state store should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter(RouteLimits{Burst: 5, RatePerSecond: 1})
+		args.StateStore = nil
+		rl, err := NewRateLimiter(args)
+		require.Equal(t, ErrNilStateStore, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter(RouteLimits{Burst: 5, RatePerSecond: 1, MaxConcurrentRequests: 2})
+		args.LimitBy = nil
+		rl, err := NewRateLimiter(args)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rl))
+		require.Equal(t, []string{LimitByIP}, rl.limitBy)
+	})
+}
+
+func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(createMockArgsRateLimiter(RouteLimits{Burst: 2, RatePerSecond: 2.0 / 60}))
+	require.NoError(t, err)
+	currentTime := time.Unix(6000, 0)
+	rl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
 	require.NoError(t, err)
-	ws := startProxyServer(addressGroup, rl, 2, "/address")
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{RateLimit: 2})
 
-	resp := httptest.NewRecorder()
-	context, _ := gin.CreateTestContext(resp)
-	req, _ := http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	ws.ServeHTTP(resp, req)
+	resp := sendRequest(ws, "1.1.1.1", nil)
 	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "2", resp.Header().Get(headerRateLimitLimit))
+	assert.Equal(t, "1", resp.Header().Get(headerRateLimitRemaining))
+	assert.Equal(t, "60", resp.Header().Get(headerRateLimitReset))
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
-	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	resp = sendRequest(ws, "1.1.1.1", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "0", resp.Header().Get(headerRateLimitRemaining))
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	resp = sendRequest(ws, "1.1.1.1", nil)
 	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "0", resp.Header().Get(headerRateLimitRemaining))
+	// the rejected request is counted as well: 3 * (60s - t) / 60s <= 2 only after 20s in the next window
+	assert.Equal(t, "80", resp.Header().Get(headerRetryAfter))
 
-	rl.ResetMap("")
+	// another IP has its own limit
+	resp = sendRequest(ws, "2.2.2.2", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	// the previous window's requests left the sliding window
+	currentTime = currentTime.Add(2 * time.Minute)
+	resp = sendRequest(ws, "1.1.1.1", nil)
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
 
-func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T) {
+func TestRateLimiter_ShouldNotAllowDoubleBurstAcrossWindowsBoundary(t *testing.T) {
 	t.Parallel()
 
-	rl, err := NewRateLimiter(map[string]uint64{"/address/:address/nonce": 1}, time.Millisecond)
+	rl, _ := NewRateLimiter(createMockArgsRateLimiter(RouteLimits{Burst: 4, RatePerSecond: 4.0 / 60}))
+	currentTime := time.Unix(6059, 0)
+	rl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
 
-	facade := &mock.Facade{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
-			return &data.AccountModel{
-				Account: data.Account{
-					Address: address,
-					Nonce:   1,
-					Balance: "100",
-				},
-			}, nil
-		},
+	addressGroup, _ := groups.NewAccountsGroup(createAccountsFacade())
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{Burst: 4, RatePerSecond: 4.0 / 60})
+
+	for i := 0; i < 4; i++ {
+		resp := sendRequest(ws, "1.1.1.1", nil)
+		require.Equal(t, http.StatusOK, resp.Code)
 	}
-	addressGroup, err := groups.NewAccountsGroup(facade)
+
+	// right after the fixed windows boundary, the previous window's requests are still counted
+	currentTime = currentTime.Add(2 * time.Second)
+	resp := sendRequest(ws, "1.1.1.1", nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRateLimiter(RouteLimits{})
+	args.Limits = map[string]RouteLimits{"/address/:address/nonce": {Burst: 1, RatePerSecond: 1}}
+	rl, err := NewRateLimiter(args)
 	require.NoError(t, err)
-	ws := startProxyServer(addressGroup, rl, 1, "/address")
 
-	resp := httptest.NewRecorder()
-	context, _ := gin.CreateTestContext(resp)
-	req, _ := http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	ws.ServeHTTP(resp, req)
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{RateLimit: 1})
+
+	for i := 0; i < 3; i++ {
+		resp := sendRequest(ws, "1.1.1.1", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(headerRateLimitLimit))
+	}
+}
+
+func TestRateLimiter_LimitByApiKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRateLimiter(RouteLimits{Burst: 1, RatePerSecond: 1.0 / 60})
+	args.LimitBy = []string{LimitByApiKey}
+	rl, _ := NewRateLimiter(args)
+
+	addressGroup, _ := groups.NewAccountsGroup(createAccountsFacade())
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{Burst: 1, RatePerSecond: 1.0 / 60})
+
+	resp := sendRequest(ws, "1.1.1.1", map[string]string{"X-Api-Key": "key0"})
 	assert.Equal(t, http.StatusOK, resp.Code)
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	// same API key, from another IP
+	resp = sendRequest(ws, "2.2.2.2", map[string]string{"X-Api-Key": "key0"})
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	resp = sendRequest(ws, "2.2.2.2", map[string]string{"X-Api-Key": "key1"})
 	assert.Equal(t, http.StatusOK, resp.Code)
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	// the requests without an API key are not limited by API key
+	resp = sendRequest(ws, "2.2.2.2", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = sendRequest(ws, "2.2.2.2", nil)
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
 
-func startProxyServer(group data.GroupHandler, rateLimiter RateLimiterHandler, rateLimit uint64, path string) *gin.Engine {
+func TestRateLimiter_LimitByIPAndApiKeyShouldApplyBoth(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRateLimiter(RouteLimits{Burst: 1, RatePerSecond: 1.0 / 60})
+	args.LimitBy = []string{LimitByIP, LimitByApiKey}
+	rl, _ := NewRateLimiter(args)
+
+	addressGroup, _ := groups.NewAccountsGroup(createAccountsFacade())
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{Burst: 1, RatePerSecond: 1.0 / 60})
+
+	resp := sendRequest(ws, "1.1.1.1", map[string]string{"X-Api-Key": "key0"})
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// a new API key does not bypass the IP limit
+	resp = sendRequest(ws, "1.1.1.1", map[string]string{"X-Api-Key": "key1"})
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestRateLimiter_LimitByUsername(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRateLimiter(RouteLimits{Burst: 1, RatePerSecond: 1.0 / 60})
+	args.LimitBy = []string{LimitByUsername}
+	rl, _ := NewRateLimiter(args)
+
+	addressGroup, _ := groups.NewAccountsGroup(createAccountsFacade())
 	ws := gin.New()
-	ws.Use(cors.Default())
-	routes := ws.Group(path)
-	apiConfig := data.ApiRoutesConfig{
+	routes := ws.Group("/address")
+	authenticationFunc := func(c *gin.Context) {
+		c.Set(gin.AuthUserKey, c.GetHeader("user"))
+	}
+	addressGroup.RegisterRoutes(routes, createApiConfig(data.RouteConfig{Secured: true, Burst: 1, RatePerSecond: 1.0 / 60}),
+		authenticationFunc, rl.MiddlewareHandlerFunc(), emptyGinHandler)
+
+	resp := sendRequest(ws, "1.1.1.1", map[string]string{"user": "alice"})
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = sendRequest(ws, "2.2.2.2", map[string]string{"user": "alice"})
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Contains(t, resp.Body.String(), "your user exceeded the limit of 1 requests in 1m0s")
+
+	resp = sendRequest(ws, "2.2.2.2", map[string]string{"user": "bob"})
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestRateLimiter_MaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := NewRateLimiter(createMockArgsRateLimiter(RouteLimits{MaxConcurrentRequests: 1}))
+
+	chStarted := make(chan struct{})
+	chRelease := make(chan struct{})
+	facade := createAccountsFacade()
+	getAccount := facade.GetAccountHandler
+	facade.GetAccountHandler = func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+		if address == "slow" {
+			close(chStarted)
+			<-chRelease
+		}
+		return getAccount(address, options)
+	}
+	addressGroup, _ := groups.NewAccountsGroup(facade)
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{MaxConcurrentRequests: 1})
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/address/slow", nil)
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}()
+	<-chStarted
+
+	resp := sendRequest(ws, "2.2.2.2", nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "1", resp.Header().Get(headerRetryAfter))
+
+	close(chRelease)
+	wg.Wait()
+
+	resp = sendRequest(ws, "2.2.2.2", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestRateLimiter_LimitsShouldBeSharedThroughTheStateStore(t *testing.T) {
+	t.Parallel()
+
+	server, err := proxyTesting.NewRedisServerStandIn("")
+	require.NoError(t, err)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	createRateLimiter := func() *rateLimiter {
+		stateStore, errCreate := statestore.NewRedisStore(statestore.ArgsRedisStore{
+			Address:          server.Address(),
+			PoolSize:         1,
+			DialTimeout:      time.Second,
+			OperationTimeout: time.Second,
+		})
+		require.NoError(t, errCreate)
+
+		args := createMockArgsRateLimiter(RouteLimits{Burst: 1, RatePerSecond: 1.0 / 60})
+		args.StateStore = stateStore
+		rl, errCreate := NewRateLimiter(args)
+		require.NoError(t, errCreate)
+
+		return rl
+	}
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+	ws0 := startProxyServer(addressGroup, createRateLimiter(), data.RouteConfig{RateLimit: 1})
+	ws1 := startProxyServer(addressGroup, createRateLimiter(), data.RouteConfig{RateLimit: 1})
+
+	resp := sendRequest(ws0, "1.1.1.1", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = sendRequest(ws1, "1.1.1.1", nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestRateLimiter_StateStoreFailureShouldAllowRequests(t *testing.T) {
+	t.Parallel()
+
+	stateStore, _ := statestore.NewRedisStore(statestore.ArgsRedisStore{
+		Address:          "127.0.0.1:1",
+		PoolSize:         1,
+		DialTimeout:      10 * time.Millisecond,
+		OperationTimeout: 10 * time.Millisecond,
+	})
+	_ = stateStore.Close()
+	args := createMockArgsRateLimiter(RouteLimits{Burst: 1, RatePerSecond: 1})
+	args.StateStore = stateStore
+	rl, err := NewRateLimiter(args)
+	require.NoError(t, err)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, rl, data.RouteConfig{RateLimit: 1})
+
+	for i := 0; i < 2; i++ {
+		resp := sendRequest(ws, "1.1.1.1", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(headerRateLimitLimit))
+	}
+}
+
+func TestComputeSlidingWindowResult(t *testing.T) {
+	t.Parallel()
+
+	window := time.Minute
+
+	result := computeSlidingWindowResult(10, window, 30*time.Second, 4, 5, LimitByIP)
+	assert.True(t, result.isAllowed)
+	assert.Equal(t, uint64(3), result.remaining)
+	assert.Equal(t, 30*time.Second, result.reset)
+
+	// 10 * 0.5 + 6 = 11: allowed once 10 * (30s - t) / 60s + 6 <= 10, that is after 6s
+	result = computeSlidingWindowResult(10, window, 30*time.Second, 10, 6, LimitByIP)
+	assert.False(t, result.isAllowed)
+	assert.Equal(t, uint64(0), result.remaining)
+	assert.Equal(t, 6*time.Second, result.retryAfter)
+
+	// the current window alone exceeds the limit: allowed once 20 * (60s - s) / 60s <= 10 in the next window
+	result = computeSlidingWindowResult(10, window, 30*time.Second, 0, 20, LimitByIP)
+	assert.False(t, result.isAllowed)
+	assert.Equal(t, 60*time.Second, result.retryAfter)
+}
+
+func sendRequest(ws *gin.Engine, remoteIP string, headers map[string]string) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	req.RemoteAddr = remoteIP + ":12345"
+	for header, value := range headers {
+		req.Header.Set(header, value)
+	}
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func createApiConfig(routeConfig data.RouteConfig) data.ApiRoutesConfig {
+	routeConfig.Name = "/:address"
+	routeConfig.Open = true
+
+	return data.ApiRoutesConfig{
 		APIPackages: map[string]data.APIPackageConfig{
-			"address": {Routes: []data.RouteConfig{
-				{
-					Name:      "/:address",
-					Open:      true,
-					Secured:   false,
-					RateLimit: rateLimit,
-				},
-			},
-			},
+			"address": {Routes: []data.RouteConfig{routeConfig}},
 		},
 	}
-	group.RegisterRoutes(routes, apiConfig, emptyGinHandler, rateLimiter.MiddlewareHandlerFunc(), emptyGinHandler)
+}
+
+func startProxyServer(group data.GroupHandler, rateLimiter RateLimiterHandler, routeConfig data.RouteConfig) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	routes := ws.Group("/address")
+	group.RegisterRoutes(routes, createApiConfig(routeConfig), emptyGinHandler, rateLimiter.MiddlewareHandlerFunc(), emptyGinHandler)
 	return ws
 }
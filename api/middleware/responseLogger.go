@@ -20,6 +20,10 @@ const (
 	prefixBadRequest           = "[bad request]"
 	prefixInternalError        = "[internal error]"
 	maxLengthRequestOrResponse = 400
+
+	// maxBufferedResponseLength bounds the part of the response kept for logging, so the streamed responses (e.g. the
+	// hyperblocks subscriptions) do not accumulate in memory
+	maxBufferedResponseLength = 4096
 )
 
 // TODO: remove this file and use the same middleware from elrond-go after it is merged
@@ -127,6 +131,13 @@ type bodyWriter struct {
 }
 
 func (w bodyWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	remainingLength := maxBufferedResponseLength - w.body.Len()
+	if remainingLength > len(b) {
+		remainingLength = len(b)
+	}
+	if remainingLength > 0 {
+		w.body.Write(b[:remainingLength])
+	}
+
 	return w.ResponseWriter.Write(b)
 }
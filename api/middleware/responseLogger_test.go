@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -163,3 +164,21 @@ func TestResponseLoggerMiddleware_ShouldNotCallHandler(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.False(t, handlerWasCalled)
 }
+
+func TestBodyWriter_WriteShouldBoundTheBufferedResponse(t *testing.T) {
+	t.Parallel()
+
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+
+	chunk := []byte(strings.Repeat("a", maxBufferedResponseLength/2+1))
+	for i := 0; i < 3; i++ {
+		n, err := bw.Write(chunk)
+		assert.Nil(t, err)
+		assert.Equal(t, len(chunk), n)
+	}
+
+	assert.Equal(t, maxBufferedResponseLength, bw.body.Len())
+	assert.Equal(t, 3*len(chunk), resp.Body.Len())
+}
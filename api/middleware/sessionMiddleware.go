@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+// maxSessionIDLength represents the maximum length of a session ID. Longer values are ignored
+const maxSessionIDLength = 128
+
+type sessionMiddleware struct {
+	header string
+}
+
+// NewSessionMiddleware returns a new instance of sessionMiddleware
+func NewSessionMiddleware(header string) (*sessionMiddleware, error) {
+	if len(header) == 0 {
+		return nil, ErrEmptySessionHeader
+	}
+
+	return &sessionMiddleware{
+		header: header,
+	}, nil
+}
+
+// MiddlewareHandlerFunc stores the session ID supplied by the client in the session header into the request's context,
+// so the requests towards the observers made on its behalf can be routed by session
+func (sm *sessionMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID := c.GetHeader(sm.header)
+		if len(sessionID) > 0 && len(sessionID) <= maxSessionIDLength {
+			c.Request = c.Request.WithContext(common.WithSessionID(c.Request.Context(), sessionID))
+		}
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *sessionMiddleware) IsInterfaceNil() bool {
+	return sm == nil
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+const testSessionHeader = "X-Session-Id"
+
+func sendRequestThroughSessionMiddleware(t *testing.T, sessionID string) string {
+	sm, err := NewSessionMiddleware(testSessionHeader)
+	require.NoError(t, err)
+
+	receivedSessionID := ""
+	ws := gin.New()
+	ws.Use(sm.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/nonce", func(c *gin.Context) {
+		receivedSessionID = common.GetSessionID(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/address/erd1alice/nonce", nil)
+	if len(sessionID) > 0 {
+		req.Header.Set(testSessionHeader, sessionID)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	return receivedSessionID
+}
+
+func TestNewSessionMiddleware(t *testing.T) {
+	t.Parallel()
+
+	sm, err := NewSessionMiddleware("")
+	require.Nil(t, sm)
+	require.Equal(t, ErrEmptySessionHeader, err)
+
+	sm, err = NewSessionMiddleware(testSessionHeader)
+	require.NoError(t, err)
+	require.False(t, sm.IsInterfaceNil())
+}
+
+func TestSessionMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no session header should not set the session ID", func(t *testing.T) {
+		t.Parallel()
+
+		require.Empty(t, sendRequestThroughSessionMiddleware(t, ""))
+	})
+	t.Run("too long session ID should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		require.Empty(t, sendRequestThroughSessionMiddleware(t, strings.Repeat("a", maxSessionIDLength+1)))
+	})
+	t.Run("should set the session ID", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "session-1", sendRequestThroughSessionMiddleware(t, "session-1"))
+	})
+}
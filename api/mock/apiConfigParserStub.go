@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// ApiConfigParserStub -
+type ApiConfigParserStub struct {
+	GetConfigForVersionCalled func(version string) (*data.ApiRoutesConfig, error)
+}
+
+// GetConfigForVersion -
+func (s *ApiConfigParserStub) GetConfigForVersion(version string) (*data.ApiRoutesConfig, error) {
+	if s.GetConfigForVersionCalled != nil {
+		return s.GetConfigForVersionCalled(version)
+	}
+
+	return &data.ApiRoutesConfig{}, nil
+}
+
+// IsInterfaceNil -
+func (s *ApiConfigParserStub) IsInterfaceNil() bool {
+	return s == nil
+}
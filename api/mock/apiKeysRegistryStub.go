@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// ApiKeysRegistryStub -
+type ApiKeysRegistryStub struct {
+	AuthorizeRequestCalled func(apiKey string, route string) (*data.ApiKeyAuthorization, bool)
+}
+
+// AuthorizeRequest -
+func (s *ApiKeysRegistryStub) AuthorizeRequest(apiKey string, route string) (*data.ApiKeyAuthorization, bool) {
+	if s.AuthorizeRequestCalled != nil {
+		return s.AuthorizeRequestCalled(apiKey, route)
+	}
+
+	return nil, false
+}
+
+// IsInterfaceNil -
+func (s *ApiKeysRegistryStub) IsInterfaceNil() bool {
+	return s == nil
+}
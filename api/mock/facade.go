@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -57,8 +58,12 @@ type Facade struct {
 	GetInternalStartOfEpochMetaBlockCalled       func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled                   func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	SubscribeToHyperblocksCalled                 func(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error)
+	WaitForTransactionCompletionCalled           func(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error)
+	ReserveNonceCalled                           func(ctx context.Context, address string) (*data.NonceReservation, error)
 	ReloadObserversCalled                        func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled             func() data.NodesReloadResponse
+	ReloadConfigCalled                           func() data.NodesReloadResponse
 	GetProofCalled                               func(string, string) (*data.GenericAPIResponse, error)
 	GetProofCurrentRootHashCalled                func(string) (*data.GenericAPIResponse, error)
 	VerifyProofCalled                            func(string, string, []string) (*data.GenericAPIResponse, error)
@@ -66,6 +71,11 @@ type Facade struct {
 	GetESDTSupplyCalled                          func(token string) (*data.ESDTSupplyResponse, error)
 	GetMetricsCalled                             func() map[string]*data.EndpointMetrics
 	GetPrometheusMetricsCalled                   func() string
+	GetCircuitBreakersStatusesCalled             func() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodesCalled                    func() []*data.QuarantinedNode
+	GetNodesHealthCalled                         func() []*data.NodeHealthStatus
+	GetCoalescedRequestsMetricsCalled            func() map[string]uint64
+	GetApiKeysUsageCalled                        func() map[string]*data.ApiKeyUsage
 	GetGenesisNodesPubKeysCalled                 func() (*data.GenericAPIResponse, error)
 	GetGasConfigsCalled                          func() (*data.GenericAPIResponse, error)
 	IsOldStorageForTokenCalled                   func(tokenID string, nonce uint64) (bool, error)
@@ -73,7 +83,7 @@ type Facade struct {
 }
 
 // GetProof -
-func (f *Facade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetProof(_ context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCalled != nil {
 		return f.GetProofCalled(rootHash, address)
 	}
@@ -82,7 +92,7 @@ func (f *Facade) GetProof(rootHash string, address string) (*data.GenericAPIResp
 }
 
 // GetProofCurrentRootHash -
-func (f *Facade) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetProofCurrentRootHash(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCurrentRootHashCalled != nil {
 		return f.GetProofCurrentRootHashCalled(address)
 	}
@@ -91,7 +101,7 @@ func (f *Facade) GetProofCurrentRootHash(address string) (*data.GenericAPIRespon
 }
 
 // VerifyProof -
-func (f *Facade) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (f *Facade) VerifyProof(_ context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	if f.VerifyProofCalled != nil {
 		return f.VerifyProofCalled(rootHash, address, proof)
 	}
@@ -126,8 +136,17 @@ func (f *Facade) ReloadFullHistoryObservers() data.NodesReloadResponse {
 	return data.NodesReloadResponse{}
 }
 
+// ReloadConfig -
+func (f *Facade) ReloadConfig() data.NodesReloadResponse {
+	if f.ReloadConfigCalled != nil {
+		return f.ReloadConfigCalled()
+	}
+
+	return data.NodesReloadResponse{}
+}
+
 // GetNetworkStatusMetrics -
-func (f *Facade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if f.GetNetworkMetricsHandler != nil {
 		return f.GetNetworkMetricsHandler(shardID)
 	}
@@ -136,7 +155,7 @@ func (f *Facade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIRespon
 }
 
 // GetNetworkConfigMetrics -
-func (f *Facade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetConfigMetricsHandler != nil {
 		return f.GetConfigMetricsHandler()
 	}
@@ -145,7 +164,7 @@ func (f *Facade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
 }
 
 // GetEconomicsDataMetrics -
-func (f *Facade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetEconomicsDataMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetEconomicsDataMetricsHandler != nil {
 		return f.GetEconomicsDataMetricsHandler()
 	}
@@ -154,7 +173,7 @@ func (f *Facade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
 }
 
 // GetAllIssuedESDTs -
-func (f *Facade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetAllIssuedESDTs(_ context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if f.GetAllIssuedESDTsHandler != nil {
 		return f.GetAllIssuedESDTsHandler(tokenType)
 	}
@@ -163,7 +182,7 @@ func (f *Facade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse,
 }
 
 // GetESDTsWithRole -
-func (f *Facade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetESDTsWithRole(_ context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsWithRoleCalled != nil {
 		return f.GetESDTsWithRoleCalled(address, role, options)
 	}
@@ -172,7 +191,7 @@ func (f *Facade) GetESDTsWithRole(address string, role string, options common.Ac
 }
 
 // GetESDTsRoles -
-func (f *Facade) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetESDTsRoles(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsRolesCalled != nil {
 		return f.GetESDTsRolesCalled(address, options)
 	}
@@ -181,7 +200,7 @@ func (f *Facade) GetESDTsRoles(address string, options common.AccountQueryOption
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (f *Facade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetNFTTokenIDsRegisteredByAddress(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
 		return f.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
@@ -190,7 +209,7 @@ func (f *Facade) GetNFTTokenIDsRegisteredByAddress(address string, options commo
 }
 
 // GetDirectStakedInfo -
-func (f *Facade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetDirectStakedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDirectStakedInfoCalled != nil {
 		return f.GetDirectStakedInfoCalled()
 	}
@@ -199,7 +218,7 @@ func (f *Facade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetDelegatedInfo -
-func (f *Facade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetDelegatedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDelegatedInfoCalled != nil {
 		return f.GetDelegatedInfoCalled()
 	}
@@ -208,17 +227,17 @@ func (f *Facade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetEnableEpochsMetrics -
-func (f *Facade) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetEnableEpochsMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetEnableEpochsMetricsHandler()
 }
 
 // GetRatingsConfig -
-func (f *Facade) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetRatingsConfig(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetRatingsConfigCalled()
 }
 
 // GetESDTSupply -
-func (f *Facade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+func (f *Facade) GetESDTSupply(_ context.Context, token string) (*data.ESDTSupplyResponse, error) {
 	if f.GetESDTSupplyCalled != nil {
 		return f.GetESDTSupplyCalled(token)
 	}
@@ -227,22 +246,22 @@ func (f *Facade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
 }
 
 // ValidatorStatistics -
-func (f *Facade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (f *Facade) ValidatorStatistics(_ context.Context) (map[string]*data.ValidatorApiResponse, error) {
 	return f.ValidatorStatisticsHandler()
 }
 
 // GetAccount -
-func (f *Facade) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (f *Facade) GetAccount(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	return f.GetAccountHandler(address, options)
 }
 
 // GetKeyValuePairs -
-func (f *Facade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetKeyValuePairs(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetKeyValuePairsHandler(address, options)
 }
 
 // GetValueForKey -
-func (f *Facade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (f *Facade) GetValueForKey(_ context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return f.GetValueForKeyHandler(address, key, options)
 }
 
@@ -252,7 +271,7 @@ func (f *Facade) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData -
-func (f *Facade) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetESDTTokenData(_ context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTTokenDataCalled != nil {
 		return f.GetESDTTokenDataCalled(address, key, options)
 	}
@@ -261,7 +280,7 @@ func (f *Facade) GetESDTTokenData(address string, key string, options common.Acc
 }
 
 // GetAllESDTTokens -
-func (f *Facade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetAllESDTTokens(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetAllESDTTokensCalled != nil {
 		return f.GetAllESDTTokensCalled(address, options)
 	}
@@ -270,7 +289,7 @@ func (f *Facade) GetAllESDTTokens(address string, options common.AccountQueryOpt
 }
 
 // GetESDTNftTokenData -
-func (f *Facade) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetESDTNftTokenData(_ context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTNftTokenDataCalled != nil {
 		return f.GetESDTNftTokenDataCalled(address, key, nonce, options)
 	}
@@ -279,7 +298,7 @@ func (f *Facade) GetESDTNftTokenData(address string, key string, nonce uint64, o
 }
 
 // IsOldStorageForToken -
-func (f *Facade) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (f *Facade) IsOldStorageForToken(_ context.Context, tokenID string, nonce uint64) (bool, error) {
 	if f.IsOldStorageForTokenCalled != nil {
 		return f.IsOldStorageForTokenCalled(tokenID, nonce)
 	}
@@ -293,17 +312,17 @@ func (f *Facade) GetTransactions(address string) ([]data.DatabaseTransaction, er
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (f *Facade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (f *Facade) GetTransactionByHashAndSenderAddress(_ context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	return f.GetTransactionByHashAndSenderAddressHandler(txHash, sndAddr, withEvents)
 }
 
 // GetTransaction -
-func (f *Facade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (f *Facade) GetTransaction(_ context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return f.GetTransactionHandler(txHash, withResults)
 }
 
 // GetTransactionsPool -
-func (f *Facade) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (f *Facade) GetTransactionsPool(_ context.Context, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolHandler != nil {
 		return f.GetTransactionsPoolHandler(fields)
 	}
@@ -312,7 +331,7 @@ func (f *Facade) GetTransactionsPool(fields string) (*data.TransactionsPool, err
 }
 
 // GetTransactionsPoolForShard -
-func (f *Facade) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (f *Facade) GetTransactionsPoolForShard(_ context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolForShardHandler != nil {
 		return f.GetTransactionsPoolForShardHandler(shardID, fields)
 	}
@@ -321,7 +340,7 @@ func (f *Facade) GetTransactionsPoolForShard(shardID uint32, fields string) (*da
 }
 
 // GetTransactionsPoolForSender -
-func (f *Facade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (f *Facade) GetTransactionsPoolForSender(_ context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if f.GetTransactionsPoolForSenderHandler != nil {
 		return f.GetTransactionsPoolForSenderHandler(sender, fields)
 	}
@@ -330,7 +349,7 @@ func (f *Facade) GetTransactionsPoolForSender(sender, fields string) (*data.Tran
 }
 
 // GetLastPoolNonceForSender -
-func (f *Facade) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (f *Facade) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
 	if f.GetLastPoolNonceForSenderHandler != nil {
 		return f.GetLastPoolNonceForSenderHandler(sender)
 	}
@@ -339,7 +358,7 @@ func (f *Facade) GetLastPoolNonceForSender(sender string) (uint64, error) {
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (f *Facade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (f *Facade) GetTransactionsPoolNonceGapsForSender(_ context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if f.GetTransactionsPoolNonceGapsForSenderHandler != nil {
 		return f.GetTransactionsPoolNonceGapsForSenderHandler(sender)
 	}
@@ -348,12 +367,12 @@ func (f *Facade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.Tra
 }
 
 // SendTransaction -
-func (f *Facade) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (f *Facade) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	return f.SendTransactionHandler(tx)
 }
 
 // SimulateTransaction -
-func (f *Facade) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (f *Facade) SimulateTransaction(_ context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	return f.SimulateTransactionHandler(tx, checkSignature)
 }
 
@@ -363,32 +382,32 @@ func (f *Facade) GetAddressConverter() (core.PubkeyConverter, error) {
 }
 
 // SendMultipleTransactions -
-func (f *Facade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (f *Facade) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return f.SendMultipleTransactionsHandler(txs)
 }
 
 // TransactionCostRequest -
-func (f *Facade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (f *Facade) TransactionCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return f.TransactionCostRequestHandler(tx)
 }
 
 // GetTransactionStatus -
-func (f *Facade) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (f *Facade) GetTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
 	return f.GetTransactionStatusHandler(txHash, sender)
 }
 
 // SendUserFunds -
-func (f *Facade) SendUserFunds(receiver string, value *big.Int) error {
+func (f *Facade) SendUserFunds(_ context.Context, receiver string, value *big.Int) error {
 	return f.SendUserFundsCalled(receiver, value)
 }
 
 // ExecuteSCQuery -
-func (f *Facade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (f *Facade) ExecuteSCQuery(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
 // GetHeartbeatData -
-func (f *Facade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (f *Facade) GetHeartbeatData(_ context.Context) (*data.HeartbeatResponse, error) {
 	return f.GetHeartbeatDataHandler()
 }
 
@@ -398,17 +417,17 @@ func (f *Facade) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (d
 }
 
 // GetBlockByHash -
-func (f *Facade) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *Facade) GetBlockByHash(_ context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByHashCalled(shardID, hash, options)
 }
 
 // GetBlockByNonce -
-func (f *Facade) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *Facade) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByNonceCalled(shardID, nonce, options)
 }
 
 // GetBlocksByRound -
-func (f *Facade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (f *Facade) GetBlocksByRound(_ context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	if f.GetBlocksByRoundCalled != nil {
 		return f.GetBlocksByRoundCalled(round, options)
 	}
@@ -416,57 +435,105 @@ func (f *Facade) GetBlocksByRound(round uint64, options common.BlockQueryOptions
 }
 
 // GetInternalBlockByHash -
-func (f *Facade) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *Facade) GetInternalBlockByHash(_ context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByHashCalled(shardID, hash, format)
 }
 
 // GetInternalBlockByNonce -
-func (f *Facade) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *Facade) GetInternalBlockByNonce(_ context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
 // GetInternalMiniBlockByHash -
-func (f *Facade) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (f *Facade) GetInternalMiniBlockByHash(_ context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return f.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
 }
 
 // GetInternalStartOfEpochMetaBlock -
-func (f *Facade) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *Facade) GetInternalStartOfEpochMetaBlock(_ context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalStartOfEpochMetaBlockCalled(epoch, format)
 }
 
 // GetHyperBlockByHash -
-func (f *Facade) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *Facade) GetHyperBlockByHash(_ context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByHashCalled(hash, options)
 }
 
 // GetHyperBlockByNonce -
-func (f *Facade) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *Facade) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByNonceCalled(nonce, options)
 }
 
+// SubscribeToHyperblocks -
+func (f *Facade) SubscribeToHyperblocks(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+	return f.SubscribeToHyperblocksCalled(ctx, options)
+}
+
+// WaitForTransactionCompletion -
+func (f *Facade) WaitForTransactionCompletion(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+	return f.WaitForTransactionCompletionCalled(ctx, txHash, options)
+}
+
+// ReserveNonce -
+func (f *Facade) ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error) {
+	return f.ReserveNonceCalled(ctx, address)
+}
+
 // GetMetrics -
 func (f *Facade) GetMetrics() map[string]*data.EndpointMetrics {
 	return f.GetMetricsCalled()
 }
 
+// GetCoalescedRequestsMetrics -
+func (f *Facade) GetCoalescedRequestsMetrics() map[string]uint64 {
+	if f.GetCoalescedRequestsMetricsCalled != nil {
+		return f.GetCoalescedRequestsMetricsCalled()
+	}
+
+	return nil
+}
+
+// GetApiKeysUsage -
+func (f *Facade) GetApiKeysUsage() map[string]*data.ApiKeyUsage {
+	if f.GetApiKeysUsageCalled != nil {
+		return f.GetApiKeysUsageCalled()
+	}
+
+	return nil
+}
+
 // GetMetricsForPrometheus -
 func (f *Facade) GetMetricsForPrometheus() string {
 	return f.GetPrometheusMetricsCalled()
 }
 
+// GetCircuitBreakersStatuses -
+func (f *Facade) GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus {
+	return f.GetCircuitBreakersStatusesCalled()
+}
+
+// GetQuarantinedNodes -
+func (f *Facade) GetQuarantinedNodes() []*data.QuarantinedNode {
+	return f.GetQuarantinedNodesCalled()
+}
+
+// GetNodesHealth -
+func (f *Facade) GetNodesHealth() []*data.NodeHealthStatus {
+	return f.GetNodesHealthCalled()
+}
+
 // GetGenesisNodesPubKeys -
-func (f *Facade) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetGenesisNodesPubKeys(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGenesisNodesPubKeysCalled()
 }
 
 // GetGasConfigs -
-func (f *Facade) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetGasConfigs(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGasConfigsCalled()
 }
 
 // GetEpochStartData -
-func (f *Facade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetEpochStartData(_ context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	return f.GetEpochStartDataCalled(epoch, shardID)
 }
 
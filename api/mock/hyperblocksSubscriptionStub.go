@@ -0,0 +1,35 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// HyperblocksSubscriptionStub -
+type HyperblocksSubscriptionStub struct {
+	HyperblocksCalled func() <-chan *data.Hyperblock
+	ErrCalled         func() error
+	CloseCalled       func()
+}
+
+// Hyperblocks -
+func (stub *HyperblocksSubscriptionStub) Hyperblocks() <-chan *data.Hyperblock {
+	if stub.HyperblocksCalled != nil {
+		return stub.HyperblocksCalled()
+	}
+
+	return nil
+}
+
+// Err -
+func (stub *HyperblocksSubscriptionStub) Err() error {
+	if stub.ErrCalled != nil {
+		return stub.ErrCalled()
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *HyperblocksSubscriptionStub) Close() {
+	if stub.CloseCalled != nil {
+		stub.CloseCalled()
+	}
+}
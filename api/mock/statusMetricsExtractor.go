@@ -6,7 +6,8 @@ import (
 
 // StatusMetricsExporterStub -
 type StatusMetricsExporterStub struct {
-	AddRequestDataCalled func(path string, withError bool, duration time.Duration)
+	AddRequestDataCalled   func(path string, withError bool, duration time.Duration)
+	AddApiKeyRequestCalled func(keyName string, path string, withError bool)
 }
 
 // AddRequestData -
@@ -16,6 +17,13 @@ func (s *StatusMetricsExporterStub) AddRequestData(path string, withError bool,
 	}
 }
 
+// AddApiKeyRequest -
+func (s *StatusMetricsExporterStub) AddApiKeyRequest(keyName string, path string, withError bool) {
+	if s.AddApiKeyRequestCalled != nil {
+		s.AddApiKeyRequestCalled(keyName, path, withError)
+	}
+}
+
 // IsInterfaceNil -
 func (s *StatusMetricsExporterStub) IsInterfaceNil() bool {
 	return s == nil
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// TokenValidatorStub -
+type TokenValidatorStub struct {
+	AuthorizeRequestCalled func(token string, route string) (*data.TokenAuthorization, error)
+}
+
+// AuthorizeRequest -
+func (s *TokenValidatorStub) AuthorizeRequest(token string, route string) (*data.TokenAuthorization, error) {
+	if s.AuthorizeRequestCalled != nil {
+		return s.AuthorizeRequestCalled(token, route)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (s *TokenValidatorStub) IsInterfaceNil() bool {
+	return s == nil
+}
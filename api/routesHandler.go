@@ -0,0 +1,182 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// ArgsRoutesHandler holds the arguments needed for creating a new routes handler. The API keys settings are taken
+// from GeneralConfig only once, as the API keys file is reloaded by the registry itself
+type ArgsRoutesHandler struct {
+	VersionsRegistry       data.VersionsRegistryHandler
+	ApiConfigParser        ApiConfigParser
+	GeneralConfig          *config.Config
+	CredentialsConfig      config.CredentialsConfig
+	TokenValidator         middleware.TokenValidatorHandler
+	StatusMetricsExtractor middleware.StatusMetricsExtractor
+	ApiKeysRegistry        middleware.ApiKeysRegistryHandler
+	StateStore             middleware.StateStoreHandler
+	IsProfileModeActivated bool
+}
+
+// routesHandler serves the requests with a gin engine which can be rebuilt while the server is running: reloading
+// the configs creates a new engine, with the routes and middlewares resulted from the new API configs, which replaces
+// the current one. The requests in progress are finished by the engine which started serving them
+type routesHandler struct {
+	apiConfigParser        ApiConfigParser
+	tokenValidator         middleware.TokenValidatorHandler
+	statusMetricsExtractor middleware.StatusMetricsExtractor
+	apiKeysConfig          config.ApiKeysConfig
+	apiKeysRegistry        middleware.ApiKeysRegistryHandler
+	stateStore             middleware.StateStoreHandler
+	isProfileModeActivated bool
+
+	mutEngine   sync.RWMutex
+	engine      *gin.Engine
+	versionsMap map[string]*data.VersionData
+}
+
+// NewRoutesHandler returns a new instance of routesHandler
+func NewRoutesHandler(args ArgsRoutesHandler) (*routesHandler, error) {
+	if check.IfNil(args.VersionsRegistry) {
+		return nil, ErrNilVersionsRegistry
+	}
+	if check.IfNil(args.ApiConfigParser) {
+		return nil, ErrNilApiConfigParser
+	}
+	if args.GeneralConfig == nil {
+		return nil, ErrNilConfig
+	}
+
+	versionsMap, err := args.VersionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	rh := &routesHandler{
+		apiConfigParser:        args.ApiConfigParser,
+		tokenValidator:         args.TokenValidator,
+		statusMetricsExtractor: args.StatusMetricsExtractor,
+		apiKeysConfig:          args.GeneralConfig.ApiKeys,
+		apiKeysRegistry:        args.ApiKeysRegistry,
+		stateStore:             args.StateStore,
+		isProfileModeActivated: args.IsProfileModeActivated,
+		versionsMap:            versionsMap,
+	}
+
+	rh.engine, err = rh.createEngine(versionsMap, args.GeneralConfig, args.CredentialsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return rh, nil
+}
+
+// ServeHTTP serves the request with the current engine
+func (rh *routesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rh.mutEngine.RLock()
+	engine := rh.engine
+	rh.mutEngine.RUnlock()
+
+	engine.ServeHTTP(w, r)
+}
+
+// PrepareReload loads the API configs and creates the engine resulted from them and from the provided configs. The
+// returned function replaces the current engine with the new one
+func (rh *routesHandler) PrepareReload(configs *config.ReloadableConfigs) (func(), error) {
+	versionsMap, err := rh.loadApiConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := rh.createEngine(versionsMap, configs.GeneralConfig, *configs.CredentialsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		rh.mutEngine.Lock()
+		rh.engine = engine
+		rh.versionsMap = versionsMap
+		rh.mutEngine.Unlock()
+	}, nil
+}
+
+// loadApiConfigs returns a copy of the versions map, in which the versions hold the API configs loaded again from
+// their files. The versions sharing a file will share the loaded config
+func (rh *routesHandler) loadApiConfigs() (map[string]*data.VersionData, error) {
+	rh.mutEngine.RLock()
+	currentVersionsMap := rh.versionsMap
+	rh.mutEngine.RUnlock()
+
+	apiConfigs := make(map[string]*data.ApiRoutesConfig)
+	versionsMap := make(map[string]*data.VersionData, len(currentVersionsMap))
+	for version, versionData := range currentVersionsMap {
+		if len(versionData.ApiConfigName) == 0 {
+			versionsMap[version] = versionData
+			continue
+		}
+
+		apiConfig, found := apiConfigs[versionData.ApiConfigName]
+		if !found {
+			var err error
+			apiConfig, err = rh.apiConfigParser.GetConfigForVersion(versionData.ApiConfigName)
+			if err != nil {
+				return nil, fmt.Errorf("%w while loading the API config %s", err, versionData.ApiConfigName)
+			}
+			apiConfigs[versionData.ApiConfigName] = apiConfig
+		}
+
+		versionsMap[version] = &data.VersionData{
+			Facade:        versionData.Facade,
+			ApiHandler:    versionData.ApiHandler,
+			ApiConfig:     *apiConfig,
+			ApiConfigName: versionData.ApiConfigName,
+		}
+	}
+
+	return versionsMap, nil
+}
+
+func (rh *routesHandler) createEngine(
+	versionsMap map[string]*data.VersionData,
+	generalConfig *config.Config,
+	credentialsConfig config.CredentialsConfig,
+) (*gin.Engine, error) {
+	ws := gin.Default()
+	ws.Use(cors.Default())
+
+	err := registerRoutes(
+		ws,
+		versionsMap,
+		generalConfig.ApiLogging,
+		credentialsConfig,
+		rh.tokenValidator,
+		rh.statusMetricsExtractor,
+		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
+		generalConfig.RateLimiter,
+		rh.apiKeysConfig,
+		rh.apiKeysRegistry,
+		rh.stateStore,
+		generalConfig.SessionAffinity,
+		rh.isProfileModeActivated,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *routesHandler) IsInterfaceNil() bool {
+	return rh == nil
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	"github.com/ElrondNetwork/elrond-proxy-go/versions"
+	"github.com/stretchr/testify/require"
+)
+
+func createActionsApiConfig(isOpen bool) *data.ApiRoutesConfig {
+	return &data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"actions": {
+				Routes: []data.RouteConfig{
+					{Name: "/reload-observers", Open: isOpen},
+				},
+			},
+		},
+	}
+}
+
+func createMockArgsRoutesHandler(t *testing.T, apiConfigParser ApiConfigParser) ArgsRoutesHandler {
+	facade := &mock.Facade{
+		ReloadObserversCalled: func() data.NodesReloadResponse {
+			return data.NodesReloadResponse{OkRequest: true}
+		},
+	}
+	apiHandler, err := NewApiHandler(facade)
+	require.NoError(t, err)
+
+	versionsRegistry := versions.NewVersionsRegistry()
+	err = versionsRegistry.AddVersion("v1.0", &data.VersionData{
+		Facade:        facade,
+		ApiHandler:    apiHandler,
+		ApiConfig:     *createActionsApiConfig(true),
+		ApiConfigName: "v1_0",
+	})
+	require.NoError(t, err)
+
+	generalConfig := &config.Config{}
+	generalConfig.GeneralSettings.RateLimitWindowDurationSeconds = 60
+
+	return ArgsRoutesHandler{
+		VersionsRegistry:       versionsRegistry,
+		ApiConfigParser:        apiConfigParser,
+		GeneralConfig:          generalConfig,
+		StatusMetricsExtractor: &mock.StatusMetricsExporterStub{},
+		StateStore:             statestore.NewMemoryStore(),
+	}
+}
+
+func sendReloadObserversRequest(rh *routesHandler) int {
+	req, _ := http.NewRequest(http.MethodPost, "/v1.0/actions/reload-observers", nil)
+	resp := httptest.NewRecorder()
+	rh.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewRoutesHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil versions registry should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoutesHandler(t, &mock.ApiConfigParserStub{})
+		args.VersionsRegistry = nil
+		rh, err := NewRoutesHandler(args)
+		require.Equal(t, ErrNilVersionsRegistry, err)
+		require.True(t, check.IfNil(rh))
+	})
+	t.Run("nil API config parser should err", func(t *testing.T) {
+		t.Parallel()
+
+		rh, err := NewRoutesHandler(createMockArgsRoutesHandler(t, nil))
+		require.Equal(t, ErrNilApiConfigParser, err)
+		require.True(t, check.IfNil(rh))
+	})
+	t.Run("nil config should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoutesHandler(t, &mock.ApiConfigParserStub{})
+		args.GeneralConfig = nil
+		rh, err := NewRoutesHandler(args)
+		require.Equal(t, ErrNilConfig, err)
+		require.True(t, check.IfNil(rh))
+	})
+	t.Run("invalid rate limit window should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoutesHandler(t, &mock.ApiConfigParserStub{})
+		args.GeneralConfig.GeneralSettings.RateLimitWindowDurationSeconds = 0
+		rh, err := NewRoutesHandler(args)
+		require.True(t, errors.Is(err, ErrInvalidRateLimitWindowDuration))
+		require.True(t, check.IfNil(rh))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rh, err := NewRoutesHandler(createMockArgsRoutesHandler(t, &mock.ApiConfigParserStub{}))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rh))
+		require.Equal(t, http.StatusOK, sendReloadObserversRequest(rh))
+	})
+}
+
+func TestRoutesHandler_PrepareReloadShouldReplaceEngineOnlyWhenApplied(t *testing.T) {
+	t.Parallel()
+
+	apiConfigParser := &mock.ApiConfigParserStub{
+		GetConfigForVersionCalled: func(version string) (*data.ApiRoutesConfig, error) {
+			require.Equal(t, "v1_0", version)
+			return createActionsApiConfig(false), nil
+		},
+	}
+	rh, _ := NewRoutesHandler(createMockArgsRoutesHandler(t, apiConfigParser))
+
+	configs := &config.ReloadableConfigs{
+		GeneralConfig:     &config.Config{},
+		CredentialsConfig: &config.CredentialsConfig{},
+	}
+	configs.GeneralConfig.GeneralSettings.RateLimitWindowDurationSeconds = 60
+
+	apply, err := rh.PrepareReload(configs)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, sendReloadObserversRequest(rh))
+
+	apply()
+	require.Equal(t, http.StatusNotFound, sendReloadObserversRequest(rh))
+}
+
+func TestRoutesHandler_PrepareReloadInvalidConfigsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid API config")
+	apiConfigParser := &mock.ApiConfigParserStub{}
+	rh, _ := NewRoutesHandler(createMockArgsRoutesHandler(t, apiConfigParser))
+
+	configs := &config.ReloadableConfigs{
+		GeneralConfig:     &config.Config{},
+		CredentialsConfig: &config.CredentialsConfig{},
+	}
+	apply, err := rh.PrepareReload(configs)
+	require.True(t, errors.Is(err, ErrInvalidRateLimitWindowDuration))
+	require.Nil(t, apply)
+
+	apiConfigParser.GetConfigForVersionCalled = func(version string) (*data.ApiRoutesConfig, error) {
+		return nil, expectedErr
+	}
+	configs.GeneralConfig.GeneralSettings.RateLimitWindowDurationSeconds = 60
+	apply, err = rh.PrepareReload(configs)
+	require.True(t, errors.Is(err, expectedErr))
+	require.Nil(t, apply)
+
+	require.Equal(t, http.StatusOK, sendReloadObserversRequest(rh))
+}
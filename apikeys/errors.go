@@ -0,0 +1,33 @@
+package apikeys
+
+import "errors"
+
+// ErrEmptyKeysFilePath signals that an empty API keys file path has been provided
+var ErrEmptyKeysFilePath = errors.New("empty API keys file path")
+
+// ErrInvalidReloadInterval signals that an invalid API keys file reload interval has been provided
+var ErrInvalidReloadInterval = errors.New("invalid reload interval")
+
+// ErrEmptyPlanName signals that a plan without a name has been provided
+var ErrEmptyPlanName = errors.New("empty plan name")
+
+// ErrDuplicatedPlan signals that several plans with the same name have been provided
+var ErrDuplicatedPlan = errors.New("duplicated plan")
+
+// ErrInvalidPlanLimits signals that invalid plan limits have been provided
+var ErrInvalidPlanLimits = errors.New("invalid plan limits: burst and rate must be both set or both unset")
+
+// ErrEmptyKeyName signals that an API key without a name has been provided
+var ErrEmptyKeyName = errors.New("empty API key name")
+
+// ErrDuplicatedKeyName signals that several API keys with the same name have been provided
+var ErrDuplicatedKeyName = errors.New("duplicated API key name")
+
+// ErrInvalidKeyHash signals that an API key hash which is not a hex encoded sha256 hash has been provided
+var ErrInvalidKeyHash = errors.New("invalid API key hash")
+
+// ErrDuplicatedKey signals that the same API key has been provided several times
+var ErrDuplicatedKey = errors.New("duplicated API key")
+
+// ErrUnknownPlan signals that an API key is mapped to a plan which does not exist
+var ErrUnknownPlan = errors.New("unknown plan")
@@ -0,0 +1,245 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("apikeys")
+
+// minReloadInterval represents the minimum interval between two checks of the API keys file
+const minReloadInterval = time.Second
+
+// ArgsKeysRegistry holds the arguments needed for creating a new keys registry
+type ArgsKeysRegistry struct {
+	FilePath       string
+	ReloadInterval time.Duration
+}
+
+type keyData struct {
+	name string
+	plan *plan
+}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// keysRegistry holds the API keys loaded from the keys file. The file holds only the sha256 hashes of the keys, so
+// the keys themselves are not stored on the proxy's machine. The file is checked periodically and reloaded when
+// modified. If the modified file is not valid, the previously loaded keys are kept
+type keysRegistry struct {
+	filePath         string
+	mutKeys          sync.RWMutex
+	keys             map[string]*keyData
+	loadedVersion    fileVersion
+	cancelReloadLoop func()
+}
+
+// NewKeysRegistry returns a new instance of keysRegistry
+func NewKeysRegistry(args ArgsKeysRegistry) (*keysRegistry, error) {
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyKeysFilePath
+	}
+	if args.ReloadInterval < minReloadInterval {
+		return nil, fmt.Errorf("%w: provided %v, minimum %v", ErrInvalidReloadInterval, args.ReloadInterval, minReloadInterval)
+	}
+
+	kr := &keysRegistry{
+		filePath: args.FilePath,
+	}
+
+	version, err := getFileVersion(args.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := loadKeys(args.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	kr.setKeys(keys, version)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	kr.cancelReloadLoop = cancel
+	go kr.reloadLoop(ctx, args.ReloadInterval)
+
+	return kr, nil
+}
+
+func (kr *keysRegistry) reloadLoop(ctx context.Context, reloadInterval time.Duration) {
+	timer := time.NewTimer(reloadInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			kr.reloadIfModified()
+			timer.Reset(reloadInterval)
+		case <-ctx.Done():
+			log.Debug("closing API keys registry reload loop")
+			return
+		}
+	}
+}
+
+func (kr *keysRegistry) reloadIfModified() {
+	version, err := getFileVersion(kr.filePath)
+	if err != nil {
+		log.Error("cannot check the API keys file", "file", kr.filePath, "error", err)
+		return
+	}
+
+	kr.mutKeys.RLock()
+	isModified := version != kr.loadedVersion
+	kr.mutKeys.RUnlock()
+	if !isModified {
+		return
+	}
+
+	keys, err := loadKeys(kr.filePath)
+	if err != nil {
+		log.Error("cannot reload the API keys file, the previous keys are kept", "file", kr.filePath, "error", err)
+
+		// the same invalid version of the file is not loaded again
+		kr.mutKeys.Lock()
+		kr.loadedVersion = version
+		kr.mutKeys.Unlock()
+		return
+	}
+
+	kr.setKeys(keys, version)
+}
+
+func (kr *keysRegistry) setKeys(keys map[string]*keyData, version fileVersion) {
+	kr.mutKeys.Lock()
+	kr.keys = keys
+	kr.loadedVersion = version
+	kr.mutKeys.Unlock()
+
+	log.Info("loaded API keys", "file", kr.filePath, "num keys", len(keys))
+}
+
+func getFileVersion(filePath string) (fileVersion, error) {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return fileVersion{}, err
+	}
+
+	return fileVersion{
+		modTime: fileInfo.ModTime(),
+		size:    fileInfo.Size(),
+	}, nil
+}
+
+// loadKeys loads and validates the plans and the keys from the provided file. The returned keys are mapped by their
+// hashes. The disabled keys are validated, but not returned
+func loadKeys(filePath string) (map[string]*keyData, error) {
+	cfg := &config.ApiKeysFileConfig{}
+	err := core.LoadTomlFile(cfg, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make(map[string]*plan)
+	for _, planCfg := range cfg.Plans {
+		p, errCreate := newPlan(planCfg)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		_, exists := plans[p.name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedPlan, p.name)
+		}
+		plans[p.name] = p
+	}
+
+	keys := make(map[string]*keyData)
+	keyNames := make(map[string]struct{})
+	keyHashes := make(map[string]struct{})
+	for _, keyCfg := range cfg.Keys {
+		if len(keyCfg.Name) == 0 {
+			return nil, ErrEmptyKeyName
+		}
+		_, exists := keyNames[keyCfg.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedKeyName, keyCfg.Name)
+		}
+		keyNames[keyCfg.Name] = struct{}{}
+
+		keyHash, errDecode := hex.DecodeString(keyCfg.KeyHash)
+		if errDecode != nil || len(keyHash) != sha256.Size {
+			return nil, fmt.Errorf("%w for API key %s", ErrInvalidKeyHash, keyCfg.Name)
+		}
+		// the hashes are compared in their lowercase hex form, regardless of how they were written in the file
+		normalizedHash := hex.EncodeToString(keyHash)
+		_, exists = keyHashes[normalizedHash]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedKey, keyCfg.Name)
+		}
+		keyHashes[normalizedHash] = struct{}{}
+
+		p, found := plans[keyCfg.Plan]
+		if !found {
+			return nil, fmt.Errorf("%w %s for API key %s", ErrUnknownPlan, keyCfg.Plan, keyCfg.Name)
+		}
+
+		if keyCfg.Disabled {
+			continue
+		}
+
+		keys[normalizedHash] = &keyData{
+			name: keyCfg.Name,
+			plan: p,
+		}
+	}
+
+	return keys, nil
+}
+
+// AuthorizeRequest returns the authorization of a request made with the provided API key towards the provided route,
+// which is in the /package/route form. It returns false if the API key is unknown or disabled
+func (kr *keysRegistry) AuthorizeRequest(apiKey string, route string) (*data.ApiKeyAuthorization, bool) {
+	hash := sha256.Sum256([]byte(apiKey))
+
+	kr.mutKeys.RLock()
+	key, found := kr.keys[hex.EncodeToString(hash[:])]
+	kr.mutKeys.RUnlock()
+	if !found {
+		return nil, false
+	}
+
+	limits := key.plan.getLimits(route)
+
+	return &data.ApiKeyAuthorization{
+		KeyName:        key.name,
+		PlanName:       key.plan.name,
+		IsRouteAllowed: key.plan.isRouteAllowed(route),
+		Burst:          limits.burst,
+		RatePerSecond:  limits.ratePerSecond,
+	}, true
+}
+
+// Close stops the reloading of the API keys file
+func (kr *keysRegistry) Close() error {
+	kr.cancelReloadLoop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kr *keysRegistry) IsInterfaceNil() bool {
+	return kr == nil
+}
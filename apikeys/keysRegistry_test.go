@@ -0,0 +1,269 @@
+package apikeys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPlans = `
+[[Plans]]
+   Name = "free"
+   AllowedRoutes = ["/address/*", "/network/config"]
+   Burst = 10
+   RatePerSecond = 1.0
+   Routes = [
+      { Name = "/address/:address/esdt", Burst = 2, RatePerSecond = 0.1 }
+   ]
+
+[[Plans]]
+   Name = "partner"
+   AllowedRoutes = ["*"]
+`
+
+func hashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func keyLine(name string, key string, plan string) string {
+	return fmt.Sprintf("[[Keys]]\n   Name = \"%s\"\n   KeyHash = \"%s\"\n   Plan = \"%s\"\n", name, hashKey(key), plan)
+}
+
+func writeKeysFile(t *testing.T, filePath string, contents ...string) {
+	err := os.WriteFile(filePath, []byte(strings.Join(contents, "\n")), 0644)
+	require.NoError(t, err)
+}
+
+func createKeysRegistry(t *testing.T, contents ...string) (*keysRegistry, string) {
+	filePath := filepath.Join(t.TempDir(), "apiKeys.toml")
+	writeKeysFile(t, filePath, contents...)
+
+	kr, err := NewKeysRegistry(ArgsKeysRegistry{
+		FilePath:       filePath,
+		ReloadInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = kr.Close()
+	})
+
+	return kr, filePath
+}
+
+func TestNewKeysRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should err", func(t *testing.T) {
+		t.Parallel()
+
+		kr, err := NewKeysRegistry(ArgsKeysRegistry{ReloadInterval: time.Second})
+		require.Equal(t, ErrEmptyKeysFilePath, err)
+		require.True(t, check.IfNil(kr))
+	})
+	t.Run("invalid reload interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		kr, err := NewKeysRegistry(ArgsKeysRegistry{FilePath: "apiKeys.toml", ReloadInterval: time.Millisecond})
+		require.True(t, errors.Is(err, ErrInvalidReloadInterval))
+		require.True(t, check.IfNil(kr))
+	})
+	t.Run("missing file should err", func(t *testing.T) {
+		t.Parallel()
+
+		kr, err := NewKeysRegistry(ArgsKeysRegistry{
+			FilePath:       filepath.Join(t.TempDir(), "missing.toml"),
+			ReloadInterval: time.Second,
+		})
+		require.Error(t, err)
+		require.True(t, check.IfNil(kr))
+	})
+	t.Run("invalid file should err", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "apiKeys.toml")
+		writeKeysFile(t, filePath, testPlans, keyLine("alice", "key0", "gold"))
+		kr, err := NewKeysRegistry(ArgsKeysRegistry{FilePath: filePath, ReloadInterval: time.Second})
+		require.True(t, errors.Is(err, ErrUnknownPlan))
+		require.True(t, check.IfNil(kr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		kr, _ := createKeysRegistry(t, testPlans, keyLine("alice", "key0", "free"))
+		require.False(t, check.IfNil(kr))
+	})
+}
+
+func TestLoadKeys_InvalidFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contents    string
+		expectedErr error
+	}{
+		{
+			name:        "plan without name",
+			contents:    "[[Plans]]\n   AllowedRoutes = [\"*\"]\n",
+			expectedErr: ErrEmptyPlanName,
+		},
+		{
+			name:        "duplicated plan",
+			contents:    testPlans + "[[Plans]]\n   Name = \"free\"\n",
+			expectedErr: ErrDuplicatedPlan,
+		},
+		{
+			name:        "burst without rate",
+			contents:    "[[Plans]]\n   Name = \"free\"\n   Burst = 5\n",
+			expectedErr: ErrInvalidPlanLimits,
+		},
+		{
+			name:        "route rate without burst",
+			contents:    "[[Plans]]\n   Name = \"free\"\n   Routes = [{ Name = \"/network/config\", RatePerSecond = 1.0 }]\n",
+			expectedErr: ErrInvalidPlanLimits,
+		},
+		{
+			name:        "invalid allowed route",
+			contents:    "[[Plans]]\n   Name = \"free\"\n   AllowedRoutes = [\"address\"]\n",
+			expectedErr: common.ErrInvalidAllowedRoute,
+		},
+		{
+			name:        "misplaced wildcard",
+			contents:    "[[Plans]]\n   Name = \"free\"\n   AllowedRoutes = [\"/address/*/nonce\"]\n",
+			expectedErr: common.ErrInvalidAllowedRoute,
+		},
+		{
+			name:        "key without name",
+			contents:    testPlans + keyLine("", "key0", "free"),
+			expectedErr: ErrEmptyKeyName,
+		},
+		{
+			name:        "duplicated key name",
+			contents:    testPlans + keyLine("alice", "key0", "free") + keyLine("alice", "key1", "free"),
+			expectedErr: ErrDuplicatedKeyName,
+		},
+		{
+			name:        "duplicated key",
+			contents:    testPlans + keyLine("alice", "key0", "free") + keyLine("bob", "key0", "free"),
+			expectedErr: ErrDuplicatedKey,
+		},
+		{
+			name:        "invalid key hash",
+			contents:    testPlans + "[[Keys]]\n   Name = \"alice\"\n   KeyHash = \"abcd\"\n   Plan = \"free\"\n",
+			expectedErr: ErrInvalidKeyHash,
+		},
+	}
+
+	for _, tt := range tests {
+		filePath := filepath.Join(t.TempDir(), "apiKeys.toml")
+		writeKeysFile(t, filePath, tt.contents)
+
+		keys, err := loadKeys(filePath)
+		assert.True(t, errors.Is(err, tt.expectedErr), "%s: %v", tt.name, err)
+		assert.Nil(t, keys, tt.name)
+	}
+}
+
+func TestKeysRegistry_AuthorizeRequest(t *testing.T) {
+	t.Parallel()
+
+	disabledKey := keyLine("carol", "key2", "partner") + "   Disabled = true\n"
+	kr, _ := createKeysRegistry(t, testPlans, keyLine("alice", "key0", "free"), keyLine("bob", "key1", "partner"), disabledKey)
+
+	authorization, found := kr.AuthorizeRequest("key0", "/address/:address")
+	require.True(t, found)
+	assert.Equal(t, "alice", authorization.KeyName)
+	assert.Equal(t, "free", authorization.PlanName)
+	assert.True(t, authorization.IsRouteAllowed)
+	assert.Equal(t, uint64(10), authorization.Burst)
+	assert.Equal(t, 1.0, authorization.RatePerSecond)
+
+	authorization, _ = kr.AuthorizeRequest("key0", "/address/:address/esdt")
+	assert.True(t, authorization.IsRouteAllowed)
+	assert.Equal(t, uint64(2), authorization.Burst)
+	assert.Equal(t, 0.1, authorization.RatePerSecond)
+
+	authorization, _ = kr.AuthorizeRequest("key0", "/network/config")
+	assert.True(t, authorization.IsRouteAllowed)
+
+	authorization, _ = kr.AuthorizeRequest("key0", "/network/esdts")
+	assert.False(t, authorization.IsRouteAllowed)
+
+	authorization, _ = kr.AuthorizeRequest("key0", "/transaction/send")
+	assert.False(t, authorization.IsRouteAllowed)
+
+	authorization, found = kr.AuthorizeRequest("key1", "/transaction/send")
+	require.True(t, found)
+	assert.Equal(t, "bob", authorization.KeyName)
+	assert.True(t, authorization.IsRouteAllowed)
+	assert.Equal(t, uint64(0), authorization.Burst)
+
+	_, found = kr.AuthorizeRequest("key2", "/transaction/send")
+	assert.False(t, found)
+
+	_, found = kr.AuthorizeRequest("unknown", "/transaction/send")
+	assert.False(t, found)
+}
+
+func TestKeysRegistry_ReloadIfModified(t *testing.T) {
+	t.Parallel()
+
+	kr, filePath := createKeysRegistry(t, testPlans, keyLine("alice", "key0", "free"))
+
+	// not modified
+	kr.reloadIfModified()
+	_, found := kr.AuthorizeRequest("key0", "/network/config")
+	assert.True(t, found)
+
+	writeKeysFile(t, filePath, testPlans, keyLine("alice", "key0", "free"), keyLine("bob", "key1", "partner"))
+	kr.reloadIfModified()
+	_, found = kr.AuthorizeRequest("key1", "/network/config")
+	assert.True(t, found)
+
+	// an invalid file should not replace the loaded keys
+	writeKeysFile(t, filePath, testPlans, keyLine("alice", "key0", "gold"))
+	kr.reloadIfModified()
+	_, found = kr.AuthorizeRequest("key0", "/network/config")
+	assert.True(t, found)
+	_, found = kr.AuthorizeRequest("key1", "/network/config")
+	assert.True(t, found)
+
+	// revoked key
+	writeKeysFile(t, filePath, testPlans, keyLine("bob", "key1", "partner"))
+	kr.reloadIfModified()
+	_, found = kr.AuthorizeRequest("key0", "/network/config")
+	assert.False(t, found)
+}
+
+func TestKeysRegistry_ReloadLoopShouldReloadModifiedFile(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "apiKeys.toml")
+	writeKeysFile(t, filePath, testPlans)
+	kr, err := NewKeysRegistry(ArgsKeysRegistry{
+		FilePath:       filePath,
+		ReloadInterval: time.Second,
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = kr.Close()
+	}()
+
+	writeKeysFile(t, filePath, testPlans, keyLine("alice", "key0", "free"))
+	assert.Eventually(t, func() bool {
+		_, found := kr.AuthorizeRequest("key0", "/network/config")
+		return found
+	}, 5*time.Second, 100*time.Millisecond)
+}
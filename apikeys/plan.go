@@ -0,0 +1,79 @@
+package apikeys
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+type routeLimits struct {
+	burst         uint64
+	ratePerSecond float64
+}
+
+// plan holds the routes an API key can access and their limits. The routes are in the /package/route form, as
+// defined in the API config files
+type plan struct {
+	name          string
+	routesMatcher *common.RoutesMatcher
+	defaultLimits routeLimits
+	routesLimits  map[string]routeLimits
+}
+
+func newPlan(cfg config.ApiKeyPlanConfig) (*plan, error) {
+	if len(cfg.Name) == 0 {
+		return nil, ErrEmptyPlanName
+	}
+
+	routesMatcher, err := common.NewRoutesMatcher(cfg.AllowedRoutes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for plan %s", err, cfg.Name)
+	}
+
+	p := &plan{
+		name:          cfg.Name,
+		routesMatcher: routesMatcher,
+		routesLimits:  make(map[string]routeLimits),
+	}
+
+	p.defaultLimits, err = newRouteLimits(cfg.Burst, cfg.RatePerSecond)
+	if err != nil {
+		return nil, fmt.Errorf("%w for plan %s", err, cfg.Name)
+	}
+
+	for _, routeCfg := range cfg.Routes {
+		p.routesLimits[routeCfg.Name], err = newRouteLimits(routeCfg.Burst, routeCfg.RatePerSecond)
+		if err != nil {
+			return nil, fmt.Errorf("%w for plan %s, route %s", err, cfg.Name, routeCfg.Name)
+		}
+	}
+
+	return p, nil
+}
+
+func newRouteLimits(burst uint64, ratePerSecond float64) (routeLimits, error) {
+	isBurstSet := burst > 0
+	isRateSet := ratePerSecond > 0
+	if isBurstSet != isRateSet || ratePerSecond < 0 {
+		return routeLimits{}, ErrInvalidPlanLimits
+	}
+
+	return routeLimits{
+		burst:         burst,
+		ratePerSecond: ratePerSecond,
+	}, nil
+}
+
+func (p *plan) isRouteAllowed(route string) bool {
+	return p.routesMatcher.IsRouteAllowed(route)
+}
+
+func (p *plan) getLimits(route string) routeLimits {
+	limits, found := p.routesLimits[route]
+	if found {
+		return limits
+	}
+
+	return p.defaultLimits
+}
@@ -20,14 +20,29 @@ import (
 	erdConfig "github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-proxy-go/apikeys"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/configreload"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery"
+	"github.com/ElrondNetwork/elrond-proxy-go/jwtauth"
 	"github.com/ElrondNetwork/elrond-proxy-go/metrics"
 	"github.com/ElrondNetwork/elrond-proxy-go/observer"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/affinity"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/circuitbreaker"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/coalescing"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/database"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	processFactory "github.com/ElrondNetwork/elrond-proxy-go/process/factory"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/hedging"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/hyperblocks"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/noncemanager"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/sanitycheck"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/txtracker"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
 	"github.com/ElrondNetwork/elrond-proxy-go/testing"
 	versionsFactory "github.com/ElrondNetwork/elrond-proxy-go/versions/factory"
 	"github.com/urfave/cli"
@@ -105,6 +120,14 @@ VERSION:
 		Value: "./config/apiConfig/credentials.toml",
 	}
 
+	// apiKeysConfigFile defines a flag for the path to the API keys toml configuration file
+	apiKeysConfigFile = cli.StringFlag{
+		Name: "config-api-keys",
+		Usage: "The path for the API keys configuration file. This TOML file contains" +
+			" the plans and the hashes of the API keys mapped to them.",
+		Value: "./config/apiConfig/apiKeys.toml",
+	}
+
 	// apiConfigDirectory defines a flag for the path to the api configuration directory
 	apiConfigDirectory = cli.StringFlag{
 		Name: "api-config-directory",
@@ -163,6 +186,7 @@ func main() {
 		configurationFile,
 		externalConfigFile,
 		credentialsConfigFile,
+		apiKeysConfigFile,
 		apiConfigDirectory,
 		profileMode,
 		walletKeyPemFile,
@@ -266,12 +290,60 @@ func startProxy(ctx *cli.Context) error {
 
 	statusMetricsProvider := metrics.NewStatusMetrics()
 
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, externalConfig, statusMetricsProvider, closableComponents)
+	stateStore, err := statestore.CreateStateStore(generalConfig.StateStore)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(stateStore)
+	log.Info("initialized state store", "type", generalConfig.StateStore.Type)
+
+	apiKeysRegistry, err := createApiKeysRegistry(generalConfig.ApiKeys, ctx.GlobalString(apiKeysConfigFile.Name), closableComponents)
+	if err != nil {
+		return err
+	}
+
+	tokenValidator, err := createTokenValidator(generalConfig.JwtAuthentication)
 	if err != nil {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, ctx, generalConfig, *credentialsConfig, statusMetricsProvider, isProfileModeActivated)
+	configReloader, err := createConfigReloader(
+		generalConfig.ConfigReload,
+		configurationFileName,
+		credentialsConfigurationFileName,
+		ctx.GlobalString(apiConfigDirectory.Name),
+		closableComponents,
+	)
+	if err != nil {
+		return err
+	}
+
+	versionsRegistry, err := createVersionsRegistryTestOrProduction(
+		ctx,
+		generalConfig,
+		configurationFileName,
+		externalConfig,
+		statusMetricsProvider,
+		createSharedCacheStore(generalConfig.StateStore, stateStore),
+		configReloader,
+		closableComponents,
+	)
+	if err != nil {
+		return err
+	}
+
+	httpServer, err := startWebServer(
+		versionsRegistry,
+		ctx,
+		generalConfig,
+		*credentialsConfig,
+		tokenValidator,
+		statusMetricsProvider,
+		apiKeysRegistry,
+		stateStore,
+		configReloader,
+		isProfileModeActivated,
+	)
 	if err != nil {
 		return err
 	}
@@ -312,6 +384,8 @@ func createVersionsRegistryTestOrProduction(
 	configurationFilePath string,
 	exCfg *erdConfig.ExternalConfig,
 	statusMetricsHandler data.StatusMetricsProvider,
+	sharedCacheStore cache.SharedStoreHandler,
+	configReloader configreload.ConfigReloaderHandler,
 	closableComponents *data.ClosableComponentsHandler,
 ) (data.VersionsRegistryHandler, error) {
 
@@ -331,6 +405,7 @@ func createVersionsRegistryTestOrProduction(
 				HeartbeatCacheValidityDurationSec:        60,
 				ValStatsCacheValidityDurationSec:         60,
 				EconomicsMetricsCacheValidityDurationSec: 6,
+				ConfigsCacheValidityDurationSec:          6,
 				FaucetValue:                              "10000000000",
 			},
 			ApiLogging: config.ApiLoggingConfig{
@@ -375,8 +450,10 @@ func createVersionsRegistryTestOrProduction(
 			configurationFilePath,
 			exCfg,
 			statusMetricsHandler,
+			sharedCacheStore,
 			ctx.GlobalString(walletKeyPemFile.Name),
 			ctx.GlobalString(apiConfigDirectory.Name),
+			configReloader,
 			closableComponents,
 		)
 	}
@@ -386,8 +463,10 @@ func createVersionsRegistryTestOrProduction(
 		configurationFilePath,
 		exCfg,
 		statusMetricsHandler,
+		sharedCacheStore,
 		ctx.GlobalString(walletKeyPemFile.Name),
 		ctx.GlobalString(apiConfigDirectory.Name),
+		configReloader,
 		closableComponents,
 	)
 }
@@ -397,8 +476,10 @@ func createVersionsRegistry(
 	configurationFilePath string,
 	exCfg *erdConfig.ExternalConfig,
 	statusMetricsHandler data.StatusMetricsProvider,
+	sharedCacheStore cache.SharedStoreHandler,
 	pemFileLocation string,
 	apiConfigDirectoryPath string,
+	configReloader configreload.ConfigReloaderHandler,
 	closableComponents *data.ClosableComponentsHandler,
 ) (data.VersionsRegistryHandler, error) {
 	pubKeyConverter, err := factory.NewPubkeyConverter(cfg.AddressPubkeyConverter)
@@ -415,6 +496,11 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	nodesDiscoverer, err := discoverNodesIfNeeded(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	shardCoord, err := getShardCoordinator(cfg)
 	if err != nil {
 		return nil, err
@@ -437,12 +523,40 @@ func createVersionsRegistry(
 		}
 	}
 
+	if !check.IfNil(nodesDiscoverer) {
+		err = nodesDiscoverer.StartUpdatingNodes(observersProvider, fullHistoryNodesProvider)
+		if err != nil {
+			return nil, err
+		}
+		closableComponents.Add(nodesDiscoverer)
+	}
+
+	circuitBreaker, err := createCircuitBreaker(cfg.CircuitBreaker)
+	if err != nil {
+		return nil, err
+	}
+
+	requestsCoalescer, err := createRequestsCoalescer(cfg.RequestsCoalescing, statusMetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesSanityChecker, err := createNodesSanityChecker(cfg.NodesSanityCheck, shardCoord)
+	if err != nil {
+		return nil, err
+	}
+
 	bp, err := process.NewBaseProcessor(
 		cfg.GeneralSettings.RequestTimeoutSec,
+		cfg.HttpClient,
+		cfg.NodesSyncCheck,
 		shardCoord,
 		observersProvider,
 		fullHistoryNodesProvider,
 		pubKeyConverter,
+		circuitBreaker,
+		requestsCoalescer,
+		nodesSanityChecker,
 	)
 	if err != nil {
 		return nil, err
@@ -454,7 +568,22 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, connector)
+	requestsHedger, err := createRequestsHedger(cfg.RequestsHedging)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsCache, err := createResponseCache(cfg.ResponseCache, "accounts", statusMetricsHandler, sharedCacheStore)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionAffinity, err := createSessionAffinity(cfg.SessionAffinity)
+	if err != nil {
+		return nil, err
+	}
+
+	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, connector, requestsHedger, accountsCache, sessionAffinity)
 	if err != nil {
 		return nil, err
 	}
@@ -466,53 +595,69 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	txProc, err := processFactory.CreateTransactionProcessor(
-		bp,
-		pubKeyConverter,
-		hasher,
-		marshalizer,
-		cfg.GeneralSettings.AllowEntireTxPoolFetch,
-	)
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter, requestsHedger)
 	if err != nil {
 		return nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	ttlCache, err := cache.NewTTLCache(cache.ArgsTTLCache{
+		Name:           "api-responses",
+		MetricsHandler: statusMetricsHandler,
+		SharedStore:    sharedCacheStore,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	htbCacher := cache.NewHeartbeatMemoryCacher()
 	cacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
-
-	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, cacheValidity)
+	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, ttlCache, cacheValidity)
 	if err != nil {
 		return nil, err
 	}
 
-	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
 	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
-
-	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
+	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, ttlCache, cacheValidity)
 	if err != nil {
 		return nil, err
 	}
 
-	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
 	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+	configsCacheValidity := time.Duration(cfg.GeneralSettings.ConfigsCacheValidityDurationSec) * time.Second
+	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, ttlCache, cacheValidity, configsCacheValidity)
+	if err != nil {
+		return nil, err
+	}
 
-	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity)
+	closableComponents.Add(ttlCache, bp)
+
+	ttlCache.StartRefresh()
+
+	txsCache, err := createResponseCache(cfg.ResponseCache, "transactions", statusMetricsHandler, sharedCacheStore)
 	if err != nil {
 		return nil, err
 	}
 
-	closableComponents.Add(nodeGroupProc, valStatsProc, nodeStatusProc, bp)
+	txProc, err := processFactory.CreateTransactionProcessor(
+		bp,
+		pubKeyConverter,
+		hasher,
+		marshalizer,
+		cfg.GeneralSettings.AllowEntireTxPoolFetch,
+		txsCache,
+		sessionAffinity,
+		cfg.GeneralSettings.VerifyTransactionsSignatures,
+		nodeStatusProc,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	nodeGroupProc.StartCacheUpdate()
-	valStatsProc.StartCacheUpdate()
-	nodeStatusProc.StartCacheUpdate()
+	blocksCache, err := createResponseCache(cfg.ResponseCache, "blocks", statusMetricsHandler, sharedCacheStore)
+	if err != nil {
+		return nil, err
+	}
 
-	blockProc, err := process.NewBlockProcessor(connector, bp)
+	blockProc, err := process.NewBlockProcessor(connector, bp, blocksCache)
 	if err != nil {
 		return nil, err
 	}
@@ -537,8 +682,37 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	actionsProc, err := process.NewActionsProcessor(bp, configReloader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerReloadableComponents(configReloader, nodeGroupProc, valStatsProc, nodeStatusProc)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblocksNotifier, err := createHyperblocksNotifier(cfg.HyperblocksSubscriptions, nodeStatusProc, blockProc)
+	if err != nil {
+		return nil, err
+	}
+
+	closableComponents.Add(hyperblocksNotifier)
+
+	txsTracker, err := createTransactionsTracker(cfg.TransactionsTracker, txProc)
+	if err != nil {
+		return nil, err
+	}
+
+	closableComponents.Add(txsTracker)
+
+	nonceManager, err := createNonceManager(cfg.NonceReservations, accntProc, txProc)
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
-		ActionsProcessor:             bp,
+		ActionsProcessor:             actionsProc,
 		AccountProcessor:             accntProc,
 		FaucetProcessor:              faucetProc,
 		BlockProcessor:               blockProc,
@@ -552,6 +726,9 @@ func createVersionsRegistry(
 		PubKeyConverter:              pubKeyConverter,
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
+		HyperblocksNotifier:          hyperblocksNotifier,
+		TransactionsTracker:          txsTracker,
+		NonceManager:                 nonceManager,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
@@ -574,6 +751,249 @@ func createElasticSearchConnector(exCfg *erdConfig.ExternalConfig) (process.Exte
 	)
 }
 
+func createRequestsHedger(cfg config.RequestsHedgingConfig) (process.RequestsHedgerHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.RequestsHedger{}, nil
+	}
+
+	return hedging.NewRequestsHedger(hedging.ArgsRequestsHedger{
+		Percentile: cfg.Percentile,
+		MinDelay:   time.Duration(cfg.MinDelayMs) * time.Millisecond,
+		MaxDelay:   time.Duration(cfg.MaxDelayMs) * time.Millisecond,
+		NumSamples: cfg.NumSamples,
+	})
+}
+
+func createSessionAffinity(cfg config.SessionAffinityConfig) (process.SessionAffinityHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.SessionAffinity{}, nil
+	}
+	if cfg.IdentifyBy == affinity.SessionIdentification && len(cfg.SessionHeader) == 0 {
+		return nil, fmt.Errorf("%w: the session header is needed for identifying the clients by session",
+			affinity.ErrInvalidIdentificationMethod)
+	}
+
+	return affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+		WindowDuration: time.Duration(cfg.WindowDurationSec) * time.Second,
+		IdentifyBy:     cfg.IdentifyBy,
+	})
+}
+
+func createHyperblocksNotifier(
+	cfg config.HyperblocksSubscriptionsConfig,
+	nonceProvider hyperblocks.LatestHyperblockNonceProvider,
+	hyperblocksProvider hyperblocks.HyperblocksProvider,
+) (process.HyperblocksNotifierHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.HyperblocksNotifier{}, nil
+	}
+
+	return hyperblocks.NewHyperblocksNotifier(hyperblocks.ArgsHyperblocksNotifier{
+		NonceProvider:        nonceProvider,
+		HyperblocksProvider:  hyperblocksProvider,
+		PollInterval:         time.Duration(cfg.PollIntervalMs) * time.Millisecond,
+		MaxSubscribers:       cfg.MaxSubscribers,
+		MaxResumeNonces:      cfg.MaxResumeNonces,
+		SubscriberBufferSize: cfg.SubscriberBufferSize,
+	})
+}
+
+func createTransactionsTracker(
+	cfg config.TransactionsTrackerConfig,
+	txProvider txtracker.TransactionProvider,
+) (process.TransactionsTrackerHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.TransactionsTracker{}, nil
+	}
+
+	return txtracker.NewTransactionsTracker(txtracker.ArgsTransactionsTracker{
+		TransactionProvider:    txProvider,
+		PollInterval:           time.Duration(cfg.PollIntervalMs) * time.Millisecond,
+		MaxWaitTimeout:         time.Duration(cfg.MaxWaitTimeoutSec) * time.Second,
+		MaxTrackedTransactions: cfg.MaxTrackedTransactions,
+	})
+}
+
+func createNonceManager(
+	cfg config.NonceReservationsConfig,
+	accountProvider noncemanager.AccountProvider,
+	poolNoncesProvider noncemanager.PoolNoncesProvider,
+) (process.NonceManagerHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.NonceManager{}, nil
+	}
+
+	return noncemanager.NewNonceManager(noncemanager.ArgsNonceManager{
+		AccountProvider:          accountProvider,
+		PoolNoncesProvider:       poolNoncesProvider,
+		ReservationTTL:           time.Duration(cfg.ReservationTTLSec) * time.Second,
+		MaxReservationsPerSender: cfg.MaxReservationsPerSender,
+		MaxSenders:               cfg.MaxSenders,
+	})
+}
+
+func createRequestsCoalescer(
+	cfg config.RequestsCoalescingConfig,
+	metricsHandler coalescing.CoalescingMetricsHandler,
+) (process.RequestsCoalescerHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.RequestsCoalescer{}, nil
+	}
+
+	return coalescing.NewRequestsCoalescer(metricsHandler)
+}
+
+func createResponseCache(
+	cfg config.ResponseCacheConfig,
+	name string,
+	metricsHandler cache.CacheMetricsHandler,
+	sharedStore cache.SharedStoreHandler,
+) (process.ResponseCacheHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.ResponseCache{}, nil
+	}
+
+	return cache.NewResponseCache(cache.ArgsResponseCache{
+		Name:           name,
+		MaxNumEntries:  cfg.MaxNumEntries,
+		MaxSizeInBytes: int64(cfg.MaxSizeInMB) * 1024 * 1024,
+		MetricsHandler: metricsHandler,
+		SharedStore:    sharedStore,
+	})
+}
+
+// createSharedCacheStore returns the store through which the cached values are shared. If the state store is local
+// to this proxy instance, the cached values are not stored twice
+func createSharedCacheStore(cfg config.StateStoreConfig, stateStore statestore.StateStoreHandler) cache.SharedStoreHandler {
+	if !statestore.IsShared(cfg) {
+		return &disabled.SharedStore{}
+	}
+
+	return stateStore
+}
+
+// createApiKeysRegistry returns nil if the API keys are not enabled, as the API keys middleware is not used
+func createApiKeysRegistry(
+	cfg config.ApiKeysConfig,
+	filePath string,
+	closableComponents *data.ClosableComponentsHandler,
+) (middleware.ApiKeysRegistryHandler, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	apiKeysRegistry, err := apikeys.NewKeysRegistry(apikeys.ArgsKeysRegistry{
+		FilePath:       filePath,
+		ReloadInterval: time.Duration(cfg.ReloadIntervalSec) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(apiKeysRegistry)
+
+	return apiKeysRegistry, nil
+}
+
+// createTokenValidator returns nil if the JWT authentication is not enabled, as no API config can select it
+func createTokenValidator(cfg config.JwtAuthenticationConfig) (middleware.TokenValidatorHandler, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	return jwtauth.NewTokenValidator(cfg)
+}
+
+// createConfigReloader returns the disabled config reloader if the config reload is not enabled, so the configs can
+// not be reloaded by request either
+func createConfigReloader(
+	cfg config.ConfigReloadConfig,
+	configFilePath string,
+	credentialsFilePath string,
+	apiConfigDirectoryPath string,
+	closableComponents *data.ClosableComponentsHandler,
+) (configreload.ConfigReloaderHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.ConfigReloader{}, nil
+	}
+
+	watchInterval := time.Duration(0)
+	if cfg.WatchFiles {
+		watchInterval = time.Duration(cfg.WatchIntervalSec) * time.Second
+	}
+
+	configReloader, err := configreload.NewConfigReloader(configreload.ArgsConfigReloader{
+		ConfigFilePath:      configFilePath,
+		CredentialsFilePath: credentialsFilePath,
+		ApiConfigDirectory:  apiConfigDirectoryPath,
+		WatchInterval:       watchInterval,
+	})
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(configReloader)
+
+	return configReloader, nil
+}
+
+func registerReloadableComponents(configReloader configreload.ConfigReloaderHandler, components ...configreload.ReloadableComponent) error {
+	for _, component := range components {
+		err := configReloader.RegisterComponent(component)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discoverNodesIfNeeded replaces the observers and the full history nodes of the config with the discovered ones, if
+// the discovery is enabled. The returned discoverer keeps the nodes providers up to date afterwards
+func discoverNodesIfNeeded(cfg *config.Config) (discovery.NodesDiscovererHandler, error) {
+	if !cfg.ObserversDiscovery.Enabled {
+		return nil, nil
+	}
+
+	nodesDiscoverer, err := discovery.CreateNodesDiscoverer(cfg.ObserversDiscovery)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Observers, cfg.FullHistoryNodes, err = nodesDiscoverer.DiscoverNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("discovered the nodes", "type", cfg.ObserversDiscovery.Type,
+		"num observers", len(cfg.Observers), "num full history nodes", len(cfg.FullHistoryNodes))
+
+	return nodesDiscoverer, nil
+}
+
+func createCircuitBreaker(cfg config.CircuitBreakerConfig) (process.CircuitBreakerHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.CircuitBreaker{}, nil
+	}
+
+	return circuitbreaker.NewNodesCircuitBreaker(
+		cfg.FailureThreshold,
+		time.Duration(cfg.CooldownDurationSec)*time.Second,
+	)
+}
+
+func createNodesSanityChecker(
+	cfg config.NodesSanityCheckConfig,
+	shardCoordinator sharding.Coordinator,
+) (process.NodesSanityCheckerHandler, error) {
+	if !cfg.Enabled {
+		return &disabled.NodesSanityChecker{}, nil
+	}
+
+	return sanitycheck.NewNodesSanityChecker(sanitycheck.ArgsNodesSanityChecker{
+		ExpectedChainID: cfg.ExpectedChainID,
+		NumShards:       shardCoordinator.NumberOfShards(),
+	})
+}
+
 func getShardCoordinator(cfg *config.Config) (sharding.Coordinator, error) {
 	maxShardID := uint32(0)
 	for _, obs := range cfg.Observers {
@@ -597,28 +1017,39 @@ func startWebServer(
 	cliContext *cli.Context,
 	generalConfig *config.Config,
 	credentialsConfig config.CredentialsConfig,
+	tokenValidator middleware.TokenValidatorHandler,
 	statusMetricsProvider data.StatusMetricsProvider,
+	apiKeysRegistry middleware.ApiKeysRegistryHandler,
+	stateStore middleware.StateStoreHandler,
+	configReloader configreload.ConfigReloaderHandler,
 	isProfileModeActivated bool,
 ) (*http.Server, error) {
-	var err error
-	var httpServer *http.Server
+	apiConfigParser, err := versionsFactory.NewApiConfigParser(cliContext.GlobalString(apiConfigDirectory.Name))
+	if err != nil {
+		return nil, err
+	}
 
-	port := generalConfig.GeneralSettings.ServerPort
+	routesHandler, err := api.NewRoutesHandler(api.ArgsRoutesHandler{
+		VersionsRegistry:       versionsRegistry,
+		ApiConfigParser:        apiConfigParser,
+		GeneralConfig:          generalConfig,
+		CredentialsConfig:      credentialsConfig,
+		TokenValidator:         tokenValidator,
+		StatusMetricsExtractor: statusMetricsProvider,
+		ApiKeysRegistry:        apiKeysRegistry,
+		StateStore:             stateStore,
+		IsProfileModeActivated: isProfileModeActivated,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	if generalConfig.GeneralSettings.RateLimitWindowDurationSeconds <= 0 {
-		return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
-			"than zero", generalConfig.GeneralSettings.RateLimitWindowDurationSeconds)
+	err = configReloader.RegisterComponent(routesHandler)
+	if err != nil {
+		return nil, err
 	}
-	httpServer, err = api.CreateServer(
-		versionsRegistry,
-		port,
-		generalConfig.ApiLogging,
-		credentialsConfig,
-		statusMetricsProvider,
-		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
-		isProfileModeActivated,
-	)
 
+	httpServer, err := api.CreateServer(generalConfig.GeneralSettings.ServerPort, routesHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -634,7 +1065,7 @@ func startWebServer(
 }
 
 func waitForServerShutdown(httpServer *http.Server, closableComponents *data.ClosableComponentsHandler) {
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
 	<-quit
 
@@ -0,0 +1,40 @@
+package common
+
+import "context"
+
+type contextKey string
+
+const hedgingAllowedKey contextKey = "hedgingAllowed"
+
+// WithHedgingAllowed returns a copy of the provided context which marks that the requests made on its behalf towards
+// the observers can be hedged. It should only be used for idempotent requests
+func WithHedgingAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, hedgingAllowedKey, true)
+}
+
+// WithHedgingDisallowed returns a copy of the provided context which marks that the requests made on its behalf towards
+// the observers must not be hedged, even if the parent context allows it
+func WithHedgingDisallowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, hedgingAllowedKey, false)
+}
+
+// IsHedgingAllowed returns true if the provided context allows the hedging of the requests towards the observers
+func IsHedgingAllowed(ctx context.Context) bool {
+	isAllowed, ok := ctx.Value(hedgingAllowedKey).(bool)
+
+	return ok && isAllowed
+}
+
+const sessionIDKey contextKey = "sessionID"
+
+// WithSessionID returns a copy of the provided context which holds the session ID supplied by the client
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey, sessionID)
+}
+
+// GetSessionID returns the session ID held by the provided context or an empty string if there is none
+func GetSessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey).(string)
+
+	return sessionID
+}
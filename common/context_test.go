@@ -0,0 +1,32 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithHedgingAllowed_ShouldWork(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, IsHedgingAllowed(ctx))
+
+	ctx = WithHedgingAllowed(ctx)
+	require.True(t, IsHedgingAllowed(ctx))
+}
+
+func TestWithHedgingDisallowed_ShouldWork(t *testing.T) {
+	ctx := WithHedgingAllowed(context.Background())
+	require.True(t, IsHedgingAllowed(ctx))
+
+	ctx = WithHedgingDisallowed(ctx)
+	require.False(t, IsHedgingAllowed(ctx))
+}
+
+func TestWithSessionID_ShouldWork(t *testing.T) {
+	ctx := context.Background()
+	require.Empty(t, GetSessionID(ctx))
+
+	ctx = WithSessionID(ctx, "session-1")
+	require.Equal(t, "session-1", GetSessionID(ctx))
+}
@@ -0,0 +1,6 @@
+package common
+
+import "errors"
+
+// ErrInvalidAllowedRoute signals that an invalid allowed route has been provided
+var ErrInvalidAllowedRoute = errors.New("invalid allowed route")
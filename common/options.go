@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 )
@@ -39,6 +40,12 @@ const (
 	UrlParameterLastNonce = "last-nonce"
 	// UrlParameterNonceGaps represents the name of an URL parameter
 	UrlParameterNonceGaps = "nonce-gaps"
+	// UrlParameterFromNonce represents the name of an URL parameter
+	UrlParameterFromNonce = "fromNonce"
+	// UrlParameterWaitForCompletion represents the name of an URL parameter
+	UrlParameterWaitForCompletion = "waitForCompletion"
+	// UrlParameterTimeout represents the name of an URL parameter
+	UrlParameterTimeout = "timeout"
 )
 
 // BlockQueryOptions holds options for block queries
@@ -52,6 +59,19 @@ type HyperblockQueryOptions struct {
 	WithLogs bool
 }
 
+// HyperblocksSubscriptionOptions holds options for the subscriptions to the new hyperblocks. If FromNonce is not set,
+// the subscription starts with the next fully synchronized hyperblock
+type HyperblocksSubscriptionOptions struct {
+	WithLogs  bool
+	FromNonce core.OptionalUint64
+}
+
+// TransactionWaitOptions holds options for waiting for the transactions to complete. A zero Timeout stands for the
+// maximum wait allowed by the proxy
+type TransactionWaitOptions struct {
+	Timeout time.Duration
+}
+
 // TransactionQueryOptions holds options for transaction queries
 type TransactionQueryOptions struct {
 	WithResults bool
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllRoutesWildcard allows all the routes when used as allowed route, or all the routes of a package when used as
+// suffix of an allowed route (for example, /address/*)
+const AllRoutesWildcard = "*"
+
+// RoutesMatcher checks if a route is allowed by a list of allowed routes. The routes are in the /package/route form,
+// as defined in the API config files
+type RoutesMatcher struct {
+	allowsAllRoutes bool
+	allowedPackages map[string]struct{}
+	allowedRoutes   map[string]struct{}
+}
+
+// NewRoutesMatcher returns a new instance of RoutesMatcher. Besides the exact routes, the allowed routes can hold
+// "/package/*", which allows all the routes of a package, and "*", which allows all the routes
+func NewRoutesMatcher(allowedRoutes []string) (*RoutesMatcher, error) {
+	rm := &RoutesMatcher{
+		allowedPackages: make(map[string]struct{}),
+		allowedRoutes:   make(map[string]struct{}),
+	}
+
+	for _, route := range allowedRoutes {
+		err := rm.addAllowedRoute(route)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rm, nil
+}
+
+func (rm *RoutesMatcher) addAllowedRoute(route string) error {
+	if route == AllRoutesWildcard {
+		rm.allowsAllRoutes = true
+		return nil
+	}
+	if !strings.HasPrefix(route, "/") || len(route) < 2 {
+		return fmt.Errorf("%w %s", ErrInvalidAllowedRoute, route)
+	}
+
+	packageName, isPackageWildcard := getPackageWildcard(route)
+	if isPackageWildcard {
+		rm.allowedPackages[packageName] = struct{}{}
+		return nil
+	}
+	if strings.Contains(route, AllRoutesWildcard) {
+		return fmt.Errorf("%w %s", ErrInvalidAllowedRoute, route)
+	}
+
+	rm.allowedRoutes[route] = struct{}{}
+
+	return nil
+}
+
+// getPackageWildcard returns the package name if the route is in the /package/* form
+func getPackageWildcard(route string) (string, bool) {
+	packageName := strings.TrimSuffix(route[1:], "/"+AllRoutesWildcard)
+	isPackageWildcard := len(packageName) < len(route)-1 && !strings.Contains(packageName, "/")
+
+	return packageName, isPackageWildcard
+}
+
+// IsRouteAllowed returns true if the provided route is allowed
+func (rm *RoutesMatcher) IsRouteAllowed(route string) bool {
+	if rm.allowsAllRoutes {
+		return true
+	}
+
+	_, isRouteAllowed := rm.allowedRoutes[route]
+	if isRouteAllowed {
+		return true
+	}
+
+	packageName := strings.SplitN(strings.TrimPrefix(route, "/"), "/", 2)[0]
+	_, isPackageAllowed := rm.allowedPackages[packageName]
+
+	return isPackageAllowed
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoutesMatcher_InvalidRoutesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidRoutes := []string{"", "/", "address", "/address/*/nonce", "/*/nonce", "/address/:address/*"}
+	for _, route := range invalidRoutes {
+		rm, err := NewRoutesMatcher([]string{"/network/config", route})
+		assert.True(t, errors.Is(err, ErrInvalidAllowedRoute), route)
+		assert.Nil(t, rm, route)
+	}
+}
+
+func TestRoutesMatcher_IsRouteAllowed(t *testing.T) {
+	t.Parallel()
+
+	rm, err := NewRoutesMatcher([]string{"/address/*", "/network/config"})
+	require.NoError(t, err)
+	assert.True(t, rm.IsRouteAllowed("/address/:address"))
+	assert.True(t, rm.IsRouteAllowed("/address/:address/esdt"))
+	assert.True(t, rm.IsRouteAllowed("/network/config"))
+	assert.False(t, rm.IsRouteAllowed("/network/esdts"))
+	assert.False(t, rm.IsRouteAllowed("/transaction/send"))
+	assert.False(t, rm.IsRouteAllowed("/addresses/:address"))
+
+	rm, _ = NewRoutesMatcher([]string{"*"})
+	assert.True(t, rm.IsRouteAllowed("/transaction/send"))
+
+	rm, _ = NewRoutesMatcher(nil)
+	assert.False(t, rm.IsRouteAllowed("/transaction/send"))
+}
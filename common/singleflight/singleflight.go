@@ -0,0 +1,76 @@
+package singleflight
+
+import (
+	"context"
+	"sync"
+)
+
+type inFlightCall struct {
+	chDone chan struct{}
+	value  interface{}
+	err    error
+}
+
+// Group makes sure that, for a given key, only one call is in progress at a time. The concurrent callers of the same
+// key wait for the call in progress and share its result
+type Group struct {
+	mutCalls sync.Mutex
+	calls    map[string]*inFlightCall
+}
+
+// NewGroup returns a new instance of Group
+func NewGroup() *Group {
+	return &Group{
+		calls: make(map[string]*inFlightCall),
+	}
+}
+
+// Do executes the provided handler, unless a call for the same key is already in progress, case in which it calls
+// onJoin, if set, then waits for that call and returns its result. The returned flag is true if the result is shared
+// with another caller. A caller waiting for a shared result stops waiting as soon as its context is done, without
+// affecting the call in progress
+func (g *Group) Do(ctx context.Context, key string, handler func() (interface{}, error), onJoin func()) (interface{}, bool, error) {
+	g.mutCalls.Lock()
+	call, found := g.calls[key]
+	if found {
+		g.mutCalls.Unlock()
+		if onJoin != nil {
+			onJoin()
+		}
+
+		select {
+		case <-call.chDone:
+			return call.value, true, call.err
+		case <-ctx.Done():
+			return nil, true, ctx.Err()
+		}
+	}
+
+	call = &inFlightCall{
+		chDone: make(chan struct{}),
+	}
+	g.calls[key] = call
+	g.mutCalls.Unlock()
+
+	defer func() {
+		g.mutCalls.Lock()
+		delete(g.calls, key)
+		g.mutCalls.Unlock()
+
+		close(call.chDone)
+	}()
+
+	call.value, call.err = handler()
+
+	return call.value, false, call.err
+}
+
+// IsInProgress returns true if a call for the provided key is in progress
+func (g *Group) IsInProgress(key string) bool {
+	g.mutCalls.Lock()
+	defer g.mutCalls.Unlock()
+
+	_, found := g.calls[key]
+
+	return found
+}
@@ -0,0 +1,90 @@
+package singleflight_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common/singleflight"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup_ConcurrentCallsOfTheSameKeyShouldBeExecutedOnce(t *testing.T) {
+	t.Parallel()
+
+	group := singleflight.NewGroup()
+	numCalls := uint32(0)
+	chRelease := make(chan struct{})
+	handler := func() (interface{}, error) {
+		atomic.AddUint32(&numCalls, 1)
+		<-chRelease
+		return "value", nil
+	}
+
+	numCallers := 10
+	numShared := uint32(0)
+	wg := sync.WaitGroup{}
+	wg.Add(numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func() {
+			defer wg.Done()
+
+			value, shared, err := group.Do(context.Background(), "key", handler, nil)
+			require.NoError(t, err)
+			require.Equal(t, "value", value)
+			if shared {
+				atomic.AddUint32(&numShared, 1)
+			}
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return group.IsInProgress("key")
+	}, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(chRelease)
+	wg.Wait()
+
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	require.Equal(t, uint32(numCallers-1), atomic.LoadUint32(&numShared))
+	require.False(t, group.IsInProgress("key"))
+}
+
+func TestGroup_WaiterShouldStopWhenItsContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	group := singleflight.NewGroup()
+	chRelease := make(chan struct{})
+	chFirstDone := make(chan struct{})
+	expectedErr := errors.New("expected error")
+	go func() {
+		_, _, err := group.Do(context.Background(), "key", func() (interface{}, error) {
+			<-chRelease
+			return nil, expectedErr
+		}, nil)
+		require.Equal(t, expectedErr, err)
+		close(chFirstDone)
+	}()
+	require.Eventually(t, func() bool {
+		return group.IsInProgress("key")
+	}, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	numJoins := 0
+	_, shared, err := group.Do(ctx, "key", func() (interface{}, error) {
+		require.Fail(t, "should have not been called")
+		return nil, nil
+	}, func() {
+		numJoins++
+	})
+	require.Equal(t, 1, numJoins)
+	require.True(t, shared)
+	require.Equal(t, context.Canceled, err)
+
+	close(chRelease)
+	<-chFirstDone
+}
@@ -12,22 +12,43 @@ type GeneralSettingsConfig struct {
 	HeartbeatCacheValidityDurationSec        int
 	ValStatsCacheValidityDurationSec         int
 	EconomicsMetricsCacheValidityDurationSec int
+	ConfigsCacheValidityDurationSec          int
 	FaucetValue                              string
 	RateLimitWindowDurationSeconds           int
 	BalancedObservers                        bool
 	BalancedFullHistoryNodes                 bool
+	ObserversSelectionStrategy               string
+	FullHistoryNodesSelectionStrategy        string
 	AllowEntireTxPoolFetch                   bool
+	VerifyTransactionsSignatures             bool
 }
 
 // Config will hold the whole config file's data
 type Config struct {
-	GeneralSettings        GeneralSettingsConfig
-	AddressPubkeyConverter config.PubkeyConfig
-	Marshalizer            config.TypeConfig
-	Hasher                 config.TypeConfig
-	ApiLogging             ApiLoggingConfig
-	Observers              []*data.NodeData
-	FullHistoryNodes       []*data.NodeData
+	GeneralSettings          GeneralSettingsConfig
+	AddressPubkeyConverter   config.PubkeyConfig
+	Marshalizer              config.TypeConfig
+	Hasher                   config.TypeConfig
+	ApiLogging               ApiLoggingConfig
+	CircuitBreaker           CircuitBreakerConfig
+	NodesSanityCheck         NodesSanityCheckConfig
+	NodesSyncCheck           NodesSyncCheckConfig
+	HttpClient               HttpClientConfig
+	RequestsHedging          RequestsHedgingConfig
+	SessionAffinity          SessionAffinityConfig
+	RequestsCoalescing       RequestsCoalescingConfig
+	ResponseCache            ResponseCacheConfig
+	HyperblocksSubscriptions HyperblocksSubscriptionsConfig
+	TransactionsTracker      TransactionsTrackerConfig
+	NonceReservations        NonceReservationsConfig
+	StateStore               StateStoreConfig
+	RateLimiter              RateLimiterConfig
+	ApiKeys                  ApiKeysConfig
+	JwtAuthentication        JwtAuthenticationConfig
+	ConfigReload             ConfigReloadConfig
+	ObserversDiscovery       ObserversDiscoveryConfig
+	Observers                []*data.NodeData
+	FullHistoryNodes         []*data.NodeData
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -36,8 +57,232 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// CircuitBreakerConfig holds the configuration related to the observers' circuit breakers
+type CircuitBreakerConfig struct {
+	Enabled             bool
+	FailureThreshold    uint32
+	CooldownDurationSec int
+}
+
+// NodesSanityCheckConfig holds the configuration related to the checks of the values reported by the nodes against
+// their configuration
+type NodesSanityCheckConfig struct {
+	Enabled         bool
+	ExpectedChainID string
+}
+
+// NodesSyncCheckConfig holds the configuration related to the checks of the nodes' sync state. Zero values will be
+// replaced by defaults
+type NodesSyncCheckConfig struct {
+	MaxParallelChecks int
+	JitterPercent     uint32
+	Default           ShardSyncCheckConfig
+	Shards            []ShardSyncCheckConfig
+}
+
+// ShardSyncCheckConfig holds the sync state check settings of a shard. ShardId is ignored for the default settings
+type ShardSyncCheckConfig struct {
+	ShardId            uint32
+	CheckIntervalSec   int
+	ComparisonMethod   string
+	LagThreshold       uint64
+	RelativeToBestNode bool
+}
+
+// HttpClientConfig holds the configuration related to the HTTP client used for the requests towards the observers.
+// Zero values will be replaced by defaults
+type HttpClientConfig struct {
+	MaxIdleConns             int
+	MaxIdleConnsPerHost      int
+	MaxConnsPerHost          int
+	IdleConnTimeoutSec       int
+	DisableKeepAlives        bool
+	KeepAlivePeriodSec       int
+	DialTimeoutSec           int
+	TLSHandshakeTimeoutSec   int
+	ResponseHeaderTimeoutSec int
+	EnableHTTP2              bool
+}
+
+// RequestsHedgingConfig holds the configuration related to the hedging of the requests towards the observers
+type RequestsHedgingConfig struct {
+	Enabled    bool
+	Percentile float64
+	MinDelayMs int
+	MaxDelayMs int
+	NumSamples int
+}
+
+// RequestsCoalescingConfig holds the configuration related to the coalescing of the identical GET requests towards
+// the observers
+type RequestsCoalescingConfig struct {
+	Enabled bool
+}
+
+// ResponseCacheConfig holds the configuration related to the caches of the immutable responses (final blocks, finalized
+// transactions and historical account queries). The limits apply to each cache
+type ResponseCacheConfig struct {
+	Enabled       bool
+	MaxNumEntries int
+	MaxSizeInMB   int
+}
+
+// HyperblocksSubscriptionsConfig holds the configuration related to the subscriptions to the new fully synchronized
+// hyperblocks
+type HyperblocksSubscriptionsConfig struct {
+	Enabled              bool
+	PollIntervalMs       int
+	MaxSubscribers       int
+	MaxResumeNonces      uint64
+	SubscriberBufferSize int
+}
+
+// TransactionsTrackerConfig holds the configuration related to waiting for the transactions to complete
+type TransactionsTrackerConfig struct {
+	Enabled                bool
+	PollIntervalMs         int
+	MaxWaitTimeoutSec      int
+	MaxTrackedTransactions int
+}
+
+// NonceReservationsConfig holds the configuration related to handing out non-conflicting nonces to the concurrent
+// clients of the same sender
+type NonceReservationsConfig struct {
+	Enabled                  bool
+	ReservationTTLSec        int
+	MaxReservationsPerSender int
+	MaxSenders               int
+}
+
+// SessionAffinityConfig holds the configuration related to the routing of a sender's account and transactions pool
+// queries towards the observer which accepted its last transaction
+type SessionAffinityConfig struct {
+	Enabled           bool
+	WindowDurationSec int
+	IdentifyBy        string
+	SessionHeader     string
+}
+
+// RateLimiterConfig holds the configuration related to the identification of the clients whose requests are limited
+type RateLimiterConfig struct {
+	LimitBy      []string
+	ApiKeyHeader string
+}
+
+// ApiKeysConfig holds the configuration related to the authentication of the requests by API keys
+type ApiKeysConfig struct {
+	Enabled           bool
+	Header            string
+	IsApiKeyRequired  bool
+	ReloadIntervalSec int
+}
+
+// JwtAuthenticationConfig holds the configuration related to the authentication of the requests towards the secured
+// routes by JWT bearer tokens
+type JwtAuthenticationConfig struct {
+	Enabled     bool
+	JwksFile    string
+	Issuer      string
+	Audience    string
+	ClaimName   string
+	LeewaySec   int
+	Permissions []JwtPermissionConfig
+}
+
+// JwtPermissionConfig holds the routes allowed for the tokens holding a claim value
+type JwtPermissionConfig struct {
+	ClaimValue    string
+	AllowedRoutes []string
+}
+
+// StateStoreConfig holds the configuration related to the store of the state which can be shared between several
+// proxy instances: the rate limiter counters and the cached responses
+type StateStoreConfig struct {
+	Type  string
+	Redis RedisConfig
+}
+
+// RedisConfig holds the configuration related to the server speaking the Redis protocol used as state store
+type RedisConfig struct {
+	Address            string
+	Password           string
+	Database           int
+	KeyPrefix          string
+	PoolSize           int
+	DialTimeoutMs      int
+	OperationTimeoutMs int
+}
+
+// ConfigReloadConfig holds the configuration related to the reloading of the configs while the proxy is running
+type ConfigReloadConfig struct {
+	Enabled          bool
+	WatchFiles       bool
+	WatchIntervalSec int
+}
+
+// ObserversDiscoveryConfig holds the configuration related to the discovery of the observers and of the full history
+// nodes, which replaces the Observers and FullHistoryNodes lists when enabled
+type ObserversDiscoveryConfig struct {
+	Enabled            bool
+	Type               string
+	RefreshIntervalSec int
+	FilePath           string
+	Dns                DnsDiscoveryConfig
+}
+
+// DnsDiscoveryConfig holds the DNS records from which the observers and the full history nodes are discovered
+type DnsDiscoveryConfig struct {
+	Scheme           string
+	Observers        []DnsRecordConfig
+	FullHistoryNodes []DnsRecordConfig
+}
+
+// DnsRecordConfig holds the configuration of a DNS record which points to nodes
+type DnsRecordConfig struct {
+	Type       string
+	Name       string
+	Port       int
+	IsFallback bool
+}
+
+// ReloadableConfigs holds the configs which can be re-applied while the proxy is running
+type ReloadableConfigs struct {
+	GeneralConfig     *Config
+	CredentialsConfig *CredentialsConfig
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
 	Credentials []data.Credential
 	Hasher      config.TypeConfig
 }
+
+// ApiKeysFileConfig holds the API keys and the plans they are mapped to
+type ApiKeysFileConfig struct {
+	Plans []ApiKeyPlanConfig
+	Keys  []ApiKeyConfig
+}
+
+// ApiKeyPlanConfig holds the routes allowed by a plan and their limits
+type ApiKeyPlanConfig struct {
+	Name          string
+	AllowedRoutes []string
+	Burst         uint64
+	RatePerSecond float64
+	Routes        []ApiKeyPlanRouteConfig
+}
+
+// ApiKeyPlanRouteConfig holds the limits of a plan which are specific to a route
+type ApiKeyPlanRouteConfig struct {
+	Name          string
+	Burst         uint64
+	RatePerSecond float64
+}
+
+// ApiKeyConfig holds the hash of an API key, its owner and its plan
+type ApiKeyConfig struct {
+	Name     string
+	KeyHash  string
+	Plan     string
+	Disabled bool
+}
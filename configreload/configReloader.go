@@ -0,0 +1,262 @@
+package configreload
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("configreload")
+
+// minWatchInterval represents the minimum interval between two checks of the config files
+const minWatchInterval = time.Second
+
+// ArgsConfigReloader holds the arguments needed for creating a new config reloader. If WatchInterval is zero, the
+// config files are not watched and the configs are only reloaded on request
+type ArgsConfigReloader struct {
+	ConfigFilePath      string
+	CredentialsFilePath string
+	ApiConfigDirectory  string
+	WatchInterval       time.Duration
+}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// configReloader loads the config files again and re-applies them to the registered components. The configs are
+// applied in two phases: all the components check the new configs first and, only if none of them rejects them, all
+// the components start using them. Otherwise, the components keep using the previous configs
+type configReloader struct {
+	configFilePath      string
+	credentialsFilePath string
+	apiConfigDirectory  string
+
+	mutReload       sync.Mutex
+	components      []ReloadableComponent
+	watchedVersions map[string]fileVersion
+	cancelWatchLoop func()
+}
+
+// NewConfigReloader returns a new instance of configReloader
+func NewConfigReloader(args ArgsConfigReloader) (*configReloader, error) {
+	if len(args.ConfigFilePath) == 0 {
+		return nil, ErrEmptyConfigFilePath
+	}
+	if len(args.CredentialsFilePath) == 0 {
+		return nil, ErrEmptyCredentialsFilePath
+	}
+	if args.WatchInterval != 0 && args.WatchInterval < minWatchInterval {
+		return nil, fmt.Errorf("%w: provided %v, minimum %v", ErrInvalidWatchInterval, args.WatchInterval, minWatchInterval)
+	}
+
+	cr := &configReloader{
+		configFilePath:      args.ConfigFilePath,
+		credentialsFilePath: args.CredentialsFilePath,
+		apiConfigDirectory:  args.ApiConfigDirectory,
+		components:          make([]ReloadableComponent, 0),
+	}
+
+	watchedVersions, err := cr.getWatchedVersions()
+	if err != nil {
+		return nil, err
+	}
+	cr.watchedVersions = watchedVersions
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cr.cancelWatchLoop = cancel
+	if args.WatchInterval > 0 {
+		go cr.watchLoop(ctx, args.WatchInterval)
+	}
+
+	return cr, nil
+}
+
+// RegisterComponent adds a component to which the reloaded configs are applied
+func (cr *configReloader) RegisterComponent(component ReloadableComponent) error {
+	if check.IfNil(component) {
+		return ErrNilReloadableComponent
+	}
+
+	cr.mutReload.Lock()
+	cr.components = append(cr.components, component)
+	cr.mutReload.Unlock()
+
+	return nil
+}
+
+// ReloadConfig loads the config files and applies them to all the registered components. If any component rejects
+// the new configs, none of them is changed
+func (cr *configReloader) ReloadConfig() data.NodesReloadResponse {
+	cr.mutReload.Lock()
+	defer cr.mutReload.Unlock()
+
+	watchedVersions, err := cr.getWatchedVersions()
+	if err != nil {
+		log.Warn("cannot check the config files", "error", err)
+	} else {
+		cr.watchedVersions = watchedVersions
+	}
+
+	return cr.reloadUnprotected()
+}
+
+func (cr *configReloader) reloadUnprotected() data.NodesReloadResponse {
+	configs, err := cr.loadConfigs()
+	if err != nil {
+		log.Error("cannot load the config files, the previous configs are kept", "error", err)
+		return data.NodesReloadResponse{
+			OkRequest:   false,
+			Description: "not reloaded",
+			Error:       err.Error(),
+		}
+	}
+
+	applyHandlers := make([]func(), 0, len(cr.components))
+	for _, component := range cr.components {
+		apply, errPrepare := component.PrepareReload(configs)
+		if errPrepare != nil {
+			log.Error("invalid configs, the previous configs are kept", "error", errPrepare)
+			return data.NodesReloadResponse{
+				OkRequest:   false,
+				Description: "not reloaded",
+				Error:       errPrepare.Error(),
+			}
+		}
+
+		applyHandlers = append(applyHandlers, apply)
+	}
+
+	for _, apply := range applyHandlers {
+		apply()
+	}
+
+	log.Info("reloaded the configs", "config file", cr.configFilePath, "credentials file", cr.credentialsFilePath,
+		"API config directory", cr.apiConfigDirectory)
+
+	return data.NodesReloadResponse{
+		OkRequest:   true,
+		Description: "configs reloaded",
+		Error:       "",
+	}
+}
+
+func (cr *configReloader) loadConfigs() (*config.ReloadableConfigs, error) {
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, cr.configFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load configuration file at %s: %w", cr.configFilePath, err)
+	}
+
+	credentialsConfig := &config.CredentialsConfig{}
+	err = core.LoadTomlFile(credentialsConfig, cr.credentialsFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load credentials file at %s: %w", cr.credentialsFilePath, err)
+	}
+
+	return &config.ReloadableConfigs{
+		GeneralConfig:     generalConfig,
+		CredentialsConfig: credentialsConfig,
+	}, nil
+}
+
+func (cr *configReloader) watchLoop(ctx context.Context, watchInterval time.Duration) {
+	timer := time.NewTimer(watchInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			cr.reloadIfModified()
+			timer.Reset(watchInterval)
+		case <-ctx.Done():
+			log.Debug("closing config files watch loop")
+			return
+		}
+	}
+}
+
+func (cr *configReloader) reloadIfModified() {
+	watchedVersions, err := cr.getWatchedVersions()
+	if err != nil {
+		log.Error("cannot check the config files", "error", err)
+		return
+	}
+
+	cr.mutReload.Lock()
+	defer cr.mutReload.Unlock()
+
+	if isSameVersion(watchedVersions, cr.watchedVersions) {
+		return
+	}
+
+	// the same invalid versions of the files are not applied again
+	cr.watchedVersions = watchedVersions
+
+	log.Info("config files modified, reloading the configs")
+	_ = cr.reloadUnprotected()
+}
+
+// getWatchedVersions returns the versions of the config file, of the credentials file and of the API config files
+func (cr *configReloader) getWatchedVersions() (map[string]fileVersion, error) {
+	filePaths := []string{cr.configFilePath, cr.credentialsFilePath}
+	if len(cr.apiConfigDirectory) > 0 {
+		apiConfigFiles, err := filepath.Glob(filepath.Join(cr.apiConfigDirectory, "*.toml"))
+		if err != nil {
+			return nil, err
+		}
+		filePaths = append(filePaths, apiConfigFiles...)
+	}
+
+	versions := make(map[string]fileVersion, len(filePaths))
+	for _, filePath := range filePaths {
+		fileInfo, err := os.Stat(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[filePath] = fileVersion{
+			modTime: fileInfo.ModTime(),
+			size:    fileInfo.Size(),
+		}
+	}
+
+	return versions, nil
+}
+
+func isSameVersion(versions map[string]fileVersion, otherVersions map[string]fileVersion) bool {
+	if len(versions) != len(otherVersions) {
+		return false
+	}
+
+	for filePath, version := range versions {
+		otherVersion, found := otherVersions[filePath]
+		if !found || !version.modTime.Equal(otherVersion.modTime) || version.size != otherVersion.size {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Close stops watching the config files
+func (cr *configReloader) Close() error {
+	cr.cancelWatchLoop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *configReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
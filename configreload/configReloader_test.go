@@ -0,0 +1,224 @@
+package configreload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGeneralConfig = `
+[GeneralSettings]
+   HeartbeatCacheValidityDurationSec = 60
+   RateLimitWindowDurationSeconds = 60
+`
+
+const testCredentialsConfig = `
+Credentials = [
+   { Username = "admin", Password = "hash" },
+]
+`
+
+func writeFile(t *testing.T, filePath string, contents string) {
+	err := os.WriteFile(filePath, []byte(contents), 0644)
+	require.NoError(t, err)
+}
+
+func createMockArgs(t *testing.T) ArgsConfigReloader {
+	dir := t.TempDir()
+	apiConfigDirectory := filepath.Join(dir, "apiConfig")
+	err := os.Mkdir(apiConfigDirectory, 0755)
+	require.NoError(t, err)
+
+	args := ArgsConfigReloader{
+		ConfigFilePath:      filepath.Join(dir, "config.toml"),
+		CredentialsFilePath: filepath.Join(apiConfigDirectory, "credentials.toml"),
+		ApiConfigDirectory:  apiConfigDirectory,
+	}
+	writeFile(t, args.ConfigFilePath, testGeneralConfig)
+	writeFile(t, args.CredentialsFilePath, testCredentialsConfig)
+	writeFile(t, filepath.Join(apiConfigDirectory, "v1_0.toml"), "[APIPackages]\n")
+
+	return args
+}
+
+func createConfigReloader(t *testing.T, args ArgsConfigReloader) *configReloader {
+	cr, err := NewConfigReloader(args)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = cr.Close()
+	})
+
+	return cr
+}
+
+func TestNewConfigReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty config file path should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.ConfigFilePath = ""
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, ErrEmptyConfigFilePath, err)
+		require.True(t, check.IfNil(cr))
+	})
+	t.Run("empty credentials file path should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.CredentialsFilePath = ""
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, ErrEmptyCredentialsFilePath, err)
+		require.True(t, check.IfNil(cr))
+	})
+	t.Run("invalid watch interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.WatchInterval = time.Millisecond
+		cr, err := NewConfigReloader(args)
+		require.True(t, errors.Is(err, ErrInvalidWatchInterval))
+		require.True(t, check.IfNil(cr))
+	})
+	t.Run("missing config file should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.ConfigFilePath = filepath.Join(t.TempDir(), "missing.toml")
+		cr, err := NewConfigReloader(args)
+		require.Error(t, err)
+		require.True(t, check.IfNil(cr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.WatchInterval = time.Second
+		cr, err := NewConfigReloader(args)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(cr))
+		require.Equal(t, 3, len(cr.watchedVersions))
+		require.NoError(t, cr.Close())
+	})
+}
+
+func TestConfigReloader_RegisterComponentNilComponentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cr := createConfigReloader(t, createMockArgs(t))
+
+	err := cr.RegisterComponent(nil)
+	require.Equal(t, ErrNilReloadableComponent, err)
+}
+
+func TestConfigReloader_ReloadConfigShouldApplyConfigsToAllComponents(t *testing.T) {
+	t.Parallel()
+
+	cr := createConfigReloader(t, createMockArgs(t))
+
+	appliedComponents := make([]string, 0)
+	createComponent := func(name string) *mock.ReloadableComponentStub {
+		return &mock.ReloadableComponentStub{
+			PrepareReloadCalled: func(configs *config.ReloadableConfigs) (func(), error) {
+				assert.Equal(t, 60, configs.GeneralConfig.GeneralSettings.HeartbeatCacheValidityDurationSec)
+				assert.Equal(t, "admin", configs.CredentialsConfig.Credentials[0].Username)
+
+				return func() {
+					appliedComponents = append(appliedComponents, name)
+				}, nil
+			},
+		}
+	}
+	_ = cr.RegisterComponent(createComponent("first"))
+	_ = cr.RegisterComponent(createComponent("second"))
+
+	response := cr.ReloadConfig()
+	require.True(t, response.OkRequest)
+	require.Empty(t, response.Error)
+	require.Equal(t, []string{"first", "second"}, appliedComponents)
+}
+
+func TestConfigReloader_ReloadConfigRejectedByAComponentShouldNotApplyConfigs(t *testing.T) {
+	t.Parallel()
+
+	cr := createConfigReloader(t, createMockArgs(t))
+
+	isApplied := false
+	_ = cr.RegisterComponent(&mock.ReloadableComponentStub{
+		PrepareReloadCalled: func(configs *config.ReloadableConfigs) (func(), error) {
+			return func() {
+				isApplied = true
+			}, nil
+		},
+	})
+	_ = cr.RegisterComponent(&mock.ReloadableComponentStub{
+		PrepareReloadCalled: func(configs *config.ReloadableConfigs) (func(), error) {
+			return nil, errors.New("invalid rate limit")
+		},
+	})
+
+	response := cr.ReloadConfig()
+	require.False(t, response.OkRequest)
+	require.Equal(t, "invalid rate limit", response.Error)
+	require.False(t, isApplied)
+}
+
+func TestConfigReloader_ReloadConfigInvalidFileShouldNotApplyConfigs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	cr := createConfigReloader(t, args)
+
+	isPrepared := false
+	_ = cr.RegisterComponent(&mock.ReloadableComponentStub{
+		PrepareReloadCalled: func(configs *config.ReloadableConfigs) (func(), error) {
+			isPrepared = true
+			return func() {}, nil
+		},
+	})
+
+	writeFile(t, args.CredentialsFilePath, "Credentials = [")
+
+	response := cr.ReloadConfig()
+	require.False(t, response.OkRequest)
+	require.Contains(t, response.Error, "cannot load credentials file")
+	require.False(t, isPrepared)
+}
+
+func TestConfigReloader_ReloadIfModifiedShouldReloadOnlyModifiedFiles(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	cr := createConfigReloader(t, args)
+
+	numReloads := 0
+	_ = cr.RegisterComponent(&mock.ReloadableComponentStub{
+		PrepareReloadCalled: func(configs *config.ReloadableConfigs) (func(), error) {
+			numReloads++
+			return func() {}, nil
+		},
+	})
+
+	cr.reloadIfModified()
+	require.Equal(t, 0, numReloads)
+
+	writeFile(t, filepath.Join(args.ApiConfigDirectory, "v1_0.toml"), "AuthenticationType = \"basic\"\n[APIPackages]\n")
+	cr.reloadIfModified()
+	require.Equal(t, 1, numReloads)
+
+	cr.reloadIfModified()
+	require.Equal(t, 1, numReloads)
+
+	writeFile(t, args.ConfigFilePath, testGeneralConfig+"\n[ApiLogging]\n   LoggingEnabled = true\n")
+	cr.reloadIfModified()
+	require.Equal(t, 2, numReloads)
+}
@@ -0,0 +1,15 @@
+package configreload
+
+import "errors"
+
+// ErrEmptyConfigFilePath signals that an empty config file path has been provided
+var ErrEmptyConfigFilePath = errors.New("empty config file path")
+
+// ErrEmptyCredentialsFilePath signals that an empty credentials file path has been provided
+var ErrEmptyCredentialsFilePath = errors.New("empty credentials file path")
+
+// ErrInvalidWatchInterval signals that an invalid config files watch interval has been provided
+var ErrInvalidWatchInterval = errors.New("invalid watch interval")
+
+// ErrNilReloadableComponent signals that a nil reloadable component has been provided
+var ErrNilReloadableComponent = errors.New("nil reloadable component")
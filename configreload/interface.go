@@ -0,0 +1,21 @@
+package configreload
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ReloadableComponent defines a component whose settings can be changed while the proxy is running. PrepareReload
+// checks the new configs and prepares everything the component needs for using them, without changing the component,
+// and returns the function which makes the component use them. The returned function must not fail
+type ReloadableComponent interface {
+	PrepareReload(configs *config.ReloadableConfigs) (func(), error)
+	IsInterfaceNil() bool
+}
+
+// ConfigReloaderHandler defines what a config reloader should be able to do
+type ConfigReloaderHandler interface {
+	RegisterComponent(component ReloadableComponent) error
+	ReloadConfig() data.NodesReloadResponse
+	IsInterfaceNil() bool
+}
@@ -20,6 +20,7 @@ type NetworkConfig struct {
 		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
 		MinGasPrice           uint64 `json:"erd_min_gas_price"`
 		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+		GasPerDataByte        uint64 `json:"erd_gas_per_data_byte"`
 	} `json:"config"`
 }
 
@@ -37,11 +38,13 @@ const (
 	ReturnCodeRequestError ReturnCode = "bad_request"
 )
 
-// VersionData holds the components specific for each version
+// VersionData holds the components specific for each version. ApiConfigName is the name of the API config file
+// (without the extension) from which ApiConfig was loaded
 type VersionData struct {
-	Facade     FacadeHandler
-	ApiHandler ApiHandler
-	ApiConfig  ApiRoutesConfig
+	Facade        FacadeHandler
+	ApiHandler    ApiHandler
+	ApiConfig     ApiRoutesConfig
+	ApiConfigName string
 }
 
 // EndpointHandlerData holds the items needed for creating a new HTTP endpoint
@@ -86,12 +89,20 @@ type StatusMetricsProvider interface {
 	GetAll() map[string]*EndpointMetrics
 	GetMetricsForPrometheus() string
 	AddRequestData(path string, withError bool, duration time.Duration)
+	AddCacheHit(cacheName string)
+	AddCacheMiss(cacheName string)
+	GetCacheMetrics() map[string]*CacheMetrics
+	AddCoalescedRequest(address string)
+	GetCoalescedRequests() map[string]uint64
+	AddApiKeyRequest(keyName string, path string, withError bool)
+	GetApiKeysUsage() map[string]*ApiKeyUsage
 	IsInterfaceNil() bool
 }
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	APIPackages map[string]APIPackageConfig
+	AuthenticationType string
+	APIPackages        map[string]APIPackageConfig
 }
 
 // APIPackageConfig holds the configuration for the routes of each package
@@ -101,10 +112,15 @@ type APIPackageConfig struct {
 
 // RouteConfig holds the configuration for a single route
 type RouteConfig struct {
-	Name      string
-	Open      bool
-	Secured   bool
-	RateLimit uint64
+	Name                  string
+	Open                  bool
+	Secured               bool
+	RateLimit             uint64
+	Burst                 uint64
+	RatePerSecond         float64
+	MaxConcurrentRequests uint32
+	TimeoutSec            int
+	Hedging               bool
 }
 
 // Credential holds an username and a password
@@ -112,3 +128,26 @@ type Credential struct {
 	Username string
 	Password string
 }
+
+// ApiKeyAuthorization holds the result of the authorization of a request made with an API key
+type ApiKeyAuthorization struct {
+	KeyName        string
+	PlanName       string
+	IsRouteAllowed bool
+	Burst          uint64
+	RatePerSecond  float64
+}
+
+// TokenAuthorization holds the result of the authorization of a request made with a bearer token
+type TokenAuthorization struct {
+	Subject        string
+	IsRouteAllowed bool
+}
+
+// HyperblocksSubscriptionHandler defines a subscription to the new fully synchronized hyperblocks. The hyperblocks
+// channel is closed when the subscription ends, after which Err returns the reason, if any
+type HyperblocksSubscriptionHandler interface {
+	Hyperblocks() <-chan *Hyperblock
+	Err() error
+	Close()
+}
@@ -0,0 +1,25 @@
+package data
+
+import "time"
+
+// CircuitBreakerState defines the type used to identify the state of a circuit breaker
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed identifies a circuit breaker that allows all requests towards the node
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+
+	// CircuitBreakerOpen identifies a circuit breaker that rejects all requests towards the node
+	CircuitBreakerOpen CircuitBreakerState = "open"
+
+	// CircuitBreakerHalfOpen identifies a circuit breaker that allows a single probe request towards the node
+	CircuitBreakerHalfOpen CircuitBreakerState = "half-open"
+)
+
+// NodeCircuitBreakerStatus holds the details about the circuit breaker of a node
+type NodeCircuitBreakerStatus struct {
+	Address             string              `json:"address"`
+	State               CircuitBreakerState `json:"state"`
+	ConsecutiveFailures uint32              `json:"consecutiveFailures"`
+	LastStateChange     time.Time           `json:"lastStateChange"`
+}
@@ -10,3 +10,17 @@ type EndpointMetrics struct {
 	LowestResponseTime  time.Duration `json:"lowest_response_time"`
 	HighestResponseTime time.Duration `json:"highest_response_time"`
 }
+
+// CacheMetrics holds statistics about the lookups in a specific responses cache
+type CacheMetrics struct {
+	NumHits   uint64 `json:"num_hits"`
+	NumMisses uint64 `json:"num_misses"`
+}
+
+// ApiKeyUsage holds statistics about the requests made with a specific API key
+type ApiKeyUsage struct {
+	NumRequests          uint64            `json:"num_requests"`
+	NumErrors            uint64            `json:"num_errors"`
+	LastRequestTimestamp int64             `json:"last_request_timestamp"`
+	Endpoints            map[string]uint64 `json:"endpoints"`
+}
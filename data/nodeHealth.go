@@ -0,0 +1,25 @@
+package data
+
+import "time"
+
+// NodeHealthStatus holds the details about the health of an observer or of a full history node, as seen by the proxy
+type NodeHealthStatus struct {
+	Address              string    `json:"address"`
+	Type                 NodeType  `json:"type"`
+	ShardId              uint32    `json:"shard"`
+	IsFallback           bool      `json:"isFallback"`
+	IsSynced             bool      `json:"isSynced"`
+	IsLastSyncedBackup   bool      `json:"isLastSyncedBackup"`
+	IsQuarantined        bool      `json:"isQuarantined"`
+	Nonce                uint64    `json:"nonce"`
+	ProbableHighestNonce uint64    `json:"probableHighestNonce"`
+	NonceLag             uint64    `json:"nonceLag"`
+	LastCheck            time.Time `json:"lastCheck"`
+	LastCheckError       string    `json:"lastCheckError,omitempty"`
+	NumFailedChecks      uint64    `json:"numFailedChecks"`
+	NumRequests          uint64    `json:"numRequests"`
+	NumErrors            uint64    `json:"numErrors"`
+	LatencyP50Ms         float64   `json:"latencyP50Ms"`
+	LatencyP90Ms         float64   `json:"latencyP90Ms"`
+	LatencyP99Ms         float64   `json:"latencyP99Ms"`
+}
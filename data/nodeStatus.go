@@ -37,11 +37,18 @@ type StatusResponse struct {
 	Running bool   `json:"running"`
 }
 
-// NodeStatusResponse holds the metrics returned from the node
+// NodeStatusResponse holds the metrics returned from the node. ShardId is nil if the node did not return its shard,
+// while the other metrics hold their zero values if not returned
 type NodeStatusResponse struct {
-	Nonce                uint64 `json:"erd_nonce"`
-	ProbableHighestNonce uint64 `json:"erd_probable_highest_nonce"`
-	AreVmQueriesReady    string `json:"erd_are_vm_queries_ready"`
+	Nonce                uint64  `json:"erd_nonce"`
+	ProbableHighestNonce uint64  `json:"erd_probable_highest_nonce"`
+	CurrentRound         uint64  `json:"erd_current_round"`
+	SynchronizedRound    uint64  `json:"erd_synchronized_round"`
+	AreVmQueriesReady    string  `json:"erd_are_vm_queries_ready"`
+	ShardId              *uint32 `json:"erd_shard_id"`
+	ChainID              string  `json:"erd_chain_id"`
+	NumShardsWithoutMeta uint32  `json:"erd_num_shards_without_meta"`
+	RoundDuration        uint64  `json:"erd_round_duration"`
 }
 
 // NodeStatusAPIResponseData holds the mapping of the data field when returning the status of a node
@@ -0,0 +1,21 @@
+package data
+
+import "time"
+
+// NodeWithMetrics holds a node together with the metrics returned by its /node/status endpoint. Metrics is nil if the
+// node could not be asked for its status, Error holding the reason
+type NodeWithMetrics struct {
+	Node    *NodeData
+	Metrics *NodeStatusResponse
+	Error   error
+}
+
+// QuarantinedNode holds the details about a node which is not used because the values it reports do not match its
+// configuration or the values reported by the other nodes
+type QuarantinedNode struct {
+	Address         string    `json:"address"`
+	ConfiguredShard uint32    `json:"configuredShard"`
+	IsFallback      bool      `json:"isFallback"`
+	Reason          string    `json:"reason"`
+	QuarantinedAt   time.Time `json:"quarantinedAt"`
+}
@@ -2,10 +2,11 @@ package data
 
 // NodeData holds an observer data
 type NodeData struct {
-	ShardId    uint32
-	Address    string
-	IsSynced   bool
-	IsFallback bool
+	ShardId       uint32
+	Address       string
+	IsSynced      bool
+	IsFallback    bool
+	IsQuarantined bool
 }
 
 // NodesReloadResponse is a DTO that holds details about nodes reloading
@@ -139,8 +139,43 @@ type ResponseTransactionSimulationCrossShard struct {
 
 // MultipleTransactionsResponseData holds the data which is returned when sending a bulk of transactions
 type MultipleTransactionsResponseData struct {
-	NumOfTxs  uint64         `json:"txsSent"`
-	TxsHashes map[int]string `json:"txsHashes"`
+	NumOfTxs   uint64                          `json:"txsSent"`
+	TxsHashes  map[int]string                  `json:"txsHashes"`
+	TxsResults []*MultipleTransactionsTxResult `json:"txsResults,omitempty"`
+}
+
+// TxSendStatus is the outcome of sending a transaction out of a bulk
+type TxSendStatus string
+
+const (
+	// TxSendStatusAccepted means that the transaction was accepted by an observer of its sender's shard
+	TxSendStatusAccepted TxSendStatus = "accepted"
+
+	// TxSendStatusRejectedLocally means that the transaction was invalid, so it was not relayed to the observers
+	TxSendStatusRejectedLocally TxSendStatus = "rejectedLocally"
+
+	// TxSendStatusRejectedByObserver means that the observer which received the transaction did not accept it
+	TxSendStatusRejectedByObserver TxSendStatus = "rejectedByObserver"
+
+	// TxSendStatusNotSent means that no observer of the sender's shard could be reached
+	TxSendStatusNotSent TxSendStatus = "notSent"
+)
+
+// MultipleTransactionsTxResult holds the outcome of sending the transaction found at the given index of a bulk
+type MultipleTransactionsTxResult struct {
+	Index   int          `json:"index"`
+	Status  TxSendStatus `json:"status"`
+	TxHash  string       `json:"txHash,omitempty"`
+	ShardID *uint32      `json:"shardID,omitempty"`
+	Reason  string       `json:"reason,omitempty"`
+}
+
+// TransactionWaitResult holds the latest known state of a transaction waited for. The transaction is nil if it was
+// not found yet, in which case the status is unknown
+type TransactionWaitResult struct {
+	Transaction *transaction.ApiTransactionResult `json:"transaction"`
+	Status      string                            `json:"status"`
+	Completed   bool                              `json:"completed"`
 }
 
 // ResponseMultipleTransactions defines a response from the node holding the number of transactions sent to the chain
@@ -248,6 +283,17 @@ type NonceGap struct {
 	To   uint64 `json:"to"`
 }
 
+// NonceReservation holds a nonce reserved for a sender, which is not handed out again until the reservation expires.
+// FillsGap is true if the nonce is missing from the transactions pool, between the account nonce and the last nonce in
+// the pool, as reported in NonceGaps. ExpiresAt is a unix timestamp, in seconds
+type NonceReservation struct {
+	Nonce        uint64     `json:"nonce"`
+	FillsGap     bool       `json:"fillsGap"`
+	AccountNonce uint64     `json:"accountNonce"`
+	NonceGaps    []NonceGap `json:"nonceGaps"`
+	ExpiresAt    int64      `json:"expiresAt"`
+}
+
 // TransactionsPoolNonceGaps represents a structure that holds nonce gaps
 type TransactionsPoolNonceGaps struct {
 	Gaps []NonceGap `json:"gaps"`
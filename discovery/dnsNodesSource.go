@@ -0,0 +1,171 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+const (
+	// SrvRecordType is the type of the DNS records which point to the nodes' hosts and ports
+	SrvRecordType = "SRV"
+
+	// ARecordType is the type of the DNS records which point to the nodes' IPs, the port being set in the config
+	ARecordType = "A"
+
+	dnsLookupTimeout = 5 * time.Second
+)
+
+// ArgsDnsNodesSource holds the arguments needed for creating a new DNS nodes source
+type ArgsDnsNodesSource struct {
+	Resolver                DnsResolver
+	Scheme                  string
+	ObserversRecords        []config.DnsRecordConfig
+	FullHistoryNodesRecords []config.DnsRecordConfig
+}
+
+// dnsNodesSource resolves the configured DNS records on each call. An SRV record gives the host and the port of each
+// node, while an A record gives the IPs of the nodes, all of them listening on the configured port. The addresses
+// of the nodes are built with the configured scheme
+type dnsNodesSource struct {
+	resolver                DnsResolver
+	scheme                  string
+	observersRecords        []config.DnsRecordConfig
+	fullHistoryNodesRecords []config.DnsRecordConfig
+}
+
+// NewDnsNodesSource returns a new instance of dnsNodesSource
+func NewDnsNodesSource(args ArgsDnsNodesSource) (*dnsNodesSource, error) {
+	if args.Resolver == nil {
+		return nil, ErrNilDnsResolver
+	}
+	if args.Scheme != "http" && args.Scheme != "https" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidScheme, args.Scheme)
+	}
+	if len(args.ObserversRecords) == 0 {
+		return nil, ErrNoDnsRecords
+	}
+	err := checkDnsRecords(args.ObserversRecords)
+	if err != nil {
+		return nil, err
+	}
+	err = checkDnsRecords(args.FullHistoryNodesRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dnsNodesSource{
+		resolver:                args.Resolver,
+		scheme:                  args.Scheme,
+		observersRecords:        args.ObserversRecords,
+		fullHistoryNodesRecords: args.FullHistoryNodesRecords,
+	}, nil
+}
+
+func checkDnsRecords(records []config.DnsRecordConfig) error {
+	for _, record := range records {
+		if len(record.Name) == 0 {
+			return fmt.Errorf("%w: empty name", ErrInvalidDnsRecord)
+		}
+
+		switch record.Type {
+		case SrvRecordType:
+		case ARecordType:
+			if record.Port <= 0 || record.Port > 65535 {
+				return fmt.Errorf("%w: invalid port %d for %s", ErrInvalidDnsRecord, record.Port, record.Name)
+			}
+		default:
+			return fmt.Errorf("%w: unknown type %s for %s", ErrInvalidDnsRecord, record.Type, record.Name)
+		}
+	}
+
+	return nil
+}
+
+// GetNodes resolves the DNS records of the observers and of the full history nodes. A failed lookup fails the whole
+// call, so the nodes behind a record which cannot be resolved for the moment are not considered gone
+func (dns *dnsNodesSource) GetNodes() (*DiscoveredNodes, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dnsLookupTimeout)
+	defer cancel()
+
+	observers, err := dns.resolveRecords(ctx, dns.observersRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	fullHistoryNodes, err := dns.resolveRecords(ctx, dns.fullHistoryNodesRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscoveredNodes{
+		Observers:        observers,
+		FullHistoryNodes: fullHistoryNodes,
+	}, nil
+}
+
+func (dns *dnsNodesSource) resolveRecords(ctx context.Context, records []config.DnsRecordConfig) ([]*DiscoveredNode, error) {
+	nodes := make([]*DiscoveredNode, 0)
+	knownAddresses := make(map[string]struct{})
+	for _, record := range records {
+		hostsPorts, err := dns.resolveRecord(ctx, record)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve the %s record %s: %w", record.Type, record.Name, err)
+		}
+
+		for _, hostPort := range hostsPorts {
+			address := dns.scheme + "://" + hostPort
+			_, isKnown := knownAddresses[address]
+			if isKnown {
+				continue
+			}
+
+			knownAddresses[address] = struct{}{}
+			nodes = append(nodes, &DiscoveredNode{
+				Address:    address,
+				IsFallback: record.IsFallback,
+			})
+		}
+	}
+
+	return nodes, nil
+}
+
+func (dns *dnsNodesSource) resolveRecord(ctx context.Context, record config.DnsRecordConfig) ([]string, error) {
+	if record.Type == SrvRecordType {
+		_, srvRecords, err := dns.resolver.LookupSRV(ctx, "", "", record.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		hostsPorts := make([]string, 0, len(srvRecords))
+		for _, srvRecord := range srvRecords {
+			host := strings.TrimSuffix(srvRecord.Target, ".")
+			hostsPorts = append(hostsPorts, net.JoinHostPort(host, strconv.Itoa(int(srvRecord.Port))))
+		}
+
+		return hostsPorts, nil
+	}
+
+	hosts, err := dns.resolver.LookupHost(ctx, record.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	hostsPorts := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		hostsPorts = append(hostsPorts, net.JoinHostPort(host, strconv.Itoa(record.Port)))
+	}
+
+	return hostsPorts, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dns *dnsNodesSource) IsInterfaceNil() bool {
+	return dns == nil
+}
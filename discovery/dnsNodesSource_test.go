@@ -0,0 +1,143 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsDnsNodesSource() discovery.ArgsDnsNodesSource {
+	return discovery.ArgsDnsNodesSource{
+		Resolver: &mock.DnsResolverStub{},
+		Scheme:   "http",
+		ObserversRecords: []config.DnsRecordConfig{
+			{Type: discovery.SrvRecordType, Name: "_observer._tcp.elrond.local"},
+		},
+	}
+}
+
+func TestNewDnsNodesSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil resolver should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDnsNodesSource()
+		args.Resolver = nil
+		dns, err := discovery.NewDnsNodesSource(args)
+		require.Equal(t, discovery.ErrNilDnsResolver, err)
+		require.True(t, check.IfNil(dns))
+	})
+	t.Run("invalid scheme should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDnsNodesSource()
+		args.Scheme = "ftp"
+		dns, err := discovery.NewDnsNodesSource(args)
+		require.True(t, errors.Is(err, discovery.ErrInvalidScheme))
+		require.True(t, check.IfNil(dns))
+	})
+	t.Run("no observers records should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDnsNodesSource()
+		args.ObserversRecords = nil
+		dns, err := discovery.NewDnsNodesSource(args)
+		require.Equal(t, discovery.ErrNoDnsRecords, err)
+		require.True(t, check.IfNil(dns))
+	})
+	t.Run("invalid records should err", func(t *testing.T) {
+		t.Parallel()
+
+		invalidRecords := []config.DnsRecordConfig{
+			{Type: discovery.SrvRecordType},
+			{Type: "AAAA", Name: "observers.elrond.local"},
+			{Type: discovery.ARecordType, Name: "observers.elrond.local"},
+		}
+		for _, record := range invalidRecords {
+			args := createMockArgsDnsNodesSource()
+			args.FullHistoryNodesRecords = []config.DnsRecordConfig{record}
+			dns, err := discovery.NewDnsNodesSource(args)
+			require.True(t, errors.Is(err, discovery.ErrInvalidDnsRecord))
+			require.True(t, check.IfNil(dns))
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dns, err := discovery.NewDnsNodesSource(createMockArgsDnsNodesSource())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(dns))
+	})
+}
+
+func TestDnsNodesSource_GetNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDnsNodesSource()
+	args.Resolver = &mock.DnsResolverStub{
+		LookupSRVCalled: func(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error) {
+			require.Equal(t, "_observer._tcp.elrond.local", name)
+			return "", []*net.SRV{
+				{Target: "observer-0.elrond.local.", Port: 8080},
+				{Target: "observer-1.elrond.local.", Port: 8081},
+			}, nil
+		},
+		LookupHostCalled: func(ctx context.Context, host string) ([]string, error) {
+			if host == "fallback.elrond.local" {
+				return []string{"10.0.0.1"}, nil
+			}
+
+			require.Equal(t, "full-history.elrond.local", host)
+			return []string{"10.0.0.2", "fd00::2"}, nil
+		},
+	}
+	args.ObserversRecords = append(args.ObserversRecords, config.DnsRecordConfig{
+		Type:       discovery.ARecordType,
+		Name:       "fallback.elrond.local",
+		Port:       9090,
+		IsFallback: true,
+	})
+	args.FullHistoryNodesRecords = []config.DnsRecordConfig{
+		{Type: discovery.ARecordType, Name: "full-history.elrond.local", Port: 8080},
+	}
+	dns, _ := discovery.NewDnsNodesSource(args)
+
+	nodes, err := dns.GetNodes()
+	require.NoError(t, err)
+	require.Equal(t, &discovery.DiscoveredNodes{
+		Observers: []*discovery.DiscoveredNode{
+			{Address: "http://observer-0.elrond.local:8080"},
+			{Address: "http://observer-1.elrond.local:8081"},
+			{Address: "http://10.0.0.1:9090", IsFallback: true},
+		},
+		FullHistoryNodes: []*discovery.DiscoveredNode{
+			{Address: "http://10.0.0.2:8080"},
+			{Address: "http://[fd00::2]:8080"},
+		},
+	}, nodes)
+}
+
+func TestDnsNodesSource_GetNodesLookupErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("no such host")
+	args := createMockArgsDnsNodesSource()
+	args.Resolver = &mock.DnsResolverStub{
+		LookupSRVCalled: func(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error) {
+			return "", nil, expectedErr
+		},
+	}
+	dns, _ := discovery.NewDnsNodesSource(args)
+
+	nodes, err := dns.GetNodes()
+	require.True(t, errors.Is(err, expectedErr))
+	require.Nil(t, nodes)
+}
@@ -0,0 +1,48 @@
+package discovery
+
+import "errors"
+
+// ErrInvalidDiscoveryType signals that an unknown nodes discovery type has been provided
+var ErrInvalidDiscoveryType = errors.New("invalid nodes discovery type")
+
+// ErrEmptyFilePath signals that an empty nodes file path has been provided
+var ErrEmptyFilePath = errors.New("empty nodes file path")
+
+// ErrEmptyNodeAddress signals that a node without address has been found
+var ErrEmptyNodeAddress = errors.New("empty node address")
+
+// ErrNilDnsResolver signals that a nil DNS resolver has been provided
+var ErrNilDnsResolver = errors.New("nil DNS resolver")
+
+// ErrInvalidScheme signals that an invalid scheme of the nodes' addresses has been provided
+var ErrInvalidScheme = errors.New("invalid scheme")
+
+// ErrInvalidDnsRecord signals that an invalid DNS record config has been provided
+var ErrInvalidDnsRecord = errors.New("invalid DNS record")
+
+// ErrNoDnsRecords signals that no DNS record has been provided for the observers
+var ErrNoDnsRecords = errors.New("no DNS records for the observers")
+
+// ErrNilNodesSource signals that a nil nodes source has been provided
+var ErrNilNodesSource = errors.New("nil nodes source")
+
+// ErrNilShardIDResolver signals that a nil shard ID resolver has been provided
+var ErrNilShardIDResolver = errors.New("nil shard ID resolver")
+
+// ErrInvalidRefreshInterval signals that an invalid nodes refresh interval has been provided
+var ErrInvalidRefreshInterval = errors.New("invalid refresh interval")
+
+// ErrInvalidTimeout signals that an invalid timeout has been provided
+var ErrInvalidTimeout = errors.New("invalid timeout")
+
+// ErrNilNodesProvider signals that a nil nodes provider has been provided
+var ErrNilNodesProvider = errors.New("nil nodes provider")
+
+// ErrNoObserversDiscovered signals that no observer with a known shard has been discovered
+var ErrNoObserversDiscovered = errors.New("no observers discovered")
+
+// ErrMissingShardIDMetric signals that a node did not return its shard ID metric
+var ErrMissingShardIDMetric = errors.New("missing shard ID metric")
+
+// ErrDiscoveryAlreadyStarted signals that the nodes providers are already updated by the discoverer
+var ErrDiscoveryAlreadyStarted = errors.New("nodes discovery already started")
@@ -0,0 +1,8 @@
+package discovery
+
+import "github.com/ElrondNetwork/elrond-proxy-go/observer"
+
+// RefreshNodes -
+func (nd *nodesDiscoverer) RefreshNodes(observersProvider observer.NodesProviderHandler, fullHistoryNodesProvider observer.NodesProviderHandler) {
+	nd.refreshNodes(observersProvider, fullHistoryNodesProvider)
+}
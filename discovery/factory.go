@@ -0,0 +1,54 @@
+package discovery
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+const (
+	// FileDiscoveryType is the type of the discovery which reads the nodes from a file maintained by an orchestrator
+	FileDiscoveryType = "file"
+
+	// DnsDiscoveryType is the type of the discovery which resolves the nodes from DNS SRV or A records
+	DnsDiscoveryType = "dns"
+
+	shardIDResolveTimeout = 2 * time.Second
+)
+
+// CreateNodesDiscoverer creates the nodes discoverer defined by the provided config
+func CreateNodesDiscoverer(cfg config.ObserversDiscoveryConfig) (NodesDiscovererHandler, error) {
+	nodesSource, err := createNodesSource(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	shardIDResolver, err := NewNodeStatusShardIDResolver(shardIDResolveTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNodesDiscoverer(ArgsNodesDiscoverer{
+		NodesSource:     nodesSource,
+		ShardIDResolver: shardIDResolver,
+		RefreshInterval: time.Duration(cfg.RefreshIntervalSec) * time.Second,
+	})
+}
+
+func createNodesSource(cfg config.ObserversDiscoveryConfig) (NodesSource, error) {
+	switch cfg.Type {
+	case FileDiscoveryType:
+		return NewFileNodesSource(cfg.FilePath)
+	case DnsDiscoveryType:
+		return NewDnsNodesSource(ArgsDnsNodesSource{
+			Resolver:                net.DefaultResolver,
+			Scheme:                  cfg.Dns.Scheme,
+			ObserversRecords:        cfg.Dns.Observers,
+			FullHistoryNodesRecords: cfg.Dns.FullHistoryNodes,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDiscoveryType, cfg.Type)
+	}
+}
@@ -0,0 +1,80 @@
+package discovery
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// DiscoveredNode holds a node found by a nodes source. Its shard is resolved afterwards, by asking the node itself
+type DiscoveredNode struct {
+	Address    string
+	IsFallback bool
+}
+
+// DiscoveredNodes holds the nodes found by a nodes source. It is also the format of the nodes file
+type DiscoveredNodes struct {
+	Observers        []*DiscoveredNode
+	FullHistoryNodes []*DiscoveredNode
+}
+
+// fileNodesSource reads the nodes from a file maintained by an external orchestrator. The file is read again on each
+// call, so the nodes added or removed by the orchestrator are found by the next refresh. Files with the .json
+// extension are decoded as JSON, while any other file is decoded as TOML
+type fileNodesSource struct {
+	filePath string
+}
+
+// NewFileNodesSource returns a new instance of fileNodesSource
+func NewFileNodesSource(filePath string) (*fileNodesSource, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	return &fileNodesSource{
+		filePath: filePath,
+	}, nil
+}
+
+// GetNodes reads the nodes from the file
+func (fns *fileNodesSource) GetNodes() (*DiscoveredNodes, error) {
+	nodes := &DiscoveredNodes{}
+
+	var err error
+	if strings.EqualFold(filepath.Ext(fns.filePath), ".json") {
+		err = core.LoadJsonFile(nodes, fns.filePath)
+	} else {
+		err = core.LoadTomlFile(nodes, fns.filePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot load nodes file at %s: %w", fns.filePath, err)
+	}
+
+	err = checkNodes(nodes.Observers)
+	if err != nil {
+		return nil, fmt.Errorf("%w for observers in %s", err, fns.filePath)
+	}
+	err = checkNodes(nodes.FullHistoryNodes)
+	if err != nil {
+		return nil, fmt.Errorf("%w for full history nodes in %s", err, fns.filePath)
+	}
+
+	return nodes, nil
+}
+
+func checkNodes(nodes []*DiscoveredNode) error {
+	for idx, node := range nodes {
+		if node == nil || len(node.Address) == 0 {
+			return fmt.Errorf("%w at index %d", ErrEmptyNodeAddress, idx)
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fns *fileNodesSource) IsInterfaceNil() bool {
+	return fns == nil
+}
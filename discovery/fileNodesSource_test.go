@@ -0,0 +1,102 @@
+package discovery_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery"
+	"github.com/stretchr/testify/require"
+)
+
+func writeNodesFile(t *testing.T, fileName string, contents string) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	err := os.WriteFile(filePath, []byte(contents), 0644)
+	require.NoError(t, err)
+
+	return filePath
+}
+
+func TestNewFileNodesSource(t *testing.T) {
+	t.Parallel()
+
+	fns, err := discovery.NewFileNodesSource("")
+	require.Equal(t, discovery.ErrEmptyFilePath, err)
+	require.True(t, check.IfNil(fns))
+
+	fns, err = discovery.NewFileNodesSource("nodes.json")
+	require.NoError(t, err)
+	require.False(t, check.IfNil(fns))
+}
+
+func TestFileNodesSource_GetNodes(t *testing.T) {
+	t.Parallel()
+
+	expectedNodes := &discovery.DiscoveredNodes{
+		Observers: []*discovery.DiscoveredNode{
+			{Address: "http://10.0.0.1:8080"},
+			{Address: "http://10.0.0.2:8080", IsFallback: true},
+		},
+		FullHistoryNodes: []*discovery.DiscoveredNode{
+			{Address: "http://10.0.0.3:8080"},
+		},
+	}
+
+	t.Run("JSON file", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := writeNodesFile(t, "nodes.json", `{
+			"Observers": [
+				{"Address": "http://10.0.0.1:8080"},
+				{"Address": "http://10.0.0.2:8080", "IsFallback": true}
+			],
+			"FullHistoryNodes": [
+				{"Address": "http://10.0.0.3:8080"}
+			]
+		}`)
+		fns, _ := discovery.NewFileNodesSource(filePath)
+
+		nodes, err := fns.GetNodes()
+		require.NoError(t, err)
+		require.Equal(t, expectedNodes, nodes)
+	})
+	t.Run("TOML file", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := writeNodesFile(t, "nodes.toml", `
+			[[Observers]]
+			   Address = "http://10.0.0.1:8080"
+			[[Observers]]
+			   Address = "http://10.0.0.2:8080"
+			   IsFallback = true
+			[[FullHistoryNodes]]
+			   Address = "http://10.0.0.3:8080"
+		`)
+		fns, _ := discovery.NewFileNodesSource(filePath)
+
+		nodes, err := fns.GetNodes()
+		require.NoError(t, err)
+		require.Equal(t, expectedNodes, nodes)
+	})
+	t.Run("node without address should err", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := writeNodesFile(t, "nodes.json", `{"Observers": [{"Address": "http://10.0.0.1:8080"}, {"IsFallback": true}]}`)
+		fns, _ := discovery.NewFileNodesSource(filePath)
+
+		nodes, err := fns.GetNodes()
+		require.True(t, errors.Is(err, discovery.ErrEmptyNodeAddress))
+		require.Nil(t, nodes)
+	})
+	t.Run("missing file should err", func(t *testing.T) {
+		t.Parallel()
+
+		fns, _ := discovery.NewFileNodesSource(filepath.Join(t.TempDir(), "missing.json"))
+
+		nodes, err := fns.GetNodes()
+		require.Error(t, err)
+		require.Nil(t, nodes)
+	})
+}
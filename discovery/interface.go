@@ -0,0 +1,35 @@
+package discovery
+
+import (
+	"context"
+	"net"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+)
+
+// NodesSource defines a source from which the addresses of the observers and of the full history nodes are taken
+type NodesSource interface {
+	GetNodes() (*DiscoveredNodes, error)
+	IsInterfaceNil() bool
+}
+
+// ShardIDResolver defines a component able to find the shard of a node
+type ShardIDResolver interface {
+	ResolveShardID(address string) (uint32, error)
+	IsInterfaceNil() bool
+}
+
+// DnsResolver defines the DNS lookups needed for discovering the nodes. It is satisfied by *net.Resolver
+type DnsResolver interface {
+	LookupSRV(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// NodesDiscovererHandler defines what a nodes discoverer should be able to do
+type NodesDiscovererHandler interface {
+	DiscoverNodes() ([]*data.NodeData, []*data.NodeData, error)
+	StartUpdatingNodes(observersProvider observer.NodesProviderHandler, fullHistoryNodesProvider observer.NodesProviderHandler) error
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+	"net"
+)
+
+// DnsResolverStub -
+type DnsResolverStub struct {
+	LookupSRVCalled  func(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error)
+	LookupHostCalled func(ctx context.Context, host string) ([]string, error)
+}
+
+// LookupSRV -
+func (drs *DnsResolverStub) LookupSRV(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error) {
+	if drs.LookupSRVCalled != nil {
+		return drs.LookupSRVCalled(ctx, service, proto, name)
+	}
+
+	return "", nil, nil
+}
+
+// LookupHost -
+func (drs *DnsResolverStub) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if drs.LookupHostCalled != nil {
+		return drs.LookupHostCalled(ctx, host)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/discovery"
+
+// NodesSourceStub -
+type NodesSourceStub struct {
+	GetNodesCalled func() (*discovery.DiscoveredNodes, error)
+}
+
+// GetNodes -
+func (nss *NodesSourceStub) GetNodes() (*discovery.DiscoveredNodes, error) {
+	if nss.GetNodesCalled != nil {
+		return nss.GetNodesCalled()
+	}
+
+	return &discovery.DiscoveredNodes{}, nil
+}
+
+// IsInterfaceNil -
+func (nss *NodesSourceStub) IsInterfaceNil() bool {
+	return nss == nil
+}
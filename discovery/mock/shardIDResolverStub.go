@@ -0,0 +1,20 @@
+package mock
+
+// ShardIDResolverStub -
+type ShardIDResolverStub struct {
+	ResolveShardIDCalled func(address string) (uint32, error)
+}
+
+// ResolveShardID -
+func (sirs *ShardIDResolverStub) ResolveShardID(address string) (uint32, error) {
+	if sirs.ResolveShardIDCalled != nil {
+		return sirs.ResolveShardIDCalled(address)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (sirs *ShardIDResolverStub) IsInterfaceNil() bool {
+	return sirs == nil
+}
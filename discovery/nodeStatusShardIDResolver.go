@@ -0,0 +1,66 @@
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// nodeStatusShardIDResolver finds the shard of a node from the shard ID metric returned by its /node/status endpoint
+type nodeStatusShardIDResolver struct {
+	httpClient *http.Client
+}
+
+// NewNodeStatusShardIDResolver returns a new instance of nodeStatusShardIDResolver
+func NewNodeStatusShardIDResolver(timeout time.Duration) (*nodeStatusShardIDResolver, error) {
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTimeout, timeout)
+	}
+
+	return &nodeStatusShardIDResolver{
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// ResolveShardID asks the node for its status and returns its shard ID
+func (resolver *nodeStatusShardIDResolver) ResolveShardID(address string) (uint32, error) {
+	resp, err := resolver.httpClient.Get(address + "/node/status")
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		log.LogIfError(resp.Body.Close())
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("node %s responded with code %d", address, resp.StatusCode)
+	}
+
+	responseBodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	var nodeStatusResponse data.NodeStatusAPIResponse
+	err = json.Unmarshal(responseBodyBytes, &nodeStatusResponse)
+	if err != nil {
+		return 0, err
+	}
+
+	shardID := nodeStatusResponse.Data.Metrics.ShardId
+	if shardID == nil {
+		return 0, fmt.Errorf("%w from node %s", ErrMissingShardIDMetric, address)
+	}
+
+	return *shardID, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (resolver *nodeStatusShardIDResolver) IsInterfaceNil() bool {
+	return resolver == nil
+}
@@ -0,0 +1,66 @@
+package discovery_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeStatusServer(t *testing.T, statusCode int, response string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/node/status", r.URL.Path)
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewNodeStatusShardIDResolver(t *testing.T) {
+	t.Parallel()
+
+	resolver, err := discovery.NewNodeStatusShardIDResolver(0)
+	require.True(t, errors.Is(err, discovery.ErrInvalidTimeout))
+	require.True(t, check.IfNil(resolver))
+
+	resolver, err = discovery.NewNodeStatusShardIDResolver(time.Second)
+	require.NoError(t, err)
+	require.False(t, check.IfNil(resolver))
+}
+
+func TestNodeStatusShardIDResolver_ResolveShardID(t *testing.T) {
+	t.Parallel()
+
+	resolver, _ := discovery.NewNodeStatusShardIDResolver(time.Second)
+
+	t.Run("should return the shard metric", func(t *testing.T) {
+		t.Parallel()
+
+		server := createNodeStatusServer(t, http.StatusOK, `{"data":{"metrics":{"erd_nonce":10,"erd_shard_id":4294967295}},"code":"successful"}`)
+		shardID, err := resolver.ResolveShardID(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, core.MetachainShardId, shardID)
+	})
+	t.Run("missing shard metric should err", func(t *testing.T) {
+		t.Parallel()
+
+		server := createNodeStatusServer(t, http.StatusOK, `{"data":{"metrics":{"erd_nonce":10}},"code":"successful"}`)
+		_, err := resolver.ResolveShardID(server.URL)
+		require.True(t, errors.Is(err, discovery.ErrMissingShardIDMetric))
+	})
+	t.Run("error status code should err", func(t *testing.T) {
+		t.Parallel()
+
+		server := createNodeStatusServer(t, http.StatusInternalServerError, "")
+		_, err := resolver.ResolveShardID(server.URL)
+		require.Error(t, err)
+	})
+}
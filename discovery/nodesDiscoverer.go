@@ -0,0 +1,278 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+)
+
+var log = logger.GetOrCreate("discovery")
+
+// minRefreshInterval represents the minimum interval between two discoveries of the nodes
+const minRefreshInterval = time.Second
+
+// ArgsNodesDiscoverer holds the arguments needed for creating a new nodes discoverer
+type ArgsNodesDiscoverer struct {
+	NodesSource     NodesSource
+	ShardIDResolver ShardIDResolver
+	RefreshInterval time.Duration
+}
+
+// nodesDiscoverer takes the nodes from a nodes source, resolves their shards and feeds them to the nodes providers.
+// The shard of a node is resolved only once, while the node is discovered, and the nodes whose shard cannot be
+// resolved are skipped until a following refresh
+type nodesDiscoverer struct {
+	nodesSource     NodesSource
+	shardIDResolver ShardIDResolver
+	refreshInterval time.Duration
+
+	mutDiscovery         sync.Mutex
+	knownShardIDs        map[string]uint32
+	lastObservers        []*data.NodeData
+	lastFullHistoryNodes []*data.NodeData
+	cancelFunc           func()
+}
+
+// NewNodesDiscoverer returns a new instance of nodesDiscoverer
+func NewNodesDiscoverer(args ArgsNodesDiscoverer) (*nodesDiscoverer, error) {
+	if check.IfNil(args.NodesSource) {
+		return nil, ErrNilNodesSource
+	}
+	if check.IfNil(args.ShardIDResolver) {
+		return nil, ErrNilShardIDResolver
+	}
+	if args.RefreshInterval < minRefreshInterval {
+		return nil, fmt.Errorf("%w: provided %v, minimum %v", ErrInvalidRefreshInterval, args.RefreshInterval, minRefreshInterval)
+	}
+
+	return &nodesDiscoverer{
+		nodesSource:     args.NodesSource,
+		shardIDResolver: args.ShardIDResolver,
+		refreshInterval: args.RefreshInterval,
+		knownShardIDs:   make(map[string]uint32),
+	}, nil
+}
+
+// DiscoverNodes returns the observers and the full history nodes currently found. It errors if no observer is found
+func (nd *nodesDiscoverer) DiscoverNodes() ([]*data.NodeData, []*data.NodeData, error) {
+	nd.mutDiscovery.Lock()
+	defer nd.mutDiscovery.Unlock()
+
+	observers, fullHistoryNodes, err := nd.discoverNodesUnprotected()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nd.lastObservers = observers
+	nd.lastFullHistoryNodes = fullHistoryNodes
+
+	return observers, fullHistoryNodes, nil
+}
+
+// StartUpdatingNodes starts discovering the nodes periodically. Once the discovered nodes change, they replace the
+// nodes of the providers
+func (nd *nodesDiscoverer) StartUpdatingNodes(
+	observersProvider observer.NodesProviderHandler,
+	fullHistoryNodesProvider observer.NodesProviderHandler,
+) error {
+	if check.IfNil(observersProvider) {
+		return fmt.Errorf("%w for observers", ErrNilNodesProvider)
+	}
+	if check.IfNil(fullHistoryNodesProvider) {
+		return fmt.Errorf("%w for full history nodes", ErrNilNodesProvider)
+	}
+
+	nd.mutDiscovery.Lock()
+	defer nd.mutDiscovery.Unlock()
+
+	if nd.cancelFunc != nil {
+		return ErrDiscoveryAlreadyStarted
+	}
+
+	var ctx context.Context
+	ctx, nd.cancelFunc = context.WithCancel(context.Background())
+
+	go nd.refreshLoop(ctx, observersProvider, fullHistoryNodesProvider)
+
+	return nil
+}
+
+func (nd *nodesDiscoverer) refreshLoop(
+	ctx context.Context,
+	observersProvider observer.NodesProviderHandler,
+	fullHistoryNodesProvider observer.NodesProviderHandler,
+) {
+	timer := time.NewTimer(nd.refreshInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			nd.refreshNodes(observersProvider, fullHistoryNodesProvider)
+			timer.Reset(nd.refreshInterval)
+		case <-ctx.Done():
+			log.Debug("closing nodes discovery loop")
+			return
+		}
+	}
+}
+
+func (nd *nodesDiscoverer) refreshNodes(
+	observersProvider observer.NodesProviderHandler,
+	fullHistoryNodesProvider observer.NodesProviderHandler,
+) {
+	nd.mutDiscovery.Lock()
+	defer nd.mutDiscovery.Unlock()
+
+	observers, fullHistoryNodes, err := nd.discoverNodesUnprotected()
+	if err != nil {
+		log.Warn("cannot discover the nodes, the current ones are kept", "error", err)
+		return
+	}
+
+	// the same rejected nodes are not provided again
+	if !haveSameNodes(observers, nd.lastObservers) {
+		updateNodes(observersProvider, observers, data.Observer)
+		nd.lastObservers = observers
+	}
+	if !haveSameNodes(fullHistoryNodes, nd.lastFullHistoryNodes) {
+		updateNodes(fullHistoryNodesProvider, fullHistoryNodes, data.FullHistoryNode)
+		nd.lastFullHistoryNodes = fullHistoryNodes
+	}
+}
+
+func updateNodes(provider observer.NodesProviderHandler, nodes []*data.NodeData, nodesType data.NodeType) {
+	err := provider.UpdateNodes(nodes)
+	if err != nil {
+		log.Warn("cannot use the discovered nodes, the current ones are kept", "type", nodesType, "error", err)
+		return
+	}
+
+	log.Info("using the discovered nodes", "type", nodesType, "num nodes", len(nodes))
+}
+
+func (nd *nodesDiscoverer) discoverNodesUnprotected() ([]*data.NodeData, []*data.NodeData, error) {
+	discoveredNodes, err := nd.nodesSource.GetNodes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addresses := make([]string, 0, len(discoveredNodes.Observers)+len(discoveredNodes.FullHistoryNodes))
+	for _, node := range discoveredNodes.Observers {
+		addresses = append(addresses, node.Address)
+	}
+	for _, node := range discoveredNodes.FullHistoryNodes {
+		addresses = append(addresses, node.Address)
+	}
+	nd.resolveShardIDsUnprotected(addresses)
+
+	observers := nd.createNodesDataUnprotected(discoveredNodes.Observers)
+	if len(observers) == 0 {
+		return nil, nil, ErrNoObserversDiscovered
+	}
+
+	return observers, nd.createNodesDataUnprotected(discoveredNodes.FullHistoryNodes), nil
+}
+
+// resolveShardIDsUnprotected resolves the shards of the new addresses and forgets the addresses which are not
+// provided anymore, so a node moved to another shard is resolved again once it reappears
+func (nd *nodesDiscoverer) resolveShardIDsUnprotected(addresses []string) {
+	knownShardIDs := make(map[string]uint32, len(addresses))
+	unresolvedAddresses := make(map[string]struct{})
+	for _, address := range addresses {
+		shardID, isKnown := nd.knownShardIDs[address]
+		if isKnown {
+			knownShardIDs[address] = shardID
+			continue
+		}
+
+		unresolvedAddresses[address] = struct{}{}
+	}
+
+	mutKnownShardIDs := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(len(unresolvedAddresses))
+	for address := range unresolvedAddresses {
+		go func(address string) {
+			defer wg.Done()
+
+			shardID, err := nd.shardIDResolver.ResolveShardID(address)
+			if err != nil {
+				log.Warn("cannot resolve the shard of the discovered node, it is not used", "address", address, "error", err)
+				return
+			}
+
+			log.Info("discovered node", "address", address, "shard", shardID)
+			mutKnownShardIDs.Lock()
+			knownShardIDs[address] = shardID
+			mutKnownShardIDs.Unlock()
+		}(address)
+	}
+	wg.Wait()
+
+	nd.knownShardIDs = knownShardIDs
+}
+
+func (nd *nodesDiscoverer) createNodesDataUnprotected(discoveredNodes []*DiscoveredNode) []*data.NodeData {
+	nodes := make([]*data.NodeData, 0, len(discoveredNodes))
+	for _, discoveredNode := range discoveredNodes {
+		shardID, isKnown := nd.knownShardIDs[discoveredNode.Address]
+		if !isKnown {
+			continue
+		}
+
+		nodes = append(nodes, &data.NodeData{
+			ShardId:    shardID,
+			Address:    discoveredNode.Address,
+			IsFallback: discoveredNode.IsFallback,
+		})
+	}
+
+	return nodes
+}
+
+func haveSameNodes(nodes []*data.NodeData, otherNodes []*data.NodeData) bool {
+	if len(nodes) != len(otherNodes) {
+		return false
+	}
+
+	nodesKeys := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		nodesKeys[nodeKey(node)] = struct{}{}
+	}
+	for _, node := range otherNodes {
+		_, found := nodesKeys[nodeKey(node)]
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func nodeKey(node *data.NodeData) string {
+	return fmt.Sprintf("%s/%d/%v", node.Address, node.ShardId, node.IsFallback)
+}
+
+// Close stops the periodic discovery of the nodes
+func (nd *nodesDiscoverer) Close() error {
+	nd.mutDiscovery.Lock()
+	defer nd.mutDiscovery.Unlock()
+
+	if nd.cancelFunc != nil {
+		nd.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nd *nodesDiscoverer) IsInterfaceNil() bool {
+	return nd == nil
+}
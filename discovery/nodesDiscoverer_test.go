@@ -0,0 +1,202 @@
+package discovery_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery"
+	"github.com/ElrondNetwork/elrond-proxy-go/discovery/mock"
+	processMock "github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsNodesDiscoverer(nodes *discovery.DiscoveredNodes) discovery.ArgsNodesDiscoverer {
+	return discovery.ArgsNodesDiscoverer{
+		NodesSource: &mock.NodesSourceStub{
+			GetNodesCalled: func() (*discovery.DiscoveredNodes, error) {
+				return nodes, nil
+			},
+		},
+		ShardIDResolver: &mock.ShardIDResolverStub{},
+		RefreshInterval: time.Second,
+	}
+}
+
+func TestNewNodesDiscoverer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes source should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{})
+		args.NodesSource = nil
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.Equal(t, discovery.ErrNilNodesSource, err)
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("nil shard ID resolver should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{})
+		args.ShardIDResolver = nil
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.Equal(t, discovery.ErrNilShardIDResolver, err)
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("invalid refresh interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{})
+		args.RefreshInterval = time.Millisecond
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.True(t, errors.Is(err, discovery.ErrInvalidRefreshInterval))
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nd, err := discovery.NewNodesDiscoverer(createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{}))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nd))
+		require.NoError(t, nd.Close())
+	})
+}
+
+func TestNodesDiscoverer_DiscoverNodesShouldSkipNodesWithUnknownShard(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{
+		Observers: []*discovery.DiscoveredNode{
+			{Address: "observer-0"},
+			{Address: "observer-1", IsFallback: true},
+			{Address: "unreachable"},
+		},
+		FullHistoryNodes: []*discovery.DiscoveredNode{
+			{Address: "observer-0"},
+		},
+	})
+	mutResolvedAddresses := sync.Mutex{}
+	resolvedAddresses := make(map[string]int)
+	args.ShardIDResolver = &mock.ShardIDResolverStub{
+		ResolveShardIDCalled: func(address string) (uint32, error) {
+			mutResolvedAddresses.Lock()
+			resolvedAddresses[address]++
+			mutResolvedAddresses.Unlock()
+
+			switch address {
+			case "observer-0":
+				return 0, nil
+			case "observer-1":
+				return 1, nil
+			default:
+				return 0, errors.New("connection refused")
+			}
+		},
+	}
+	nd, _ := discovery.NewNodesDiscoverer(args)
+
+	observers, fullHistoryNodes, err := nd.DiscoverNodes()
+	require.NoError(t, err)
+	require.Equal(t, []*data.NodeData{
+		{Address: "observer-0", ShardId: 0},
+		{Address: "observer-1", ShardId: 1, IsFallback: true},
+	}, observers)
+	require.Equal(t, []*data.NodeData{{Address: "observer-0", ShardId: 0}}, fullHistoryNodes)
+
+	_, _, _ = nd.DiscoverNodes()
+	require.Equal(t, map[string]int{"observer-0": 1, "observer-1": 1, "unreachable": 2}, resolvedAddresses)
+}
+
+func TestNodesDiscoverer_DiscoverNodesErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nodes source error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsNodesDiscoverer(nil)
+		args.NodesSource = &mock.NodesSourceStub{
+			GetNodesCalled: func() (*discovery.DiscoveredNodes, error) {
+				return nil, expectedErr
+			},
+		}
+		nd, _ := discovery.NewNodesDiscoverer(args)
+
+		_, _, err := nd.DiscoverNodes()
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("no observers should err", func(t *testing.T) {
+		t.Parallel()
+
+		nd, _ := discovery.NewNodesDiscoverer(createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{
+			FullHistoryNodes: []*discovery.DiscoveredNode{{Address: "observer-0"}},
+		}))
+
+		_, _, err := nd.DiscoverNodes()
+		require.Equal(t, discovery.ErrNoObserversDiscovered, err)
+	})
+}
+
+func TestNodesDiscoverer_StartUpdatingNodesNilProvidersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nd, _ := discovery.NewNodesDiscoverer(createMockArgsNodesDiscoverer(&discovery.DiscoveredNodes{}))
+
+	err := nd.StartUpdatingNodes(nil, &processMock.ObserversProviderStub{})
+	require.True(t, errors.Is(err, discovery.ErrNilNodesProvider))
+
+	err = nd.StartUpdatingNodes(&processMock.ObserversProviderStub{}, nil)
+	require.True(t, errors.Is(err, discovery.ErrNilNodesProvider))
+
+	err = nd.StartUpdatingNodes(&processMock.ObserversProviderStub{}, &processMock.ObserversProviderStub{})
+	require.NoError(t, err)
+
+	err = nd.StartUpdatingNodes(&processMock.ObserversProviderStub{}, &processMock.ObserversProviderStub{})
+	require.Equal(t, discovery.ErrDiscoveryAlreadyStarted, err)
+	require.NoError(t, nd.Close())
+}
+
+func TestNodesDiscoverer_RefreshNodesShouldUpdateOnlyTheChangedNodes(t *testing.T) {
+	t.Parallel()
+
+	discoveredNodes := &discovery.DiscoveredNodes{
+		Observers: []*discovery.DiscoveredNode{{Address: "observer-0"}},
+	}
+	nd, _ := discovery.NewNodesDiscoverer(createMockArgsNodesDiscoverer(discoveredNodes))
+
+	numObserversUpdates := 0
+	observersProvider := &processMock.ObserversProviderStub{
+		UpdateNodesCalled: func(nodes []*data.NodeData) error {
+			numObserversUpdates++
+			require.Equal(t, len(discoveredNodes.Observers), len(nodes))
+			return nil
+		},
+	}
+	numFullHistoryNodesUpdates := 0
+	fullHistoryNodesProvider := &processMock.ObserversProviderStub{
+		UpdateNodesCalled: func(nodes []*data.NodeData) error {
+			numFullHistoryNodesUpdates++
+			return errors.New("full history nodes not supported")
+		},
+	}
+
+	_, _, _ = nd.DiscoverNodes()
+	nd.RefreshNodes(observersProvider, fullHistoryNodesProvider)
+	require.Equal(t, 0, numObserversUpdates)
+	require.Equal(t, 0, numFullHistoryNodesUpdates)
+
+	discoveredNodes.Observers = append(discoveredNodes.Observers, &discovery.DiscoveredNode{Address: "observer-1"})
+	nd.RefreshNodes(observersProvider, fullHistoryNodesProvider)
+	require.Equal(t, 1, numObserversUpdates)
+	require.Equal(t, 0, numFullHistoryNodesUpdates)
+
+	discoveredNodes.FullHistoryNodes = []*discovery.DiscoveredNode{{Address: "observer-1"}}
+	nd.RefreshNodes(observersProvider, fullHistoryNodesProvider)
+	nd.RefreshNodes(observersProvider, fullHistoryNodesProvider)
+	require.Equal(t, 1, numObserversUpdates)
+	require.Equal(t, 1, numFullHistoryNodesUpdates)
+}
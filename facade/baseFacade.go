@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
 
@@ -41,6 +42,9 @@ type ElrondProxyFacade struct {
 	proofProc        ProofProcessor
 	esdtSuppliesProc ESDTSupplyProcessor
 	statusProc       StatusProcessor
+	hyperblocksNotif HyperblocksNotifier
+	txsTracker       TransactionsTracker
+	nonceManager     NonceManager
 
 	pubKeyConverter core.PubkeyConverter
 }
@@ -61,6 +65,9 @@ func NewElrondProxyFacade(
 	pubKeyConverter core.PubkeyConverter,
 	esdtSuppliesProc ESDTSupplyProcessor,
 	statusProc StatusProcessor,
+	hyperblocksNotifier HyperblocksNotifier,
+	txsTracker TransactionsTracker,
+	nonceManager NonceManager,
 ) (*ElrondProxyFacade, error) {
 	if actionsProc == nil {
 		return nil, ErrNilActionsProcessor
@@ -101,6 +108,15 @@ func NewElrondProxyFacade(
 	if statusProc == nil {
 		return nil, ErrNilStatusProcessor
 	}
+	if hyperblocksNotifier == nil {
+		return nil, ErrNilHyperblocksNotifier
+	}
+	if txsTracker == nil {
+		return nil, ErrNilTransactionsTracker
+	}
+	if nonceManager == nil {
+		return nil, ErrNilNonceManager
+	}
 
 	return &ElrondProxyFacade{
 		actionsProc:      actionsProc,
@@ -117,22 +133,25 @@ func NewElrondProxyFacade(
 		pubKeyConverter:  pubKeyConverter,
 		esdtSuppliesProc: esdtSuppliesProc,
 		statusProc:       statusProc,
+		hyperblocksNotif: hyperblocksNotifier,
+		txsTracker:       txsTracker,
+		nonceManager:     nonceManager,
 	}, nil
 }
 
 // GetAccount returns an account based on the input address
-func (epf *ElrondProxyFacade) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return epf.accountProc.GetAccount(address, options)
+func (epf *ElrondProxyFacade) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return epf.accountProc.GetAccount(ctx, address, options)
 }
 
 // GetKeyValuePairs returns the key-value pairs for the given address
-func (epf *ElrondProxyFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetKeyValuePairs(address, options)
+func (epf *ElrondProxyFacade) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetKeyValuePairs(ctx, address, options)
 }
 
 // GetValueForKey returns the value for the given address and key
-func (epf *ElrondProxyFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
-	return epf.accountProc.GetValueForKey(address, key, options)
+func (epf *ElrondProxyFacade) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
+	return epf.accountProc.GetValueForKey(ctx, address, key, options)
 }
 
 // GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
@@ -146,63 +165,86 @@ func (epf *ElrondProxyFacade) GetTransactions(address string) ([]data.DatabaseTr
 }
 
 // GetESDTTokenData returns the token data for a given token name
-func (epf *ElrondProxyFacade) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetESDTTokenData(address, key, options)
+func (epf *ElrondProxyFacade) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTTokenData(ctx, address, key, options)
 }
 
 // GetESDTNftTokenData returns the token data for a given token name
-func (epf *ElrondProxyFacade) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetESDTNftTokenData(address, key, nonce, options)
+func (epf *ElrondProxyFacade) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTNftTokenData(ctx, address, key, nonce, options)
 }
 
 // GetESDTsWithRole returns the tokens where the given address has the assigned role
-func (epf *ElrondProxyFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetESDTsWithRole(address, role, options)
+func (epf *ElrondProxyFacade) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTsWithRole(ctx, address, role, options)
 }
 
 // GetESDTsRoles returns the tokens and roles for the given address
-func (epf *ElrondProxyFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetESDTsRoles(address, options)
+func (epf *ElrondProxyFacade) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTsRoles(ctx, address, options)
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (epf *ElrondProxyFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetNFTTokenIDsRegisteredByAddress(address, options)
+func (epf *ElrondProxyFacade) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetNFTTokenIDsRegisteredByAddress(ctx, address, options)
 }
 
 // GetAllESDTTokens returns all the ESDT tokens for a given address
-func (epf *ElrondProxyFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetAllESDTTokens(address, options)
+func (epf *ElrondProxyFacade) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetAllESDTTokens(ctx, address, options)
 }
 
 // SendTransaction should send the transaction to the correct observer
-func (epf *ElrondProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
-	return epf.txProc.SendTransaction(tx)
+func (epf *ElrondProxyFacade) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
+	statusCode, txHash, err := epf.txProc.SendTransaction(ctx, tx)
+	if err == nil {
+		epf.nonceManager.RecordSentNonce(tx.Sender, tx.Nonce)
+	}
+
+	return statusCode, txHash, err
 }
 
 // SendMultipleTransactions should send the transactions to the correct observers
-func (epf *ElrondProxyFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return epf.txProc.SendMultipleTransactions(txs)
+func (epf *ElrondProxyFacade) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+	response, err := epf.txProc.SendMultipleTransactions(ctx, txs)
+	for _, txResult := range response.TxsResults {
+		if txResult.Status == data.TxSendStatusAccepted {
+			tx := txs[txResult.Index]
+			epf.nonceManager.RecordSentNonce(tx.Sender, tx.Nonce)
+		}
+	}
+
+	return response, err
 }
 
 // SimulateTransaction should send the transaction to the correct observer for simulation
-func (epf *ElrondProxyFacade) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
-	return epf.txProc.SimulateTransaction(tx, checkSignature)
+func (epf *ElrondProxyFacade) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+	return epf.txProc.SimulateTransaction(ctx, tx, checkSignature)
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (epf *ElrondProxyFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
-	return epf.txProc.TransactionCostRequest(tx)
+func (epf *ElrondProxyFacade) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+	return epf.txProc.TransactionCostRequest(ctx, tx)
+}
+
+// WaitForTransactionCompletion waits for the transaction to complete and returns its latest known state
+func (epf *ElrondProxyFacade) WaitForTransactionCompletion(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+	return epf.txsTracker.WaitForCompletion(ctx, txHash, options)
 }
 
 // GetTransactionStatus should return transaction status
-func (epf *ElrondProxyFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
-	return epf.txProc.GetTransactionStatus(txHash, sender)
+func (epf *ElrondProxyFacade) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	return epf.txProc.GetTransactionStatus(ctx, txHash, sender)
 }
 
 // GetTransaction should return a transaction by hash
-func (epf *ElrondProxyFacade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	return epf.txProc.GetTransaction(txHash, withResults)
+func (epf *ElrondProxyFacade) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	return epf.txProc.GetTransaction(ctx, txHash, withResults)
+}
+
+// ReloadConfig will try to reload the configs
+func (epf *ElrondProxyFacade) ReloadConfig() data.NodesReloadResponse {
+	return epf.actionsProc.ReloadConfig()
 }
 
 // ReloadObservers will try to reload the observers
@@ -216,8 +258,8 @@ func (epf *ElrondProxyFacade) ReloadFullHistoryObservers() data.NodesReloadRespo
 }
 
 // GetTransactionByHashAndSenderAddress should return a transaction by hash and sender address
-func (epf *ElrondProxyFacade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
-	return epf.txProc.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+func (epf *ElrondProxyFacade) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+	return epf.txProc.GetTransactionByHashAndSenderAddress(ctx, txHash, sndAddr, withEvents)
 }
 
 // IsFaucetEnabled returns true if the faucet mechanism is enabled or false otherwise
@@ -226,18 +268,18 @@ func (epf *ElrondProxyFacade) IsFaucetEnabled() bool {
 }
 
 // SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
-func (epf *ElrondProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
+func (epf *ElrondProxyFacade) SendUserFunds(ctx context.Context, receiver string, value *big.Int) error {
 	senderSk, senderPk, err := epf.faucetProc.SenderDetailsFromPem(receiver)
 	if err != nil {
 		return err
 	}
 
-	senderAccount, err := epf.accountProc.GetAccount(senderPk, common.AccountQueryOptions{})
+	senderAccount, err := epf.accountProc.GetAccount(ctx, senderPk, common.AccountQueryOptions{})
 	if err != nil {
 		return err
 	}
 
-	networkCfg, err := epf.getNetworkConfig()
+	networkCfg, err := epf.getNetworkConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -254,12 +296,12 @@ func (epf *ElrondProxyFacade) SendUserFunds(receiver string, value *big.Int) err
 		return err
 	}
 
-	_, _, err = epf.txProc.SendTransaction(tx)
+	_, _, err = epf.txProc.SendTransaction(ctx, tx)
 	return err
 }
 
-func (epf *ElrondProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
-	genericResponse, err := epf.nodeStatusProc.GetNetworkConfigMetrics()
+func (epf *ElrondProxyFacade) getNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	genericResponse, err := epf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -276,108 +318,108 @@ func (epf *ElrondProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
-func (epf *ElrondProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
-	return epf.scQueryService.ExecuteQuery(query)
+func (epf *ElrondProxyFacade) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
+	return epf.scQueryService.ExecuteQuery(ctx, query)
 }
 
 // GetHeartbeatData retrieves the heartbeat status from one observer
-func (epf *ElrondProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	return epf.nodeGroupProc.GetHeartbeatData()
+func (epf *ElrondProxyFacade) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	return epf.nodeGroupProc.GetHeartbeatData(ctx)
 }
 
 // GetNetworkConfigMetrics retrieves the node's configuration's metrics
-func (epf *ElrondProxyFacade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetNetworkConfigMetrics()
+func (epf *ElrondProxyFacade) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 }
 
 // GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
-func (epf *ElrondProxyFacade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetNetworkStatusMetrics(shardID)
+func (epf *ElrondProxyFacade) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetNetworkStatusMetrics(ctx, shardID)
 }
 
 // GetESDTSupply retrieves the supply for the provided token
-func (epf *ElrondProxyFacade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
-	return epf.esdtSuppliesProc.GetESDTSupply(token)
+func (epf *ElrondProxyFacade) GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error) {
+	return epf.esdtSuppliesProc.GetESDTSupply(ctx, token)
 }
 
 // GetEconomicsDataMetrics retrieves the node's network metrics for a given shard
-func (epf *ElrondProxyFacade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetEconomicsDataMetrics()
+func (epf *ElrondProxyFacade) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetEconomicsDataMetrics(ctx)
 }
 
 // GetDelegatedInfo retrieves the node's network delegated info
-func (epf *ElrondProxyFacade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetDelegatedInfo()
+func (epf *ElrondProxyFacade) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetDelegatedInfo(ctx)
 }
 
 // GetDirectStakedInfo retrieves the node's direct staked values
-func (epf *ElrondProxyFacade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetDirectStakedInfo()
+func (epf *ElrondProxyFacade) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetDirectStakedInfo(ctx)
 }
 
 // GetAllIssuedESDTs retrieves all the issued ESDTs from the node
-func (epf *ElrondProxyFacade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetAllIssuedESDTs(tokenType)
+func (epf *ElrondProxyFacade) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetAllIssuedESDTs(ctx, tokenType)
 }
 
 // GetEnableEpochsMetrics retrieves the activation epochs
-func (epf *ElrondProxyFacade) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetEnableEpochsMetrics()
+func (epf *ElrondProxyFacade) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetEnableEpochsMetrics(ctx)
 }
 
 // GetRatingsConfig retrieves the node's configuration's metrics
-func (epf *ElrondProxyFacade) GetRatingsConfig() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetRatingsConfig()
+func (epf *ElrondProxyFacade) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetRatingsConfig(ctx)
 }
 
 // GetBlockByHash retrieves the block by hash for a given shard
-func (epf *ElrondProxyFacade) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return epf.blockProc.GetBlockByHash(shardID, hash, options)
+func (epf *ElrondProxyFacade) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return epf.blockProc.GetBlockByHash(ctx, shardID, hash, options)
 }
 
 // GetBlockByNonce retrieves the block by nonce for a given shard
-func (epf *ElrondProxyFacade) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return epf.blockProc.GetBlockByNonce(shardID, nonce, options)
+func (epf *ElrondProxyFacade) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return epf.blockProc.GetBlockByNonce(ctx, shardID, nonce, options)
 }
 
 // GetBlocksByRound retrieves the blocks for a given round
-func (epf *ElrondProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
-	return epf.blocksProc.GetBlocksByRound(round, options)
+func (epf *ElrondProxyFacade) GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	return epf.blocksProc.GetBlocksByRound(ctx, round, options)
 }
 
 // GetInternalBlockByHash retrieves the internal block by hash for a given shard
-func (epf *ElrondProxyFacade) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return epf.blockProc.GetInternalBlockByHash(shardID, hash, format)
+func (epf *ElrondProxyFacade) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return epf.blockProc.GetInternalBlockByHash(ctx, shardID, hash, format)
 }
 
 // GetInternalBlockByNonce retrieves the internal block by nonce for a given shard
-func (epf *ElrondProxyFacade) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return epf.blockProc.GetInternalBlockByNonce(shardID, nonce, format)
+func (epf *ElrondProxyFacade) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return epf.blockProc.GetInternalBlockByNonce(ctx, shardID, nonce, format)
 }
 
 // GetInternalStartOfEpochMetaBlock retrieves the internal block by nonce for a given shard
-func (epf *ElrondProxyFacade) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	return epf.blockProc.GetInternalStartOfEpochMetaBlock(epoch, format)
+func (epf *ElrondProxyFacade) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+	return epf.blockProc.GetInternalStartOfEpochMetaBlock(ctx, epoch, format)
 }
 
 // GetInternalMiniBlockByHash retrieves the internal miniblock by hash for a given shard
-func (epf *ElrondProxyFacade) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
-	return epf.blockProc.GetInternalMiniBlockByHash(shardID, hash, epoch, format)
+func (epf *ElrondProxyFacade) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	return epf.blockProc.GetInternalMiniBlockByHash(ctx, shardID, hash, epoch, format)
 }
 
 // GetHyperBlockByHash retrieves the hyperblock by hash
-func (epf *ElrondProxyFacade) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return epf.blockProc.GetHyperBlockByHash(hash, options)
+func (epf *ElrondProxyFacade) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return epf.blockProc.GetHyperBlockByHash(ctx, hash, options)
 }
 
 // GetHyperBlockByNonce retrieves the block by nonce
-func (epf *ElrondProxyFacade) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return epf.blockProc.GetHyperBlockByNonce(nonce, options)
+func (epf *ElrondProxyFacade) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return epf.blockProc.GetHyperBlockByNonce(ctx, nonce, options)
 }
 
 // ValidatorStatistics will return the statistics from an observer
-func (epf *ElrondProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
-	valStats, err := epf.valStatsProc.GetValidatorStatistics()
+func (epf *ElrondProxyFacade) ValidatorStatistics(ctx context.Context) (map[string]*data.ValidatorApiResponse, error) {
+	valStats, err := epf.valStatsProc.GetValidatorStatistics(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -395,9 +437,14 @@ func (epf *ElrondProxyFacade) GetAddressConverter() (core.PubkeyConverter, error
 	return epf.pubKeyConverter, nil
 }
 
+// SubscribeToHyperblocks creates a subscription to the new fully synchronized hyperblocks
+func (epf *ElrondProxyFacade) SubscribeToHyperblocks(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+	return epf.hyperblocksNotif.Subscribe(ctx, options)
+}
+
 // GetLatestFullySynchronizedHyperblockNonce returns the latest fully synchronized hyperblock nonce
-func (epf *ElrondProxyFacade) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
-	return epf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+func (epf *ElrondProxyFacade) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
+	return epf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(ctx)
 }
 
 // ComputeTransactionHash will compute hash of a given transaction
@@ -406,48 +453,53 @@ func (epf *ElrondProxyFacade) ComputeTransactionHash(tx *data.Transaction) (stri
 }
 
 // GetTransactionsPool returns all txs from pool
-func (epf *ElrondProxyFacade) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
-	return epf.txProc.GetTransactionsPool(fields)
+func (epf *ElrondProxyFacade) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
+	return epf.txProc.GetTransactionsPool(ctx, fields)
 }
 
 // GetTransactionsPoolForShard returns all txs from shard's pool
-func (epf *ElrondProxyFacade) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
-	return epf.txProc.GetTransactionsPoolForShard(shardID, fields)
+func (epf *ElrondProxyFacade) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
+	return epf.txProc.GetTransactionsPoolForShard(ctx, shardID, fields)
 }
 
 // GetTransactionsPoolForSender returns tx pool for sender
-func (epf *ElrondProxyFacade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	return epf.txProc.GetTransactionsPoolForSender(sender, fields)
+func (epf *ElrondProxyFacade) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	return epf.txProc.GetTransactionsPoolForSender(ctx, sender, fields)
 }
 
 // GetLastPoolNonceForSender returns last nonce from tx pool for sender
-func (epf *ElrondProxyFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return epf.txProc.GetLastPoolNonceForSender(sender)
+func (epf *ElrondProxyFacade) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return epf.txProc.GetLastPoolNonceForSender(ctx, sender)
 }
 
 // IsOldStorageForToken returns true is the storage for a given token is old
-func (epf *ElrondProxyFacade) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
-	return epf.nodeGroupProc.IsOldStorageForToken(tokenID, nonce)
+func (epf *ElrondProxyFacade) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
+	return epf.nodeGroupProc.IsOldStorageForToken(ctx, tokenID, nonce)
+}
+
+// ReserveNonce reserves the next nonce of the sender, so the concurrent clients of the same sender get different nonces
+func (epf *ElrondProxyFacade) ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error) {
+	return epf.nonceManager.ReserveNonce(ctx, address)
 }
 
 // GetTransactionsPoolNonceGapsForSender returns all nonce gaps from tx pool for sender
-func (epf *ElrondProxyFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return epf.txProc.GetTransactionsPoolNonceGapsForSender(sender)
+func (epf *ElrondProxyFacade) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return epf.txProc.GetTransactionsPoolNonceGapsForSender(ctx, sender)
 }
 
 // GetProof returns the Merkle proof for the given address
-func (epf *ElrondProxyFacade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
-	return epf.proofProc.GetProof(rootHash, address)
+func (epf *ElrondProxyFacade) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
+	return epf.proofProc.GetProof(ctx, rootHash, address)
 }
 
 // GetProofCurrentRootHash returns the Merkle proof for the given address
-func (epf *ElrondProxyFacade) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
-	return epf.proofProc.GetProofCurrentRootHash(address)
+func (epf *ElrondProxyFacade) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
+	return epf.proofProc.GetProofCurrentRootHash(ctx, address)
 }
 
 // VerifyProof verifies the given Merkle proof
-func (epf *ElrondProxyFacade) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
-	return epf.proofProc.VerifyProof(rootHash, address, proof)
+func (epf *ElrondProxyFacade) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+	return epf.proofProc.VerifyProof(ctx, rootHash, address, proof)
 }
 
 // GetMetrics will return the status metrics
@@ -455,22 +507,47 @@ func (epf *ElrondProxyFacade) GetMetrics() map[string]*data.EndpointMetrics {
 	return epf.statusProc.GetMetrics()
 }
 
+// GetCoalescedRequestsMetrics will return the number of coalesced requests for each node
+func (epf *ElrondProxyFacade) GetCoalescedRequestsMetrics() map[string]uint64 {
+	return epf.statusProc.GetCoalescedRequestsMetrics()
+}
+
+// GetApiKeysUsage will return the usage of each API key
+func (epf *ElrondProxyFacade) GetApiKeysUsage() map[string]*data.ApiKeyUsage {
+	return epf.statusProc.GetApiKeysUsage()
+}
+
 // GetMetricsForPrometheus will return the status metrics in a prometheus format
 func (epf *ElrondProxyFacade) GetMetricsForPrometheus() string {
 	return epf.statusProc.GetMetricsForPrometheus()
 }
 
+// GetCircuitBreakersStatuses will return the statuses of the observers' circuit breakers
+func (epf *ElrondProxyFacade) GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus {
+	return epf.statusProc.GetCircuitBreakersStatuses()
+}
+
+// GetQuarantinedNodes will return the nodes which are not used because they do not match their configuration
+func (epf *ElrondProxyFacade) GetQuarantinedNodes() []*data.QuarantinedNode {
+	return epf.statusProc.GetQuarantinedNodes()
+}
+
+// GetNodesHealth will return the health of all the observers and of all the full history nodes
+func (epf *ElrondProxyFacade) GetNodesHealth() []*data.NodeHealthStatus {
+	return epf.statusProc.GetNodesHealth()
+}
+
 // GetGenesisNodesPubKeys retrieves the node's configuration public keys
-func (epf *ElrondProxyFacade) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetGenesisNodesPubKeys()
+func (epf *ElrondProxyFacade) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetGenesisNodesPubKeys(ctx)
 }
 
 // GetGasConfigs retrieves the current gas schedule configs
-func (epf *ElrondProxyFacade) GetGasConfigs() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetGasConfigs()
+func (epf *ElrondProxyFacade) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetGasConfigs(ctx)
 }
 
 // GetEpochStartData retrieves epoch start data for the provides epoch and shard ID
-func (epf *ElrondProxyFacade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetEpochStartData(epoch, shardID)
+func (epf *ElrondProxyFacade) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetEpochStartData(ctx, epoch, shardID)
 }
@@ -1,8 +1,10 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
+	"net/http"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
@@ -45,6 +47,9 @@ func TestNewElrondProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -69,6 +74,9 @@ func TestNewElrondProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -93,6 +101,9 @@ func TestNewElrondProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -117,6 +128,9 @@ func TestNewElrondProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -141,6 +155,9 @@ func TestNewElrondProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -165,6 +182,9 @@ func TestNewElrondProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -189,6 +209,9 @@ func TestNewElrondProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -213,6 +236,9 @@ func TestNewElrondProxyFacade_NilNodeProcessor(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -237,6 +263,9 @@ func TestNewElrondProxyFacade_NilBlocksProcessor(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -261,6 +290,9 @@ func TestNewElrondProxyFacade_NilProofProcessor(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -285,12 +317,96 @@ func TestNewElrondProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilStatusProcessor, err)
 }
 
+func TestNewElrondProxyFacade_NilHyperblocksNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewElrondProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		nil,
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilHyperblocksNotifier, err)
+}
+
+func TestNewElrondProxyFacade_NilTransactionsTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewElrondProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		nil,
+		&mock.NonceManagerStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionsTracker, err)
+}
+
+func TestNewElrondProxyFacade_NilNonceManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewElrondProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilNonceManager, err)
+}
+
 func TestNewElrondProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -309,6 +425,9 @@ func TestNewElrondProxyFacade_ShouldWork(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -356,14 +475,17 @@ func TestNewElrondProxyFacade_GetBlocksByRound(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 	require.NoError(t, err)
 
-	ret, err := epf.GetBlocksByRound(3, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := epf.GetBlocksByRound(context.Background(), 3, common.BlockQueryOptions{WithTransactions: true})
 	require.Equal(t, errGetBlockByRound, err)
 	require.Nil(t, ret)
 
-	ret, err = epf.GetBlocksByRound(4, common.BlockQueryOptions{WithTransactions: true})
+	ret, err = epf.GetBlocksByRound(context.Background(), 4, common.BlockQueryOptions{WithTransactions: true})
 	require.Nil(t, err)
 	require.Equal(t, expectedResponse, ret)
 }
@@ -392,9 +514,12 @@ func TestElrondProxyFacade_GetAccount(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
+	_, _ = epf.GetAccount(context.Background(), "", common.AccountQueryOptions{})
 
 	assert.True(t, wasCalled)
 }
@@ -424,9 +549,12 @@ func TestElrondProxyFacade_SendTransaction(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	_, _, _ = epf.SendTransaction(&data.Transaction{})
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
@@ -455,9 +583,12 @@ func TestElrondProxyFacade_SimulateTransaction(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
+	_, _ = epf.SimulateTransaction(context.Background(), &data.Transaction{}, false)
 
 	assert.True(t, wasCalled)
 }
@@ -512,9 +643,12 @@ func TestElrondProxyFacade_SendUserFunds(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	_ = epf.SendUserFunds("", big.NewInt(0))
+	_ = epf.SendUserFunds(context.Background(), "", big.NewInt(0))
 
 	assert.True(t, wasCalled)
 }
@@ -543,9 +677,12 @@ func TestElrondProxyFacade_GetDataValue(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	_, _ = epf.ExecuteSCQuery(nil)
+	_, _ = epf.ExecuteSCQuery(context.Background(), nil)
 
 	assert.True(t, wasCalled)
 }
@@ -580,9 +717,12 @@ func TestElrondProxyFacade_GetHeartbeatData(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, _ := epf.GetHeartbeatData()
+	actualResult, _ := epf.GetHeartbeatData(context.Background())
 
 	assert.Equal(t, expectedResults, actualResult)
 }
@@ -614,6 +754,9 @@ func TestElrondProxyFacade_ReloadObservers(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -648,6 +791,9 @@ func TestElrondProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -686,9 +832,12 @@ func TestElrondProxyFacade_GetBlockByHash(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByHash(context.Background(), 0, "aaaa", common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -725,9 +874,12 @@ func TestElrondProxyFacade_GetBlockByNonce(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByNonce(context.Background(), 0, 10, common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -766,9 +918,12 @@ func TestElrondProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	actualResult, err := epf.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -805,9 +960,12 @@ func TestElrondProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
+	actualResult, err := epf.GetInternalBlockByNonce(context.Background(), 0, 10, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -844,9 +1002,12 @@ func TestElrondProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	actualResult, err := epf.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -881,9 +1042,12 @@ func TestElrondProxyFacade_GetRatingsConfig(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetRatingsConfig()
+	actualResult, err := epf.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -945,25 +1109,28 @@ func TestElrondProxyFacade_GetTransactionsPool(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualTxPool, err := epf.GetTransactionsPool("")
+	actualTxPool, err := epf.GetTransactionsPool(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPool, err = epf.GetTransactionsPoolForShard(0, "")
+	actualTxPool, err = epf.GetTransactionsPoolForShard(context.Background(), 0, "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender("", "")
+	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender(context.Background(), "", "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPoolForSender, actualTxPoolForSender)
 
-	actualNonce, err := epf.GetLastPoolNonceForSender("")
+	actualNonce, err := epf.GetLastPoolNonceForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, providedNonce, actualNonce)
 
-	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender("")
+	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedNonceGaps, actualNonceGaps)
 }
@@ -998,15 +1165,74 @@ func TestElrondProxyFacade_GetGasConfigs(t *testing.T) {
 		publicKeyConverter,
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{},
 	)
 
-	actualResult, err := epf.GetGasConfigs()
+	actualResult, err := epf.GetGasConfigs(context.Background())
 	require.Nil(t, err)
 
 	assert.True(t, wasCalled)
 	assert.Equal(t, expectedResult, actualResult)
 }
 
+func TestElrondProxyFacade_SendTransactionsShouldRecordTheSentNonces(t *testing.T) {
+	t.Parallel()
+
+	recordedNonces := make(map[uint64]string)
+	epf, _ := facade.NewElrondProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				if tx.Nonce == 2 {
+					return http.StatusBadRequest, "", errors.New("rejected")
+				}
+				return http.StatusOK, "hash", nil
+			},
+			SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				return data.MultipleTransactionsResponseData{
+					TxsResults: []*data.MultipleTransactionsTxResult{
+						{Index: 0, Status: data.TxSendStatusAccepted},
+						{Index: 1, Status: data.TxSendStatusRejectedByObserver},
+					},
+				}, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.HyperblocksNotifierStub{},
+		&mock.TransactionsTrackerStub{},
+		&mock.NonceManagerStub{
+			RecordSentNonceCalled: func(address string, nonce uint64) {
+				recordedNonces[nonce] = address
+			},
+		},
+	)
+
+	_, _, err := epf.SendTransaction(context.Background(), &data.Transaction{Sender: "alice", Nonce: 1})
+	require.Nil(t, err)
+	_, _, err = epf.SendTransaction(context.Background(), &data.Transaction{Sender: "alice", Nonce: 2})
+	require.NotNil(t, err)
+	_, err = epf.SendMultipleTransactions(context.Background(), []*data.Transaction{
+		{Sender: "bob", Nonce: 3},
+		{Sender: "bob", Nonce: 4},
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, map[uint64]string{1: "alice", 3: "bob"}, recordedNonces)
+}
+
 func getPrivKey() crypto.PrivateKey {
 	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
 	sk, _ := keyGen.GeneratePair()
@@ -40,3 +40,12 @@ var ErrNilESDTSuppliesProcessor = errors.New("nil esdt supplies processor")
 
 // ErrNilStatusProcessor signals that a nil status processor has been provided
 var ErrNilStatusProcessor = errors.New("nil status processor")
+
+// ErrNilHyperblocksNotifier signals that a nil hyperblocks notifier has been provided
+var ErrNilHyperblocksNotifier = errors.New("nil hyperblocks notifier")
+
+// ErrNilTransactionsTracker signals that a nil transactions tracker has been provided
+var ErrNilTransactionsTracker = errors.New("nil transactions tracker")
+
+// ErrNilNonceManager signals that a nil nonce manager has been provided
+var ErrNilNonceManager = errors.New("nil nonce manager")
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -14,101 +15,102 @@ import (
 type ActionsProcessor interface {
 	ReloadObservers() data.NodesReloadResponse
 	ReloadFullHistoryObservers() data.NodesReloadResponse
+	ReloadConfig() data.NodesReloadResponse
 }
 
 // AccountProcessor defines what an account request processor should do
 type AccountProcessor interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error)
 	GetTransactions(address string) ([]data.DatabaseTransaction, error)
-	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 }
 
 // TransactionProcessor defines what a transaction request processor should do
 type TransactionProcessor interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
-	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetTransaction(ctx context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
-	GetLastPoolNonceForSender(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
 }
 
 // ProofProcessor defines what a proof request processor should do
 type ProofProcessor interface {
-	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
-	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
-	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
+	GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error)
 }
 
 // NodeGroupProcessor defines what a node group processor should do
 type NodeGroupProcessor interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
-	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
+	IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error)
 }
 
 // ValidatorStatisticsProcessor defines what a validator statistics processor should do
 type ValidatorStatisticsProcessor interface {
-	GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error)
+	GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
 }
 
 // ESDTSupplyProcessor defines what an esdt supply processor should do
 type ESDTSupplyProcessor interface {
-	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error)
 }
 
 // NodeStatusProcessor defines what a node status processor should do
 type NodeStatusProcessor interface {
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
-	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
-	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
-	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
-	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
-	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
-	GetDelegatedInfo() (*data.GenericAPIResponse, error)
-	GetRatingsConfig() (*data.GenericAPIResponse, error)
-	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
-	GetGasConfigs() (*data.GenericAPIResponse, error)
-	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error)
+	GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
 }
 
 // BlocksProcessor defines what a blocks processor should do
 type BlocksProcessor interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // BlockProcessor defines what a block processor should do
 type BlockProcessor interface {
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-
-	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
-	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+
+	GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 }
 
 // FaucetProcessor defines what a component which will handle faucets should do
@@ -128,5 +130,27 @@ type FaucetProcessor interface {
 // StatusProcessor defines what a component which will handle status request should do
 type StatusProcessor interface {
 	GetMetrics() map[string]*data.EndpointMetrics
+	GetCoalescedRequestsMetrics() map[string]uint64
+	GetApiKeysUsage() map[string]*data.ApiKeyUsage
 	GetMetricsForPrometheus() string
+	GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodes() []*data.QuarantinedNode
+	GetNodesHealth() []*data.NodeHealthStatus
+}
+
+// HyperblocksNotifier defines what a component which notifies the subscribers about the new fully synchronized
+// hyperblocks should do
+type HyperblocksNotifier interface {
+	Subscribe(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error)
+}
+
+// TransactionsTracker defines what a component which waits for the transactions to complete should do
+type TransactionsTracker interface {
+	WaitForCompletion(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error)
+}
+
+// NonceManager defines what a component which hands out non-conflicting nonces to the senders should do
+type NonceManager interface {
+	ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error)
+	RecordSentNonce(address string, nonce uint64)
 }
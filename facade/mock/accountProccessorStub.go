@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
@@ -22,32 +23,32 @@ type AccountProcessorStub struct {
 }
 
 // GetKeyValuePairs -
-func (aps *AccountProcessorStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetKeyValuePairs(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetKeyValuePairsCalled(address, options)
 }
 
 // GetAllESDTTokens -
-func (aps *AccountProcessorStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetAllESDTTokens(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetAllESDTTokensCalled(address, options)
 }
 
 // GetESDTTokenData -
-func (aps *AccountProcessorStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTTokenData(_ context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTTokenDataCalled(address, key, options)
 }
 
 // GetESDTNftTokenData -
-func (aps *AccountProcessorStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTNftTokenData(_ context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTNftTokenDataCalled(address, key, nonce, options)
 }
 
 // GetESDTsWithRole -
-func (aps *AccountProcessorStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsWithRole(_ context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTsWithRoleCalled(address, role, options)
 }
 
 // GetESDTsRoles -
-func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsRoles(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.GetESDTsRolesCalled != nil {
 		return aps.GetESDTsRolesCalled(address, options)
 	}
@@ -56,17 +57,17 @@ func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.Ac
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 }
 
 // GetAccount --
-func (aps *AccountProcessorStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (aps *AccountProcessorStub) GetAccount(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	return aps.GetAccountCalled(address, options)
 }
 
 // GetValueForKey --
-func (aps *AccountProcessorStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (aps *AccountProcessorStub) GetValueForKey(_ context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return aps.GetValueForKeyCalled(address, key, options)
 }
 
@@ -81,6 +82,6 @@ func (aps *AccountProcessorStub) GetTransactions(address string) ([]data.Databas
 }
 
 // ValidatorStatistics --
-func (aps *AccountProcessorStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (aps *AccountProcessorStub) ValidatorStatistics(_ context.Context) (map[string]*data.ValidatorApiResponse, error) {
 	return aps.ValidatorStatisticsCalled()
 }
@@ -8,6 +8,7 @@ import (
 type ActionsProcessorStub struct {
 	ReloadObserversCalled            func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled func() data.NodesReloadResponse
+	ReloadConfigCalled               func() data.NodesReloadResponse
 }
 
 // ReloadObservers -
@@ -27,3 +28,12 @@ func (a *ActionsProcessorStub) ReloadFullHistoryObservers() data.NodesReloadResp
 
 	return data.NodesReloadResponse{}
 }
+
+// ReloadConfig -
+func (a *ActionsProcessorStub) ReloadConfig() data.NodesReloadResponse {
+	if a.ReloadConfigCalled != nil {
+		return a.ReloadConfigCalled()
+	}
+
+	return data.NodesReloadResponse{}
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
@@ -18,11 +19,11 @@ type BlockProcessorStub struct {
 	GetInternalStartOfEpochMetaBlockCalled func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 }
 
-func (bps *BlockProcessorStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetBlockByHash(_ context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return bps.GetBlockByHashCalled(shardID, hash, options)
 }
 
-func (bps *BlockProcessorStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return bps.GetBlockByNonceCalled(shardID, nonce, options)
 }
 
@@ -32,7 +33,7 @@ func (bps *BlockProcessorStub) GetAtlasBlockByShardIDAndNonce(shardID uint32, no
 }
 
 // GetHyperBlockByHash -
-func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bps *BlockProcessorStub) GetHyperBlockByHash(_ context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByHashCalled != nil {
 		return bps.GetHyperBlockByHashCalled(hash, options)
 	}
@@ -41,7 +42,7 @@ func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string, options common.H
 }
 
 // GetHyperBlockByNonce -
-func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bps *BlockProcessorStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByNonceCalled != nil {
 		return bps.GetHyperBlockByNonceCalled(nonce, options)
 	}
@@ -50,21 +51,21 @@ func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common
 }
 
 // GetInternalBlockByHash -
-func (bps *BlockProcessorStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalBlockByHash(_ context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalBlockByHashCalled(shardID, hash, format)
 }
 
 // GetInternalBlockByNonce -
-func (bps *BlockProcessorStub) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalBlockByNonce(_ context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
 // GetInternalMiniBlockByHash -
-func (bps *BlockProcessorStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalMiniBlockByHash(_ context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return bps.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
 }
 
 // GetInternalStartOfEpochMetaBlock -
-func (bps *BlockProcessorStub) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetInternalStartOfEpochMetaBlock(_ context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalStartOfEpochMetaBlockCalled(epoch, format)
 }
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
@@ -11,7 +12,7 @@ type BlocksProcessorStub struct {
 }
 
 // GetBlocksByRound -
-func (bps *BlocksProcessorStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (bps *BlocksProcessorStub) GetBlocksByRound(_ context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	if bps.GetBlocksByRoundCalled != nil {
 		return bps.GetBlocksByRoundCalled(round, options)
 	}
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// HyperblocksNotifierStub -
+type HyperblocksNotifierStub struct {
+	SubscribeCalled func(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error)
+}
+
+// Subscribe -
+func (stub *HyperblocksNotifierStub) Subscribe(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(ctx, options)
+	}
+
+	return nil, nil
+}
@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // NodeGroupProcessorStub represents a stub implementation of a NodeGroupProcessor
 type NodeGroupProcessorStub struct {
@@ -9,11 +12,11 @@ type NodeGroupProcessorStub struct {
 }
 
 // IsOldStorageForToken -
-func (hbps *NodeGroupProcessorStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (hbps *NodeGroupProcessorStub) IsOldStorageForToken(_ context.Context, tokenID string, nonce uint64) (bool, error) {
 	return hbps.IsOldStorageForTokenCalled(tokenID, nonce)
 }
 
 // GetHeartbeatData -
-func (hbps *NodeGroupProcessorStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (hbps *NodeGroupProcessorStub) GetHeartbeatData(_ context.Context) (*data.HeartbeatResponse, error) {
 	return hbps.GetHeartbeatDataCalled()
 }
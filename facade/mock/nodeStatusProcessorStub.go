@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // NodeStatusProcessorStub --
 type NodeStatusProcessorStub struct {
@@ -19,7 +22,7 @@ type NodeStatusProcessorStub struct {
 }
 
 // GetNetworkConfigMetrics --
-func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetConfigMetricsCalled != nil {
 		return stub.GetConfigMetricsCalled()
 	}
@@ -28,7 +31,7 @@ func (stub *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPI
 }
 
 // GetNetworkStatusMetrics --
-func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if stub.GetNetworkMetricsCalled != nil {
 		return stub.GetNetworkMetricsCalled(shardID)
 	}
@@ -37,7 +40,7 @@ func (stub *NodeStatusProcessorStub) GetNetworkStatusMetrics(shardID uint32) (*d
 }
 
 // GetEconomicsDataMetrics --
-func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetEconomicsDataMetricsCalled != nil {
 		return stub.GetEconomicsDataMetricsCalled()
 	}
@@ -46,7 +49,7 @@ func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPI
 }
 
 // GetLatestFullySynchronizedHyperblockNonce -
-func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
 	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
 		return stub.GetLatestFullySynchronizedHyperblockNonceCalled()
 	}
@@ -55,7 +58,7 @@ func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce()
 }
 
 // GetAllIssuedESDTs -
-func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(_ context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if stub.GetAllIssuedESDTsCalled != nil {
 		return stub.GetAllIssuedESDTsCalled(tokenType)
 	}
@@ -64,7 +67,7 @@ func (stub *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.
 }
 
 // GetDirectStakedInfo -
-func (stub *NodeStatusProcessorStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetDirectStakedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetDirectStakedInfoCalled != nil {
 		return stub.GetDirectStakedInfoCalled()
 	}
@@ -73,7 +76,7 @@ func (stub *NodeStatusProcessorStub) GetDirectStakedInfo() (*data.GenericAPIResp
 }
 
 // GetDelegatedInfo -
-func (stub *NodeStatusProcessorStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetDelegatedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetDelegatedInfoCalled != nil {
 		return stub.GetDelegatedInfoCalled()
 	}
@@ -82,7 +85,7 @@ func (stub *NodeStatusProcessorStub) GetDelegatedInfo() (*data.GenericAPIRespons
 }
 
 // GetEnableEpochsMetrics -
-func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetEnableEpochsMetricsCalled != nil {
 		return stub.GetEnableEpochsMetricsCalled()
 	}
@@ -91,7 +94,7 @@ func (stub *NodeStatusProcessorStub) GetEnableEpochsMetrics() (*data.GenericAPIR
 }
 
 // GetRatingsConfig -
-func (stub *NodeStatusProcessorStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetRatingsConfig(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetRatingsConfigCalled != nil {
 		return stub.GetRatingsConfigCalled()
 	}
@@ -100,7 +103,7 @@ func (stub *NodeStatusProcessorStub) GetRatingsConfig() (*data.GenericAPIRespons
 }
 
 // GetGenesisNodesPubKeys -
-func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetGenesisNodesPubKeysCalled != nil {
 		return stub.GetGenesisNodesPubKeysCalled()
 	}
@@ -109,7 +112,7 @@ func (stub *NodeStatusProcessorStub) GetGenesisNodesPubKeys() (*data.GenericAPIR
 }
 
 // GetGasConfigs -
-func (stub *NodeStatusProcessorStub) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetGasConfigs(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetGasConfigsCalled != nil {
 		return stub.GetGasConfigsCalled()
 	}
@@ -118,7 +121,7 @@ func (stub *NodeStatusProcessorStub) GetGasConfigs() (*data.GenericAPIResponse,
 }
 
 // GetEpochStartData -
-func (stub *NodeStatusProcessorStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (stub *NodeStatusProcessorStub) GetEpochStartData(_ context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	if stub.GetEpochStartDataCalled != nil {
 		return stub.GetEpochStartDataCalled(epoch, shardID)
 	}
@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// NonceManagerStub -
+type NonceManagerStub struct {
+	ReserveNonceCalled    func(ctx context.Context, address string) (*data.NonceReservation, error)
+	RecordSentNonceCalled func(address string, nonce uint64)
+}
+
+// ReserveNonce -
+func (stub *NonceManagerStub) ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error) {
+	if stub.ReserveNonceCalled != nil {
+		return stub.ReserveNonceCalled(ctx, address)
+	}
+
+	return nil, nil
+}
+
+// RecordSentNonce -
+func (stub *NonceManagerStub) RecordSentNonce(address string, nonce uint64) {
+	if stub.RecordSentNonceCalled != nil {
+		stub.RecordSentNonceCalled(address, nonce)
+	}
+}
@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // ProofProcessorStub -
 type ProofProcessorStub struct {
@@ -10,7 +13,7 @@ type ProofProcessorStub struct {
 }
 
 // GetProof -
-func (pp *ProofProcessorStub) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) GetProof(_ context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	if pp.GetProofCalled != nil {
 		return pp.GetProofCalled(rootHash, address)
 	}
@@ -19,7 +22,7 @@ func (pp *ProofProcessorStub) GetProof(rootHash string, address string) (*data.G
 }
 
 // GetProofCurrentRootHash -
-func (pp *ProofProcessorStub) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) GetProofCurrentRootHash(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	if pp.GetProofCurrentRootHashCalled != nil {
 		return pp.GetProofCurrentRootHashCalled(address)
 	}
@@ -28,7 +31,7 @@ func (pp *ProofProcessorStub) GetProofCurrentRootHash(address string) (*data.Gen
 }
 
 // VerifyProof -
-func (pp *ProofProcessorStub) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) VerifyProof(_ context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	if pp.VerifyProofCalled != nil {
 		return pp.VerifyProofCalled(rootHash, address, proof)
 	}
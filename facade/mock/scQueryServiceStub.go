@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
@@ -11,6 +12,6 @@ type SCQueryServiceStub struct {
 }
 
 // ExecuteQuery -
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
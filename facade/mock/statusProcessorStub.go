@@ -6,8 +6,13 @@ import (
 
 // StatusProcessorStub -
 type StatusProcessorStub struct {
-	GetMetricsCalled              func() map[string]*data.EndpointMetrics
-	GetMetricsForPrometheusCalled func() string
+	GetMetricsCalled                  func() map[string]*data.EndpointMetrics
+	GetCoalescedRequestsMetricsCalled func() map[string]uint64
+	GetApiKeysUsageCalled             func() map[string]*data.ApiKeyUsage
+	GetMetricsForPrometheusCalled     func() string
+	GetCircuitBreakersStatusesCalled  func() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodesCalled         func() []*data.QuarantinedNode
+	GetNodesHealthCalled              func() []*data.NodeHealthStatus
 }
 
 // GetMetricsForPrometheus -
@@ -27,3 +32,48 @@ func (s *StatusProcessorStub) GetMetrics() map[string]*data.EndpointMetrics {
 
 	return nil
 }
+
+// GetCoalescedRequestsMetrics -
+func (s *StatusProcessorStub) GetCoalescedRequestsMetrics() map[string]uint64 {
+	if s.GetCoalescedRequestsMetricsCalled != nil {
+		return s.GetCoalescedRequestsMetricsCalled()
+	}
+
+	return nil
+}
+
+// GetApiKeysUsage -
+func (s *StatusProcessorStub) GetApiKeysUsage() map[string]*data.ApiKeyUsage {
+	if s.GetApiKeysUsageCalled != nil {
+		return s.GetApiKeysUsageCalled()
+	}
+
+	return nil
+}
+
+// GetCircuitBreakersStatuses -
+func (s *StatusProcessorStub) GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus {
+	if s.GetCircuitBreakersStatusesCalled != nil {
+		return s.GetCircuitBreakersStatusesCalled()
+	}
+
+	return nil
+}
+
+// GetQuarantinedNodes -
+func (s *StatusProcessorStub) GetQuarantinedNodes() []*data.QuarantinedNode {
+	if s.GetQuarantinedNodesCalled != nil {
+		return s.GetQuarantinedNodesCalled()
+	}
+
+	return nil
+}
+
+// GetNodesHealth -
+func (s *StatusProcessorStub) GetNodesHealth() []*data.NodeHealthStatus {
+	if s.GetNodesHealthCalled != nil {
+		return s.GetNodesHealthCalled()
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -26,17 +27,17 @@ type TransactionProcessorStub struct {
 }
 
 // SimulateTransaction -
-func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tps *TransactionProcessorStub) SimulateTransaction(_ context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	return tps.SimulateTransactionCalled(tx, checkSignature)
 }
 
 // SendTransaction -
-func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tps *TransactionProcessorStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	return tps.SendTransactionCalled(tx)
 }
 
 // SendMultipleTransactions -
-func (tps *TransactionProcessorStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (tps *TransactionProcessorStub) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return tps.SendMultipleTransactionsCalled(txs)
 }
 
@@ -46,32 +47,32 @@ func (tps *TransactionProcessorStub) ComputeTransactionHash(tx *data.Transaction
 }
 
 // SendUserFunds -
-func (tps *TransactionProcessorStub) SendUserFunds(receiver string, value *big.Int) error {
+func (tps *TransactionProcessorStub) SendUserFunds(_ context.Context, receiver string, value *big.Int) error {
 	return tps.SendUserFundsCalled(receiver, value)
 }
 
 // GetTransactionStatus -
-func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tps *TransactionProcessorStub) GetTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
 	return tps.GetTransactionStatusHandler(txHash, sender)
 }
 
 // GetTransaction -
-func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+func (tps *TransactionProcessorStub) GetTransaction(_ context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	return tps.GetTransactionCalled(txHash, withEvents)
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(_ context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	return tps.GetTransactionByHashAndSenderAddressCalled(txHash, sndAddr, withEvents)
 }
 
 // TransactionCostRequest -
-func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tps *TransactionProcessorStub) TransactionCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return tps.TransactionCostRequestHandler(tx)
 }
 
 // GetTransactionsPool -
-func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPool(_ context.Context, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolCalled != nil {
 		return tps.GetTransactionsPoolCalled(fields)
 	}
@@ -80,7 +81,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.T
 }
 
 // GetTransactionsPoolForShard -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(_ context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolForShardCalled != nil {
 		return tps.GetTransactionsPoolForShardCalled(shardID, fields)
 	}
@@ -89,7 +90,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32,
 }
 
 // GetTransactionsPoolForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(_ context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if tps.GetTransactionsPoolForSenderCalled != nil {
 		return tps.GetTransactionsPoolForSenderCalled(sender, fields)
 	}
@@ -98,7 +99,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields
 }
 
 // GetLastPoolNonceForSender -
-func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
 	if tps.GetLastPoolNonceForSenderCalled != nil {
 		return tps.GetLastPoolNonceForSenderCalled(sender)
 	}
@@ -107,7 +108,7 @@ func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (u
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(_ context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if tps.GetTransactionsPoolNonceGapsForSenderCalled != nil {
 		return tps.GetTransactionsPoolNonceGapsForSenderCalled(sender)
 	}
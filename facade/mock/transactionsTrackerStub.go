@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// TransactionsTrackerStub -
+type TransactionsTrackerStub struct {
+	WaitForCompletionCalled func(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error)
+}
+
+// WaitForCompletion -
+func (stub *TransactionsTrackerStub) WaitForCompletion(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+	if stub.WaitForCompletionCalled != nil {
+		return stub.WaitForCompletionCalled(ctx, txHash, options)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,48 @@
+package jwtauth
+
+import "errors"
+
+// ErrEmptyJwksFilePath signals that an empty JWKS file path has been provided
+var ErrEmptyJwksFilePath = errors.New("empty JWKS file path")
+
+// ErrNoVerificationKeys signals that the JWKS file does not hold any key which can be used for verifying the tokens
+var ErrNoVerificationKeys = errors.New("no verification keys in the JWKS file")
+
+// ErrInvalidJwk signals that an invalid JSON web key has been provided
+var ErrInvalidJwk = errors.New("invalid JSON web key")
+
+// ErrEmptyClaimName signals that an empty claim name has been provided
+var ErrEmptyClaimName = errors.New("empty claim name")
+
+// ErrEmptyClaimValue signals that a permission with an empty claim value has been provided
+var ErrEmptyClaimValue = errors.New("empty claim value")
+
+// ErrInvalidLeeway signals that an invalid leeway has been provided
+var ErrInvalidLeeway = errors.New("invalid leeway")
+
+// ErrMalformedToken signals that the provided token is not a well formed JWT
+var ErrMalformedToken = errors.New("malformed token")
+
+// ErrUnsupportedAlgorithm signals that the provided token is signed with an unsupported algorithm
+var ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+
+// ErrVerificationKeyNotFound signals that no key able to verify the provided token was found
+var ErrVerificationKeyNotFound = errors.New("verification key not found")
+
+// ErrInvalidSignature signals that the signature of the provided token is not valid
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrMissingExpiration signals that the provided token does not have an expiration time
+var ErrMissingExpiration = errors.New("missing expiration time")
+
+// ErrTokenExpired signals that the provided token has expired
+var ErrTokenExpired = errors.New("token expired")
+
+// ErrTokenNotValidYet signals that the provided token can not be used yet
+var ErrTokenNotValidYet = errors.New("token not valid yet")
+
+// ErrInvalidIssuer signals that the provided token was issued by an unexpected issuer
+var ErrInvalidIssuer = errors.New("invalid issuer")
+
+// ErrInvalidAudience signals that the provided token is not intended for this proxy
+var ErrInvalidAudience = errors.New("invalid audience")
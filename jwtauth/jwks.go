@@ -0,0 +1,224 @@
+package jwtauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+const (
+	// AlgorithmHS256 is the HMAC with SHA-256 signing algorithm, verified with the "oct" keys
+	AlgorithmHS256 = "HS256"
+
+	// AlgorithmRS256 is the RSASSA-PKCS1-v1_5 with SHA-256 signing algorithm, verified with the "RSA" keys
+	AlgorithmRS256 = "RS256"
+
+	// AlgorithmES256 is the ECDSA using P-256 and SHA-256 signing algorithm, verified with the "EC" keys
+	AlgorithmES256 = "ES256"
+)
+
+const (
+	keyTypeOctet = "oct"
+	keyTypeRSA   = "RSA"
+	keyTypeEC    = "EC"
+	keyUseSig    = "sig"
+	curveP256    = "P-256"
+
+	es256SignatureLength = 64
+)
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	K         string `json:"k"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+// verificationKey is a key able to verify the signatures made with a single algorithm
+type verificationKey struct {
+	keyID     string
+	algorithm string
+	verify    func(signingInput []byte, signature []byte) bool
+}
+
+// loadJwks loads the keys able to verify the tokens' signatures from the provided JWKS file. The keys which are not
+// meant for signatures or which use other algorithms than the supported ones are skipped
+func loadJwks(filePath string) ([]*verificationKey, error) {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := &jsonWebKeySet{}
+	err = json.Unmarshal(buff, jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*verificationKey, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if len(jwk.Use) > 0 && jwk.Use != keyUseSig {
+			log.Debug("skipping JSON web key which is not meant for signatures", "kid", jwk.KeyID, "use", jwk.Use)
+			continue
+		}
+
+		key, errParse := parseJwk(jwk)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w, kid %s: %v", ErrInvalidJwk, jwk.KeyID, errParse)
+		}
+		if key == nil {
+			log.Warn("skipping JSON web key with unsupported type or algorithm", "kid", jwk.KeyID, "kty", jwk.KeyType, "alg", jwk.Algorithm)
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, ErrNoVerificationKeys
+	}
+
+	return keys, nil
+}
+
+// parseJwk returns nil if the key type or algorithm is not supported
+func parseJwk(jwk jsonWebKey) (*verificationKey, error) {
+	switch {
+	case jwk.KeyType == keyTypeOctet && isAlgorithmAllowed(jwk, AlgorithmHS256):
+		return parseOctetKey(jwk)
+	case jwk.KeyType == keyTypeRSA && isAlgorithmAllowed(jwk, AlgorithmRS256):
+		return parseRSAKey(jwk)
+	case jwk.KeyType == keyTypeEC && isAlgorithmAllowed(jwk, AlgorithmES256):
+		return parseECKey(jwk)
+	default:
+		return nil, nil
+	}
+}
+
+func isAlgorithmAllowed(jwk jsonWebKey, algorithm string) bool {
+	return len(jwk.Algorithm) == 0 || jwk.Algorithm == algorithm
+}
+
+func parseOctetKey(jwk jsonWebKey) (*verificationKey, error) {
+	secret, err := base64.RawURLEncoding.DecodeString(jwk.K)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) < sha256.Size {
+		return nil, fmt.Errorf("the HS256 secret must have at least %d bytes", sha256.Size)
+	}
+
+	return &verificationKey{
+		keyID:     jwk.KeyID,
+		algorithm: AlgorithmHS256,
+		verify: func(signingInput []byte, signature []byte) bool {
+			mac := hmac.New(sha256.New, secret)
+			_, _ = mac.Write(signingInput)
+
+			return hmac.Equal(mac.Sum(nil), signature)
+		},
+	}, nil
+}
+
+func parseRSAKey(jwk jsonWebKey) (*verificationKey, error) {
+	modulus, err := decodeBigInt(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	exponent, err := decodeBigInt(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+	if !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid RSA exponent")
+	}
+
+	publicKey := &rsa.PublicKey{
+		N: modulus,
+		E: int(exponent.Int64()),
+	}
+	if publicKey.Size() < 256 {
+		return nil, fmt.Errorf("the RSA key must have at least 2048 bits")
+	}
+
+	return &verificationKey{
+		keyID:     jwk.KeyID,
+		algorithm: AlgorithmRS256,
+		verify: func(signingInput []byte, signature []byte) bool {
+			hash := sha256.Sum256(signingInput)
+
+			return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hash[:], signature) == nil
+		},
+	}, nil
+}
+
+func parseECKey(jwk jsonWebKey) (*verificationKey, error) {
+	if jwk.Curve != curveP256 {
+		return nil, fmt.Errorf("unsupported curve %s", jwk.Curve)
+	}
+
+	x, err := decodeBigInt(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(jwk.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	curve := elliptic.P256()
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("the EC public key is not on the %s curve", curveP256)
+	}
+
+	publicKey := &ecdsa.PublicKey{
+		Curve: curve,
+		X:     x,
+		Y:     y,
+	}
+
+	return &verificationKey{
+		keyID:     jwk.KeyID,
+		algorithm: AlgorithmES256,
+		verify: func(signingInput []byte, signature []byte) bool {
+			if len(signature) != es256SignatureLength {
+				return false
+			}
+
+			hash := sha256.Sum256(signingInput)
+			r := new(big.Int).SetBytes(signature[:es256SignatureLength/2])
+			s := new(big.Int).SetBytes(signature[es256SignatureLength/2:])
+
+			return ecdsa.Verify(publicKey, hash[:], r, s)
+		},
+	}, nil
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	buff, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return nil, fmt.Errorf("empty key parameter")
+	}
+
+	return new(big.Int).SetBytes(buff), nil
+}
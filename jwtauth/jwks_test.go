@@ -0,0 +1,71 @@
+package jwtauth
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadJwks_InvalidKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		key         map[string]interface{}
+		expectedErr error
+	}{
+		{
+			name:        "short HS256 secret",
+			key:         map[string]interface{}{"kty": "oct", "k": encodeSegment([]byte("short"))},
+			expectedErr: ErrInvalidJwk,
+		},
+		{
+			name:        "invalid RSA modulus encoding",
+			key:         map[string]interface{}{"kty": "RSA", "n": "%%%", "e": "AQAB"},
+			expectedErr: ErrInvalidJwk,
+		},
+		{
+			name:        "short RSA key",
+			key:         map[string]interface{}{"kty": "RSA", "n": encodeBigInt(big.NewInt(1<<40 + 1)), "e": "AQAB"},
+			expectedErr: ErrInvalidJwk,
+		},
+		{
+			name:        "unsupported curve",
+			key:         map[string]interface{}{"kty": "EC", "crv": "P-384", "x": "AQAB", "y": "AQAB"},
+			expectedErr: ErrInvalidJwk,
+		},
+		{
+			name:        "EC point not on curve",
+			key:         map[string]interface{}{"kty": "EC", "crv": "P-256", "x": "AQAB", "y": "AQAB"},
+			expectedErr: ErrInvalidJwk,
+		},
+		{
+			name:        "only unsupported keys",
+			key:         map[string]interface{}{"kty": "RSA", "alg": "PS256", "n": "AQAB", "e": "AQAB"},
+			expectedErr: ErrNoVerificationKeys,
+		},
+	}
+
+	for _, tt := range tests {
+		filePath := writeJwksFile(t, map[string]interface{}{"keys": []interface{}{tt.key}})
+
+		keys, err := loadJwks(filePath)
+		assert.True(t, errors.Is(err, tt.expectedErr), "%s: %v", tt.name, err)
+		assert.Nil(t, keys, tt.name)
+	}
+}
+
+func TestLoadJwks_ShouldSkipUnsupportedKeys(t *testing.T) {
+	t.Parallel()
+
+	keys, err := loadJwks(writeJwksFile(t, createTestJwks()))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keys))
+	assert.Equal(t, AlgorithmHS256, keys[0].algorithm)
+	assert.Equal(t, "hmac", keys[0].keyID)
+	assert.Equal(t, AlgorithmRS256, keys[1].algorithm)
+	assert.Equal(t, AlgorithmES256, keys[2].algorithm)
+}
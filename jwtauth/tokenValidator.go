@@ -0,0 +1,287 @@
+package jwtauth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("jwtauth")
+
+const numTokenParts = 3
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// audience is the "aud" claim, which can be either a string or an array of strings
+type audience []string
+
+// UnmarshalJSON unmarshalls the audience from either a string or an array of strings
+func (aud *audience) UnmarshalJSON(buff []byte) error {
+	var single string
+	err := json.Unmarshal(buff, &single)
+	if err == nil {
+		*aud = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	err = json.Unmarshal(buff, &multiple)
+	if err != nil {
+		return err
+	}
+	*aud = multiple
+
+	return nil
+}
+
+type registeredClaims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+}
+
+// tokenValidator validates the JWT bearer tokens and maps the values of a configured claim to the routes the tokens
+// can access. The tokens can be signed with HS256, RS256 or ES256, using the keys from a local JWKS file
+type tokenValidator struct {
+	keys           []*verificationKey
+	issuer         string
+	audience       string
+	claimName      string
+	leeway         time.Duration
+	permissions    map[string]*common.RoutesMatcher
+	getTimeHandler func() time.Time
+}
+
+// NewTokenValidator returns a new instance of tokenValidator
+func NewTokenValidator(cfg config.JwtAuthenticationConfig) (*tokenValidator, error) {
+	if len(cfg.JwksFile) == 0 {
+		return nil, ErrEmptyJwksFilePath
+	}
+	if len(cfg.ClaimName) == 0 {
+		return nil, ErrEmptyClaimName
+	}
+	if cfg.LeewaySec < 0 {
+		return nil, fmt.Errorf("%w: %d seconds", ErrInvalidLeeway, cfg.LeewaySec)
+	}
+
+	permissions := make(map[string]*common.RoutesMatcher)
+	for _, permission := range cfg.Permissions {
+		if len(permission.ClaimValue) == 0 {
+			return nil, ErrEmptyClaimValue
+		}
+
+		routesMatcher, err := common.NewRoutesMatcher(permission.AllowedRoutes)
+		if err != nil {
+			return nil, fmt.Errorf("%w for claim value %s", err, permission.ClaimValue)
+		}
+		permissions[permission.ClaimValue] = routesMatcher
+	}
+
+	keys, err := loadJwks(cfg.JwksFile)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("loaded JWT verification keys", "file", cfg.JwksFile, "num keys", len(keys))
+
+	return &tokenValidator{
+		keys:           keys,
+		issuer:         cfg.Issuer,
+		audience:       cfg.Audience,
+		claimName:      cfg.ClaimName,
+		leeway:         time.Duration(cfg.LeewaySec) * time.Second,
+		permissions:    permissions,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// AuthorizeRequest validates the provided token and returns the authorization of the request towards the provided
+// route, which is in the /package/route form. It errors if the token is not valid
+func (tv *tokenValidator) AuthorizeRequest(token string, route string) (*data.TokenAuthorization, error) {
+	payload, err := tv.verifySignature(token)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &registeredClaims{}
+	err = json.Unmarshal(payload, claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+
+	err = tv.checkRegisteredClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	claimValues, err := tv.getClaimValues(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.TokenAuthorization{
+		Subject:        claims.Subject,
+		IsRouteAllowed: tv.isRouteAllowed(claimValues, route),
+	}, nil
+}
+
+// verifySignature checks the token's signature and returns its decoded payload
+func (tv *tokenValidator) verifySignature(token string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != numTokenParts {
+		return nil, ErrMalformedToken
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	header := &tokenHeader{}
+	err = json.Unmarshal(headerBytes, header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+
+	err = tv.verifyWithKeys(header, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+
+	return payload, nil
+}
+
+// verifyWithKeys verifies the signature with the key having the token's key ID or, if the token does not have a key
+// ID, with any key of the token's algorithm. The algorithm is always checked against the key's algorithm, so a token
+// can not be verified with a key meant for another algorithm
+func (tv *tokenValidator) verifyWithKeys(header *tokenHeader, signingInput []byte, signature []byte) error {
+	switch header.Algorithm {
+	case AlgorithmHS256, AlgorithmRS256, AlgorithmES256:
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, header.Algorithm)
+	}
+
+	isKeyFound := false
+	for _, key := range tv.keys {
+		if key.algorithm != header.Algorithm {
+			continue
+		}
+		if len(header.KeyID) > 0 && key.keyID != header.KeyID {
+			continue
+		}
+
+		isKeyFound = true
+		if key.verify(signingInput, signature) {
+			return nil
+		}
+	}
+	if !isKeyFound {
+		return ErrVerificationKeyNotFound
+	}
+
+	return ErrInvalidSignature
+}
+
+func (tv *tokenValidator) checkRegisteredClaims(claims *registeredClaims) error {
+	now := tv.getTimeHandler()
+	if claims.ExpiresAt == nil {
+		return ErrMissingExpiration
+	}
+	if now.After(unixToTime(*claims.ExpiresAt).Add(tv.leeway)) {
+		return ErrTokenExpired
+	}
+	if claims.NotBefore != nil && now.Before(unixToTime(*claims.NotBefore).Add(-tv.leeway)) {
+		return ErrTokenNotValidYet
+	}
+	if len(tv.issuer) > 0 && claims.Issuer != tv.issuer {
+		return ErrInvalidIssuer
+	}
+	if len(tv.audience) > 0 && !containsString(claims.Audience, tv.audience) {
+		return ErrInvalidAudience
+	}
+
+	return nil
+}
+
+// getClaimValues returns the values of the configured claim, which can be either a space separated string (as the
+// OAuth 2.0 "scope" claim) or an array of strings (as the usual "groups" or "roles" claims)
+func (tv *tokenValidator) getClaimValues(payload []byte) ([]string, error) {
+	claims := make(map[string]json.RawMessage)
+	err := json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+
+	rawValue, found := claims[tv.claimName]
+	if !found || bytes.Equal(rawValue, []byte("null")) {
+		return nil, nil
+	}
+
+	var single string
+	err = json.Unmarshal(rawValue, &single)
+	if err == nil {
+		return strings.Fields(single), nil
+	}
+
+	var multiple []string
+	err = json.Unmarshal(rawValue, &multiple)
+	if err != nil {
+		return nil, fmt.Errorf("%w: the %s claim is neither a string nor an array of strings", ErrMalformedToken, tv.claimName)
+	}
+
+	return multiple, nil
+}
+
+func (tv *tokenValidator) isRouteAllowed(claimValues []string, route string) bool {
+	for _, claimValue := range claimValues {
+		routesMatcher, found := tv.permissions[claimValue]
+		if found && routesMatcher.IsRouteAllowed(route) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func unixToTime(seconds float64) time.Time {
+	integerPart, fractionalPart := math.Modf(seconds)
+
+	return time.Unix(int64(integerPart), int64(fractionalPart*float64(time.Second)))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tv *tokenValidator) IsInterfaceNil() bool {
+	return tv == nil
+}
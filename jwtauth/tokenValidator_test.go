@@ -0,0 +1,306 @@
+package jwtauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testSecret     = []byte("a secret of at least thirty-two bytes")
+	testRSAKey     *rsa.PrivateKey
+	testECKey      *ecdsa.PrivateKey
+	testNow        = time.Unix(1700000000, 0)
+	testExpiration = testNow.Add(time.Hour).Unix()
+)
+
+func init() {
+	var err error
+	testRSAKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	testECKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func encodeSegment(buff []byte) string {
+	return base64.RawURLEncoding.EncodeToString(buff)
+}
+
+func encodeBigInt(value *big.Int) string {
+	return encodeSegment(value.Bytes())
+}
+
+func createTestJwks() map[string]interface{} {
+	return map[string]interface{}{
+		"keys": []map[string]interface{}{
+			{"kty": "oct", "kid": "hmac", "k": encodeSegment(testSecret)},
+			{"kty": "RSA", "kid": "rsa", "alg": "RS256", "use": "sig", "n": encodeBigInt(testRSAKey.N), "e": encodeBigInt(big.NewInt(int64(testRSAKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encodeBigInt(testECKey.X), "y": encodeBigInt(testECKey.Y)},
+			{"kty": "RSA", "kid": "encryption", "use": "enc", "n": "AQAB", "e": "AQAB"},
+			{"kty": "OKP", "kid": "unsupported", "crv": "Ed25519", "x": "AQAB"},
+		},
+	}
+}
+
+func writeJwksFile(t *testing.T, jwks interface{}) string {
+	buff, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	filePath := filepath.Join(t.TempDir(), "jwks.json")
+	err = os.WriteFile(filePath, buff, 0644)
+	require.NoError(t, err)
+
+	return filePath
+}
+
+func createMockConfig(t *testing.T) config.JwtAuthenticationConfig {
+	return config.JwtAuthenticationConfig{
+		Enabled:   true,
+		JwksFile:  writeJwksFile(t, createTestJwks()),
+		Issuer:    "https://issuer.example",
+		Audience:  "proxy",
+		ClaimName: "groups",
+		LeewaySec: 30,
+		Permissions: []config.JwtPermissionConfig{
+			{ClaimValue: "proxy-admin", AllowedRoutes: []string{"/actions/*", "/network/direct-staked-info"}},
+			{ClaimValue: "staking", AllowedRoutes: []string{"/network/direct-staked-info"}},
+		},
+	}
+}
+
+func createTokenValidator(t *testing.T) *tokenValidator {
+	tv, err := NewTokenValidator(createMockConfig(t))
+	require.NoError(t, err)
+	tv.getTimeHandler = func() time.Time {
+		return testNow
+	}
+
+	return tv
+}
+
+func createClaims(groups interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":    "internal-service",
+		"iss":    "https://issuer.example",
+		"aud":    []string{"other", "proxy"},
+		"exp":    testExpiration,
+		"groups": groups,
+	}
+}
+
+func signToken(t *testing.T, algorithm string, keyID string, claims interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "kid": keyID, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := encodeSegment(header) + "." + encodeSegment(payload)
+	hash := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch algorithm {
+	case AlgorithmHS256:
+		mac := hmac.New(sha256.New, testSecret)
+		_, _ = mac.Write([]byte(signingInput))
+		signature = mac.Sum(nil)
+	case AlgorithmRS256:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, testRSAKey, crypto.SHA256, hash[:])
+		require.NoError(t, err)
+	case AlgorithmES256:
+		r, s, errSign := ecdsa.Sign(rand.Reader, testECKey, hash[:])
+		require.NoError(t, errSign)
+		signature = make([]byte, es256SignatureLength)
+		r.FillBytes(signature[:es256SignatureLength/2])
+		s.FillBytes(signature[es256SignatureLength/2:])
+	default:
+		signature = []byte("signature")
+	}
+
+	return signingInput + "." + encodeSegment(signature)
+}
+
+func TestNewTokenValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty JWKS file should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockConfig(t)
+		cfg.JwksFile = ""
+		tv, err := NewTokenValidator(cfg)
+		require.Equal(t, ErrEmptyJwksFilePath, err)
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("empty claim name should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockConfig(t)
+		cfg.ClaimName = ""
+		tv, err := NewTokenValidator(cfg)
+		require.Equal(t, ErrEmptyClaimName, err)
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("negative leeway should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockConfig(t)
+		cfg.LeewaySec = -1
+		tv, err := NewTokenValidator(cfg)
+		require.True(t, errors.Is(err, ErrInvalidLeeway))
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("empty claim value should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockConfig(t)
+		cfg.Permissions[0].ClaimValue = ""
+		tv, err := NewTokenValidator(cfg)
+		require.Equal(t, ErrEmptyClaimValue, err)
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("invalid allowed route should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockConfig(t)
+		cfg.Permissions[0].AllowedRoutes = []string{"actions"}
+		tv, err := NewTokenValidator(cfg)
+		require.True(t, errors.Is(err, common.ErrInvalidAllowedRoute))
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("missing JWKS file should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockConfig(t)
+		cfg.JwksFile = filepath.Join(t.TempDir(), "missing.json")
+		tv, err := NewTokenValidator(cfg)
+		require.Error(t, err)
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tv, err := NewTokenValidator(createMockConfig(t))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(tv))
+		require.Equal(t, 3, len(tv.keys))
+	})
+}
+
+func TestTokenValidator_AuthorizeRequestShouldWorkWithAllAlgorithms(t *testing.T) {
+	t.Parallel()
+
+	tv := createTokenValidator(t)
+	for _, algorithm := range []string{AlgorithmHS256, AlgorithmRS256, AlgorithmES256} {
+		token := signToken(t, algorithm, "", createClaims([]string{"proxy-admin"}))
+
+		authorization, err := tv.AuthorizeRequest(token, "/actions/reload-observers")
+		require.NoError(t, err, algorithm)
+		assert.Equal(t, "internal-service", authorization.Subject, algorithm)
+		assert.True(t, authorization.IsRouteAllowed, algorithm)
+	}
+}
+
+func TestTokenValidator_AuthorizeRequestShouldMapClaimValuesToRoutes(t *testing.T) {
+	t.Parallel()
+
+	tv := createTokenValidator(t)
+
+	token := signToken(t, AlgorithmRS256, "rsa", createClaims("openid staking"))
+	authorization, err := tv.AuthorizeRequest(token, "/network/direct-staked-info")
+	require.NoError(t, err)
+	assert.True(t, authorization.IsRouteAllowed)
+
+	authorization, err = tv.AuthorizeRequest(token, "/actions/reload-observers")
+	require.NoError(t, err)
+	assert.False(t, authorization.IsRouteAllowed)
+
+	token = signToken(t, AlgorithmRS256, "rsa", createClaims(nil))
+	authorization, err = tv.AuthorizeRequest(token, "/network/direct-staked-info")
+	require.NoError(t, err)
+	assert.False(t, authorization.IsRouteAllowed)
+
+	token = signToken(t, AlgorithmRS256, "rsa", createClaims(5))
+	_, err = tv.AuthorizeRequest(token, "/network/direct-staked-info")
+	assert.True(t, errors.Is(err, ErrMalformedToken))
+}
+
+func TestTokenValidator_AuthorizeRequestInvalidTokensShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tv := createTokenValidator(t)
+	validClaims := createClaims([]string{"proxy-admin"})
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := createClaims([]string{"proxy-admin"})
+		claims[name] = value
+		if value == nil {
+			delete(claims, name)
+		}
+
+		return claims
+	}
+	validToken := signToken(t, AlgorithmHS256, "hmac", validClaims)
+	tamperedToken := validToken[:len(validToken)-2] + "AA"
+
+	tests := []struct {
+		name        string
+		token       string
+		expectedErr error
+	}{
+		{"malformed", "header.payload", ErrMalformedToken},
+		{"invalid encoding", "%%%.payload.signature", ErrMalformedToken},
+		{"none algorithm", signToken(t, "none", "", validClaims), ErrUnsupportedAlgorithm},
+		{"HS512 algorithm", signToken(t, "HS512", "", validClaims), ErrUnsupportedAlgorithm},
+		{"unknown key ID", signToken(t, AlgorithmHS256, "missing", validClaims), ErrVerificationKeyNotFound},
+		{"key ID of another algorithm", signToken(t, AlgorithmHS256, "rsa", validClaims), ErrVerificationKeyNotFound},
+		{"tampered signature", tamperedToken, ErrInvalidSignature},
+		{"missing expiration", signToken(t, AlgorithmHS256, "", withClaim("exp", nil)), ErrMissingExpiration},
+		{"expired", signToken(t, AlgorithmHS256, "", withClaim("exp", testNow.Add(-time.Minute).Unix())), ErrTokenExpired},
+		{"not valid yet", signToken(t, AlgorithmHS256, "", withClaim("nbf", testNow.Add(time.Minute).Unix())), ErrTokenNotValidYet},
+		{"invalid issuer", signToken(t, AlgorithmHS256, "", withClaim("iss", "https://other.example")), ErrInvalidIssuer},
+		{"invalid audience", signToken(t, AlgorithmHS256, "", withClaim("aud", "other")), ErrInvalidAudience},
+		{"missing audience", signToken(t, AlgorithmHS256, "", withClaim("aud", nil)), ErrInvalidAudience},
+	}
+
+	for _, tt := range tests {
+		authorization, err := tv.AuthorizeRequest(tt.token, "/actions/reload-observers")
+		assert.True(t, errors.Is(err, tt.expectedErr), "%s: %v", tt.name, err)
+		assert.Nil(t, authorization, tt.name)
+	}
+}
+
+func TestTokenValidator_AuthorizeRequestShouldApplyLeeway(t *testing.T) {
+	t.Parallel()
+
+	tv := createTokenValidator(t)
+
+	claims := createClaims([]string{"proxy-admin"})
+	claims["exp"] = testNow.Add(-10 * time.Second).Unix()
+	claims["nbf"] = testNow.Add(10 * time.Second).Unix()
+	token := signToken(t, AlgorithmES256, "ec", claims)
+
+	authorization, err := tv.AuthorizeRequest(token, "/actions/reload-observers")
+	require.NoError(t, err)
+	assert.True(t, authorization.IsRouteAllowed)
+}
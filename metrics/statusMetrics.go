@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -13,12 +14,21 @@ import (
 type statusMetrics struct {
 	endpointMetrics        map[string]*data.EndpointMetrics
 	mutEndpointsOperations sync.RWMutex
+	cacheMetrics           map[string]*data.CacheMetrics
+	mutCacheOperations     sync.RWMutex
+	coalescedRequests      map[string]uint64
+	mutCoalescedRequests   sync.RWMutex
+	apiKeysUsage           map[string]*data.ApiKeyUsage
+	mutApiKeysUsage        sync.RWMutex
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
-		endpointMetrics: make(map[string]*data.EndpointMetrics),
+		endpointMetrics:   make(map[string]*data.EndpointMetrics),
+		cacheMetrics:      make(map[string]*data.CacheMetrics),
+		coalescedRequests: make(map[string]uint64),
+		apiKeysUsage:      make(map[string]*data.ApiKeyUsage),
 	}
 }
 
@@ -71,6 +81,110 @@ func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetrics {
 	return newMap
 }
 
+// AddCacheHit will record a successful lookup in the provided cache
+func (sm *statusMetrics) AddCacheHit(cacheName string) {
+	sm.mutCacheOperations.Lock()
+	sm.getOrCreateCacheMetrics(cacheName).NumHits++
+	sm.mutCacheOperations.Unlock()
+}
+
+// AddCacheMiss will record a failed lookup in the provided cache
+func (sm *statusMetrics) AddCacheMiss(cacheName string) {
+	sm.mutCacheOperations.Lock()
+	sm.getOrCreateCacheMetrics(cacheName).NumMisses++
+	sm.mutCacheOperations.Unlock()
+}
+
+func (sm *statusMetrics) getOrCreateCacheMetrics(cacheName string) *data.CacheMetrics {
+	currentData, found := sm.cacheMetrics[cacheName]
+	if !found {
+		currentData = &data.CacheMetrics{}
+		sm.cacheMetrics[cacheName] = currentData
+	}
+
+	return currentData
+}
+
+// GetCacheMetrics returns a copy of the caches metrics map
+func (sm *statusMetrics) GetCacheMetrics() map[string]*data.CacheMetrics {
+	sm.mutCacheOperations.RLock()
+	defer sm.mutCacheOperations.RUnlock()
+
+	newMap := make(map[string]*data.CacheMetrics)
+	for key, value := range sm.cacheMetrics {
+		newMap[key] = &data.CacheMetrics{
+			NumHits:   value.NumHits,
+			NumMisses: value.NumMisses,
+		}
+	}
+
+	return newMap
+}
+
+// AddCoalescedRequest will record a request towards the provided node which was coalesced with an identical request
+func (sm *statusMetrics) AddCoalescedRequest(address string) {
+	sm.mutCoalescedRequests.Lock()
+	sm.coalescedRequests[address]++
+	sm.mutCoalescedRequests.Unlock()
+}
+
+// GetCoalescedRequests returns a copy of the coalesced requests map
+func (sm *statusMetrics) GetCoalescedRequests() map[string]uint64 {
+	sm.mutCoalescedRequests.RLock()
+	defer sm.mutCoalescedRequests.RUnlock()
+
+	newMap := make(map[string]uint64)
+	for key, value := range sm.coalescedRequests {
+		newMap[key] = value
+	}
+
+	return newMap
+}
+
+// AddApiKeyRequest will record a request made with the provided API key towards the provided path
+func (sm *statusMetrics) AddApiKeyRequest(keyName string, path string, withError bool) {
+	sm.mutApiKeysUsage.Lock()
+	defer sm.mutApiKeysUsage.Unlock()
+
+	usage, found := sm.apiKeysUsage[keyName]
+	if !found {
+		usage = &data.ApiKeyUsage{
+			Endpoints: make(map[string]uint64),
+		}
+		sm.apiKeysUsage[keyName] = usage
+	}
+
+	usage.NumRequests++
+	if withError {
+		usage.NumErrors++
+	}
+	usage.LastRequestTimestamp = time.Now().Unix()
+	usage.Endpoints[path]++
+}
+
+// GetApiKeysUsage returns a copy of the API keys usage map
+func (sm *statusMetrics) GetApiKeysUsage() map[string]*data.ApiKeyUsage {
+	sm.mutApiKeysUsage.RLock()
+	defer sm.mutApiKeysUsage.RUnlock()
+
+	newMap := make(map[string]*data.ApiKeyUsage)
+	for keyName, usage := range sm.apiKeysUsage {
+		endpoints := make(map[string]uint64)
+		for path, numRequests := range usage.Endpoints {
+			endpoints[path] = numRequests
+		}
+
+		newMap[keyName] = &data.ApiKeyUsage{
+			NumRequests:          usage.NumRequests,
+			NumErrors:            usage.NumErrors,
+			LastRequestTimestamp: usage.LastRequestTimestamp,
+			Endpoints:            endpoints,
+		}
+	}
+
+	return newMap
+}
+
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sm *statusMetrics) GetMetricsForPrometheus() string {
 	metricsMap := sm.GetAll()
@@ -85,6 +199,52 @@ func (sm *statusMetrics) GetMetricsForPrometheus() string {
 		stringBuilder.WriteString(fmt.Sprintf("lowest_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.LowestResponseTime))
 	}
 
+	cacheMetrics := sm.GetCacheMetrics()
+	cacheNames := make([]string, 0, len(cacheMetrics))
+	for cacheName := range cacheMetrics {
+		cacheNames = append(cacheNames, cacheName)
+	}
+	sort.Strings(cacheNames)
+
+	for _, cacheName := range cacheNames {
+		stringBuilder.WriteString(fmt.Sprintf("cache_hits{cache=\"%s\"} %d\n", cacheName, cacheMetrics[cacheName].NumHits))
+		stringBuilder.WriteString(fmt.Sprintf("cache_misses{cache=\"%s\"} %d\n", cacheName, cacheMetrics[cacheName].NumMisses))
+	}
+
+	coalescedRequests := sm.GetCoalescedRequests()
+	addresses := make([]string, 0, len(coalescedRequests))
+	for address := range coalescedRequests {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		stringBuilder.WriteString(fmt.Sprintf("coalesced_requests{node=\"%s\"} %d\n", address, coalescedRequests[address]))
+	}
+
+	apiKeysUsage := sm.GetApiKeysUsage()
+	keyNames := make([]string, 0, len(apiKeysUsage))
+	for keyName := range apiKeysUsage {
+		keyNames = append(keyNames, keyName)
+	}
+	sort.Strings(keyNames)
+
+	for _, keyName := range keyNames {
+		usage := apiKeysUsage[keyName]
+		stringBuilder.WriteString(fmt.Sprintf("api_key_num_requests{key=\"%s\"} %d\n", keyName, usage.NumRequests))
+		stringBuilder.WriteString(fmt.Sprintf("api_key_num_errors{key=\"%s\"} %d\n", keyName, usage.NumErrors))
+
+		paths := make([]string, 0, len(usage.Endpoints))
+		for path := range usage.Endpoints {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			stringBuilder.WriteString(fmt.Sprintf("api_key_endpoint_requests{key=\"%s\",endpoint=\"%s\"} %d\n", keyName, path, usage.Endpoints[path]))
+		}
+	}
+
 	return stringBuilder.String()
 }
 
@@ -129,6 +129,88 @@ lowest_response_time_ns{endpoint="/network/config"} 2000000
 	require.Equal(t, expectedString, res)
 }
 
+func TestStatusMetrics_CacheMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+
+	sm.AddCacheHit("transactions")
+	sm.AddCacheHit("transactions")
+	sm.AddCacheMiss("transactions")
+	sm.AddCacheMiss("blocks")
+
+	res := sm.GetCacheMetrics()
+	require.Equal(t, map[string]*data.CacheMetrics{
+		"transactions": {NumHits: 2, NumMisses: 1},
+		"blocks":       {NumHits: 0, NumMisses: 1},
+	}, res)
+
+	expectedString := `cache_hits{cache="blocks"} 0
+cache_misses{cache="blocks"} 1
+cache_hits{cache="transactions"} 2
+cache_misses{cache="transactions"} 1
+`
+	require.Equal(t, expectedString, sm.GetMetricsForPrometheus())
+}
+
+func TestStatusMetrics_CoalescedRequests(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+
+	sm.AddCoalescedRequest("http://observer1")
+	sm.AddCoalescedRequest("http://observer1")
+	sm.AddCoalescedRequest("http://observer0")
+
+	res := sm.GetCoalescedRequests()
+	require.Equal(t, map[string]uint64{
+		"http://observer0": 1,
+		"http://observer1": 2,
+	}, res)
+
+	expectedString := `coalesced_requests{node="http://observer0"} 1
+coalesced_requests{node="http://observer1"} 2
+`
+	require.Equal(t, expectedString, sm.GetMetricsForPrometheus())
+}
+
+func TestStatusMetrics_ApiKeysUsage(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+
+	sm.AddApiKeyRequest("bob", "/v1.0/network/config", false)
+	sm.AddApiKeyRequest("alice", "/v1.0/address/:address", false)
+	sm.AddApiKeyRequest("alice", "/v1.0/address/:address", true)
+	sm.AddApiKeyRequest("alice", "/v1.0/network/config", false)
+
+	res := sm.GetApiKeysUsage()
+	require.Equal(t, 2, len(res))
+	require.Equal(t, uint64(3), res["alice"].NumRequests)
+	require.Equal(t, uint64(1), res["alice"].NumErrors)
+	require.NotZero(t, res["alice"].LastRequestTimestamp)
+	require.Equal(t, map[string]uint64{
+		"/v1.0/address/:address": 2,
+		"/v1.0/network/config":   1,
+	}, res["alice"].Endpoints)
+	require.Equal(t, uint64(1), res["bob"].NumRequests)
+	require.Equal(t, uint64(0), res["bob"].NumErrors)
+
+	// the returned usage is a copy
+	res["alice"].Endpoints["/v1.0/network/config"] = 10
+	require.Equal(t, uint64(1), sm.GetApiKeysUsage()["alice"].Endpoints["/v1.0/network/config"])
+
+	expectedString := `api_key_num_requests{key="alice"} 3
+api_key_num_errors{key="alice"} 1
+api_key_endpoint_requests{key="alice",endpoint="/v1.0/address/:address"} 2
+api_key_endpoint_requests{key="alice",endpoint="/v1.0/network/config"} 1
+api_key_num_requests{key="bob"} 1
+api_key_num_errors{key="bob"} 0
+api_key_endpoint_requests{key="bob",endpoint="/v1.0/network/config"} 1
+`
+	require.Equal(t, expectedString, sm.GetMetricsForPrometheus())
+}
+
 func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 	t.Parallel()
 
@@ -144,7 +226,7 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 
 	for i := 0; i < numIterations; i++ {
 		go func(index int) {
-			switch index % 4 {
+			switch index % 6 {
 			case 0:
 				sm.AddRequestData(fmt.Sprintf("endpoint_%d", index%5), false, time.Hour*time.Duration(index))
 			case 1:
@@ -152,6 +234,15 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 				delete(res, "endpoint_0")
 			case 2:
 				_ = sm.GetMetricsForPrometheus()
+			case 3:
+				sm.AddCacheHit(fmt.Sprintf("cache_%d", index%2))
+				sm.AddCacheMiss(fmt.Sprintf("cache_%d", index%2))
+			case 4:
+				sm.AddCoalescedRequest(fmt.Sprintf("node_%d", index%2))
+				_ = sm.GetCoalescedRequests()
+			case 5:
+				sm.AddApiKeyRequest(fmt.Sprintf("key_%d", index%2), "endpoint_0", false)
+				_ = sm.GetApiKeysUsage()
 			}
 
 			wg.Done()
@@ -66,6 +66,20 @@ func (bnp *baseNodeProvider) GetAllNodesWithSyncState() []*data.NodeData {
 	return nodesSlice
 }
 
+// GetLastSyncedNodes returns, for each shard, the last synced node which is kept as backup in case all the nodes of
+// the shard become out of sync
+func (bnp *baseNodeProvider) GetLastSyncedNodes() map[uint32]*data.NodeData {
+	bnp.mutNodes.RLock()
+	defer bnp.mutNodes.RUnlock()
+
+	lastSyncedNodes := make(map[uint32]*data.NodeData, len(bnp.lastSyncedNodes))
+	for shardID, node := range bnp.lastSyncedNodes {
+		lastSyncedNodes[shardID] = node
+	}
+
+	return lastSyncedNodes
+}
+
 // UpdateNodesBasedOnSyncState will handle the nodes lists, by removing out of sync observers or by adding back observers
 // that were previously removed because they were out of sync.
 // If all observers are removed, the last one synced will be saved and the fallbacks will be used.
@@ -257,6 +271,13 @@ func (bnp *baseNodeProvider) removeOutOfSyncNodesUnprotected(
 	}
 
 	for _, outOfSyncNode := range outOfSyncNodes {
+		// a quarantined node does not serve the shard it is configured for, so it can be used neither as backup
+		// nor as the last fallback of its shard
+		if outOfSyncNode.IsQuarantined {
+			bnp.removeQuarantinedNodeUnprotected(outOfSyncNode)
+			continue
+		}
+
 		hasOneSyncedNode := len(syncedNodesMap[outOfSyncNode.ShardId]) >= 1
 		hasEnoughSyncedFallbackNodes := len(syncedFallbackNodesMap[outOfSyncNode.ShardId]) > 1
 		canDeleteFallbackNode := hasOneSyncedNode || hasEnoughSyncedFallbackNodes
@@ -313,6 +334,19 @@ func (bnp *baseNodeProvider) removeOutOfSyncNodesUnprotected(
 	}
 }
 
+func (bnp *baseNodeProvider) removeQuarantinedNodeUnprotected(node *data.NodeData) {
+	backupNode := bnp.lastSyncedNodes[node.ShardId]
+	if backupNode != nil && backupNode.Address == node.Address {
+		log.Info("backup observer removed as it is quarantined",
+			"address", node.Address,
+			"is fallback", node.IsFallback,
+			"shard", node.ShardId)
+		delete(bnp.lastSyncedNodes, node.ShardId)
+	}
+
+	bnp.removeNodeUnprotected(node)
+}
+
 func (bnp *baseNodeProvider) removeNodeUnprotected(node *data.NodeData) {
 	bnp.removeNodeFromSyncedNodesUnprotected(node)
 	bnp.addToOutOfSyncUnprotected(node)
@@ -415,6 +449,39 @@ func (bnp *baseNodeProvider) ReloadNodes(nodesType data.NodeType) data.NodesRelo
 	}
 }
 
+// UpdateNodes replaces the nodes with the provided ones, which must be spread on the same shards as the current ones.
+// The nodes which were already known keep their sync state, while the new ones are considered synced until the next
+// sync state check
+func (bnp *baseNodeProvider) UpdateNodes(nodes []*data.NodeData) error {
+	if len(nodes) == 0 {
+		return ErrEmptyObserversList
+	}
+
+	newNodes := nodesSliceToShardedMap(nodes)
+	newShardIDs := getSortedShardIDsSlice(newNodes)
+
+	bnp.mutNodes.Lock()
+	defer bnp.mutNodes.Unlock()
+
+	if !isSameShardIDsSlice(bnp.shardIds, newShardIDs) {
+		return fmt.Errorf("%w: the nodes should be spread on shards %v, provided nodes are spread on shards %v",
+			ErrWrongObserversConfiguration, bnp.shardIds, newShardIDs)
+	}
+
+	regularNodes, fallbackNodes := initAllNodesSlice(newNodes)
+	bnp.syncedNodes, bnp.outOfSyncNodes = splitNodesBySyncState(regularNodes, bnp.outOfSyncNodes)
+	bnp.syncedFallbackNodes, bnp.outOfSyncFallbackNodes = splitNodesBySyncState(fallbackNodes, bnp.outOfSyncFallbackNodes)
+	for shardID, backupNode := range bnp.lastSyncedNodes {
+		if getIndexFromList(backupNode, nodes) == -1 {
+			delete(bnp.lastSyncedNodes, shardID)
+		}
+	}
+
+	bnp.printSyncedNodesInShardsUnprotected()
+
+	return nil
+}
+
 func (bnp *baseNodeProvider) getSyncedNodesForShardUnprotected(shardId uint32) ([]*data.NodeData, error) {
 	syncedNodes := make([]*data.NodeData, 0)
 	for _, node := range bnp.syncedNodes {
@@ -436,7 +503,7 @@ func (bnp *baseNodeProvider) getSyncedNodesForShardUnprotected(shardId uint32) (
 	}
 
 	backupNode, hasBackup := bnp.lastSyncedNodes[shardId]
-	if hasBackup {
+	if hasBackup && !backupNode.IsQuarantined {
 		return []*data.NodeData{backupNode}, nil
 	}
 
@@ -545,3 +612,32 @@ func getIndexFromList(providedNode *data.NodeData, list []*data.NodeData) int {
 
 	return nodeIndex
 }
+
+func splitNodesBySyncState(nodes []*data.NodeData, outOfSyncNodes []*data.NodeData) ([]*data.NodeData, []*data.NodeData) {
+	syncedNodes := make([]*data.NodeData, 0, len(nodes))
+	stillOutOfSyncNodes := make([]*data.NodeData, 0)
+	for _, node := range nodes {
+		if getIndexFromList(node, outOfSyncNodes) != -1 {
+			stillOutOfSyncNodes = append(stillOutOfSyncNodes, node)
+			continue
+		}
+
+		syncedNodes = append(syncedNodes, node)
+	}
+
+	return syncedNodes, stillOutOfSyncNodes
+}
+
+func isSameShardIDsSlice(shardIDs []uint32, otherShardIDs []uint32) bool {
+	if len(shardIDs) != len(otherShardIDs) {
+		return false
+	}
+
+	for idx := range shardIDs {
+		if shardIDs[idx] != otherShardIDs[idx] {
+			return false
+		}
+	}
+
+	return true
+}
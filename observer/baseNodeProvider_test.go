@@ -47,6 +47,57 @@ func TestBaseNodeProvider_ReloadNodesShouldWork(t *testing.T) {
 	require.Empty(t, response.Error)
 }
 
+func TestBaseNodeProvider_UpdateNodesDifferentShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodes([]*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 1},
+	})
+
+	err := bnp.UpdateNodes(nil)
+	require.Equal(t, ErrEmptyObserversList, err)
+
+	err = bnp.UpdateNodes([]*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr2", ShardId: 2},
+	})
+	require.True(t, errors.Is(err, ErrWrongObserversConfiguration))
+	require.Equal(t, 2, len(bnp.syncedNodes))
+}
+
+func TestBaseNodeProvider_UpdateNodesShouldKeepTheSyncStateOfKnownNodes(t *testing.T) {
+	t.Parallel()
+
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodes([]*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 1},
+		{Address: "addr3", ShardId: 1},
+	})
+	bnp.UpdateNodesBasedOnSyncState([]*data.NodeData{
+		{Address: "addr0", ShardId: 0, IsSynced: true},
+		{Address: "addr1", ShardId: 0, IsSynced: false},
+		{Address: "addr2", ShardId: 1, IsSynced: true},
+		{Address: "addr3", ShardId: 1, IsSynced: true},
+	})
+
+	err := bnp.UpdateNodes([]*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr4", ShardId: 0},
+		{Address: "addr2", ShardId: 1},
+		{Address: "addr5", ShardId: 1, IsFallback: true},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"addr2", "addr4"}, getAddresses(bnp.syncedNodes))
+	require.Equal(t, []string{"addr1"}, getAddresses(bnp.outOfSyncNodes))
+	require.Equal(t, []string{"addr5"}, getAddresses(bnp.syncedFallbackNodes))
+	require.Empty(t, bnp.outOfSyncFallbackNodes)
+}
+
 func TestBaseNodeProvider_prepareReloadResponseMessage(t *testing.T) {
 	addr0, addr1, addr2 := "addr0", "addr1", "addr2"
 	newNodes := map[uint32][]*data.NodeData{
@@ -870,10 +921,11 @@ func copyNodes(nodes []*data.NodeData) []*data.NodeData {
 	nodesCopy := make([]*data.NodeData, len(nodes))
 	for i, node := range nodes {
 		nodesCopy[i] = &data.NodeData{
-			ShardId:    node.ShardId,
-			Address:    node.Address,
-			IsSynced:   node.IsSynced,
-			IsFallback: node.IsFallback,
+			ShardId:       node.ShardId,
+			Address:       node.Address,
+			IsSynced:      node.IsSynced,
+			IsFallback:    node.IsFallback,
+			IsQuarantined: node.IsQuarantined,
 		}
 	}
 
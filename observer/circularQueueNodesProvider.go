@@ -50,6 +50,11 @@ func (cqnp *circularQueueNodesProvider) GetNodesByShardId(shardId uint32) ([]*da
 	return sliceToRet, nil
 }
 
+// GetNodesByShardIdForAddress will return the same nodes as GetNodesByShardId, as the nodes are not selected by address
+func (cqnp *circularQueueNodesProvider) GetNodesByShardIdForAddress(shardId uint32, _ []byte) ([]*data.NodeData, error) {
+	return cqnp.GetNodesByShardId(shardId)
+}
+
 // GetAllNodes will return a slice containing all observers
 func (cqnp *circularQueueNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
 	cqnp.mutNodes.Lock()
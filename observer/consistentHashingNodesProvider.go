@@ -0,0 +1,158 @@
+package observer
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ConsistentHashingStrategy maps each address to a preferred node of its shard by placing the nodes on a hash ring, so
+// the requests for the same address reach the same node while it is synced. The next nodes on the ring are used as
+// fallback. The requests which are not made for an address are distributed in a circular queue way
+const ConsistentHashingStrategy = "consistent-hashing"
+
+// numVirtualNodesPerNode represents the number of points each node has on the hash ring. The more points, the more
+// evenly the addresses are spread between the nodes
+const numVirtualNodesPerNode = 100
+
+type hashRingPoint struct {
+	hash uint64
+	node *data.NodeData
+}
+
+// hashRing holds the points of the nodes of a shard, sorted by their hash
+type hashRing struct {
+	signature string
+	points    []hashRingPoint
+	numNodes  int
+}
+
+// consistentHashingNodesProvider will handle the providing of observers based on the address the request is made for
+type consistentHashingNodesProvider struct {
+	*circularQueueNodesProvider
+	mutRings sync.Mutex
+	rings    map[uint32]*hashRing
+}
+
+// NewConsistentHashingNodesProvider returns a new instance of consistentHashingNodesProvider
+func NewConsistentHashingNodesProvider(observers []*data.NodeData, configurationFilePath string) (*consistentHashingNodesProvider, error) {
+	cqnp, err := NewCircularQueueNodesProvider(observers, configurationFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consistentHashingNodesProvider{
+		circularQueueNodesProvider: cqnp,
+		rings:                      make(map[uint32]*hashRing),
+	}, nil
+}
+
+// GetNodesByShardIdForAddress will return a slice of the nodes for the given shard, having the node the address is
+// mapped to in front, followed by the next nodes on the hash ring
+func (chnp *consistentHashingNodesProvider) GetNodesByShardIdForAddress(shardId uint32, address []byte) ([]*data.NodeData, error) {
+	if len(address) == 0 {
+		return chnp.GetNodesByShardId(shardId)
+	}
+
+	chnp.mutNodes.RLock()
+	syncedNodesForShard, err := chnp.getSyncedNodesForShardUnprotected(shardId)
+	chnp.mutNodes.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(syncedNodesForShard) < 2 {
+		return syncedNodesForShard, nil
+	}
+
+	ring := chnp.getHashRing(shardId, syncedNodesForShard)
+
+	return ring.getNodes(computeHash(address)), nil
+}
+
+// getHashRing returns the hash ring of the provided nodes, which is only rebuilt when the synced nodes of the shard change
+func (chnp *consistentHashingNodesProvider) getHashRing(shardId uint32, nodes []*data.NodeData) *hashRing {
+	signature := computeNodesSignature(nodes)
+
+	chnp.mutRings.Lock()
+	defer chnp.mutRings.Unlock()
+
+	ring, found := chnp.rings[shardId]
+	if found && ring.signature == signature {
+		return ring
+	}
+
+	ring = newHashRing(signature, nodes)
+	chnp.rings[shardId] = ring
+
+	return ring
+}
+
+func newHashRing(signature string, nodes []*data.NodeData) *hashRing {
+	points := make([]hashRingPoint, 0, len(nodes)*numVirtualNodesPerNode)
+	for _, node := range nodes {
+		for i := 0; i < numVirtualNodesPerNode; i++ {
+			points = append(points, hashRingPoint{
+				hash: computeHash([]byte(node.Address + "#" + strconv.Itoa(i))),
+				node: node,
+			})
+		}
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].hash < points[j].hash
+	})
+
+	return &hashRing{
+		signature: signature,
+		points:    points,
+		numNodes:  len(nodes),
+	}
+}
+
+// getNodes returns all the nodes of the ring, in the order in which they are found walking the ring clockwise from
+// the provided hash
+func (hr *hashRing) getNodes(hash uint64) []*data.NodeData {
+	startIndex := sort.Search(len(hr.points), func(i int) bool {
+		return hr.points[i].hash >= hash
+	})
+
+	nodes := make([]*data.NodeData, 0, hr.numNodes)
+	addedNodes := make(map[string]struct{}, hr.numNodes)
+	for i := 0; i < len(hr.points) && len(nodes) < hr.numNodes; i++ {
+		point := hr.points[(startIndex+i)%len(hr.points)]
+		_, isAdded := addedNodes[point.node.Address]
+		if isAdded {
+			continue
+		}
+
+		addedNodes[point.node.Address] = struct{}{}
+		nodes = append(nodes, point.node)
+	}
+
+	return nodes
+}
+
+func computeNodesSignature(nodes []*data.NodeData) string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return strings.Join(addresses, ",")
+}
+
+func computeHash(buff []byte) uint64 {
+	hash := sha256.Sum256(buff)
+
+	return binary.BigEndian.Uint64(hash[:8])
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (chnp *consistentHashingNodesProvider) IsInterfaceNil() bool {
+	return chnp == nil
+}
@@ -0,0 +1,128 @@
+package observer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createConsistentHashingTestNodes() []*data.NodeData {
+	return []*data.NodeData{
+		{Address: "observer0", ShardId: 0, IsSynced: true},
+		{Address: "observer1", ShardId: 0, IsSynced: true},
+		{Address: "observer2", ShardId: 0, IsSynced: true},
+		{Address: "observer3", ShardId: 1, IsSynced: true},
+	}
+}
+
+func getNodesAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
+
+func TestNewConsistentHashingNodesProvider(t *testing.T) {
+	t.Parallel()
+
+	chnp, err := NewConsistentHashingNodesProvider(make([]*data.NodeData, 0), "path")
+	assert.True(t, check.IfNil(chnp))
+	assert.Equal(t, ErrEmptyObserversList, err)
+
+	chnp, err = NewConsistentHashingNodesProvider(createConsistentHashingTestNodes(), "path")
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(chnp))
+}
+
+func TestConsistentHashingNodesProvider_GetNodesByShardIdForAddressShouldBeSticky(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashingNodesProvider(createConsistentHashingTestNodes(), "path")
+
+	address := []byte("address")
+	nodes, err := chnp.GetNodesByShardIdForAddress(0, address)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(nodes))
+	require.ElementsMatch(t, []string{"observer0", "observer1", "observer2"}, getNodesAddresses(nodes))
+
+	for i := 0; i < 10; i++ {
+		otherNodes, _ := chnp.GetNodesByShardIdForAddress(0, address)
+		assert.Equal(t, getNodesAddresses(nodes), getNodesAddresses(otherNodes))
+	}
+
+	nodes, err = chnp.GetNodesByShardIdForAddress(1, address)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"observer3"}, getNodesAddresses(nodes))
+}
+
+func TestConsistentHashingNodesProvider_GetNodesByShardIdForAddressShouldSpreadTheAddresses(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashingNodesProvider(createConsistentHashingTestNodes(), "path")
+
+	numAddresses := 3000
+	numAddressesPerNode := make(map[string]int)
+	for i := 0; i < numAddresses; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForAddress(0, []byte(fmt.Sprintf("address%d", i)))
+		numAddressesPerNode[nodes[0].Address]++
+	}
+
+	require.Equal(t, 3, len(numAddressesPerNode))
+	for address, numAddressesOfNode := range numAddressesPerNode {
+		assert.Greater(t, numAddressesOfNode, numAddresses/5, address)
+	}
+}
+
+func TestConsistentHashingNodesProvider_OutOfSyncNodeShouldOnlyMoveItsAddresses(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashingNodesProvider(createConsistentHashingTestNodes(), "path")
+
+	numAddresses := 300
+	initialNodes := make([][]string, 0, numAddresses)
+	for i := 0; i < numAddresses; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForAddress(0, []byte(fmt.Sprintf("address%d", i)))
+		initialNodes = append(initialNodes, getNodesAddresses(nodes))
+	}
+
+	nodesWithSyncState := createConsistentHashingTestNodes()
+	nodesWithSyncState[1].IsSynced = false
+	chnp.UpdateNodesBasedOnSyncState(nodesWithSyncState)
+
+	for i := 0; i < numAddresses; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForAddress(0, []byte(fmt.Sprintf("address%d", i)))
+		require.Equal(t, 2, len(nodes))
+
+		expectedPreferredNode := initialNodes[i][0]
+		if expectedPreferredNode == "observer1" {
+			expectedPreferredNode = initialNodes[i][1]
+		}
+		assert.Equal(t, expectedPreferredNode, nodes[0].Address)
+	}
+}
+
+func TestConsistentHashingNodesProvider_GetNodesByShardIdForAddressWithoutAddressShouldBalance(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashingNodesProvider(createConsistentHashingTestNodes(), "path")
+
+	firstNodes, _ := chnp.GetNodesByShardIdForAddress(0, nil)
+	secondNodes, _ := chnp.GetNodesByShardIdForAddress(0, nil)
+	assert.NotEqual(t, firstNodes[0].Address, secondNodes[0].Address)
+}
+
+func TestConsistentHashingNodesProvider_UnknownShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashingNodesProvider(createConsistentHashingTestNodes(), "path")
+
+	nodes, err := chnp.GetNodesByShardIdForAddress(2, []byte("address"))
+	assert.Nil(t, nodes)
+	assert.Equal(t, ErrShardNotAvailable, err)
+}
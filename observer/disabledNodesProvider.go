@@ -24,11 +24,21 @@ func NewDisabledNodesProvider(returnMessage string) *disabledNodesProvider {
 func (d *disabledNodesProvider) UpdateNodesBasedOnSyncState(_ []*data.NodeData) {
 }
 
+// GetNodesByShardIdForAddress returns the desired return message as an error
+func (d *disabledNodesProvider) GetNodesByShardIdForAddress(_ uint32, _ []byte) ([]*data.NodeData, error) {
+	return nil, errors.New(d.returnMessage)
+}
+
 // GetAllNodesWithSyncState returns an empty slice
 func (d *disabledNodesProvider) GetAllNodesWithSyncState() []*data.NodeData {
 	return make([]*data.NodeData, 0)
 }
 
+// GetLastSyncedNodes returns an empty map
+func (d *disabledNodesProvider) GetLastSyncedNodes() map[uint32]*data.NodeData {
+	return make(map[uint32]*data.NodeData)
+}
+
 // GetNodesByShardId returns the desired return message as an error
 func (d *disabledNodesProvider) GetNodesByShardId(_ uint32) ([]*data.NodeData, error) {
 	return nil, errors.New(d.returnMessage)
@@ -44,6 +54,11 @@ func (d *disabledNodesProvider) ReloadNodes(_ data.NodeType) data.NodesReloadRes
 	return data.NodesReloadResponse{Description: "disabled nodes provider", Error: d.returnMessage}
 }
 
+// UpdateNodes returns the desired return message as an error
+func (d *disabledNodesProvider) UpdateNodes(_ []*data.NodeData) error {
+	return errors.New(d.returnMessage)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (d *disabledNodesProvider) IsInterfaceNil() bool {
 	return d == nil
@@ -10,3 +10,6 @@ var ErrShardNotAvailable = errors.New("the specified shard ID does not exist in
 
 // ErrWrongObserversConfiguration signals an invalid observers configuration
 var ErrWrongObserversConfiguration = errors.New("wrong observers configuration")
+
+// ErrInvalidNodesSelectionStrategy signals that an invalid nodes selection strategy has been provided
+var ErrInvalidNodesSelectionStrategy = errors.New("invalid nodes selection strategy")
@@ -1,13 +1,26 @@
 package observer
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // NodesProviderHandler defines what a nodes provider should be able to do
 type NodesProviderHandler interface {
 	GetNodesByShardId(shardId uint32) ([]*data.NodeData, error)
+	GetNodesByShardIdForAddress(shardId uint32, address []byte) ([]*data.NodeData, error)
 	GetAllNodes() ([]*data.NodeData, error)
 	UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*data.NodeData)
 	GetAllNodesWithSyncState() []*data.NodeData
+	GetLastSyncedNodes() map[uint32]*data.NodeData
 	ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse
+	UpdateNodes(nodes []*data.NodeData) error
 	IsInterfaceNil() bool
 }
+
+// NodesRequestsTracker defines what a component able to track the requests sent towards the nodes should do
+type NodesRequestsTracker interface {
+	RequestStarted(address string)
+	RequestFinished(address string, duration time.Duration, withError bool)
+}
@@ -0,0 +1,278 @@
+package observer
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	// EWMALatencyStrategy orders the nodes by the exponentially weighted moving average of their response times,
+	// penalized by their recent error rate
+	EWMALatencyStrategy = "ewma-latency"
+
+	// LeastOutstandingRequestsStrategy orders the nodes by the number of requests that are still in progress on
+	// each of them. Ties are broken by the EWMA latency score
+	LeastOutstandingRequestsStrategy = "least-outstanding-requests"
+)
+
+const (
+	// ewmaSmoothingFactor is the weight of the newest sample when updating the moving averages
+	ewmaSmoothingFactor = 0.3
+
+	// errorPenalty is the latency added to a node's score for an error rate of 100%
+	errorPenalty = time.Second
+
+	// statsExpiryDuration is the interval after which the statistics of a node which did not receive any request are
+	// considered stale. Such a node will be placed in front so it can be probed again
+	statsExpiryDuration = 10 * time.Second
+)
+
+type nodeRequestsStats struct {
+	ewmaLatency     float64
+	ewmaErrorRate   float64
+	numOutstanding  int64
+	numSamples      uint64
+	lastSampleTime  time.Time
+	lastRequestTime time.Time
+}
+
+// latencyAwareNodesProvider will handle the providing of observers based on their recent performance, so that the
+// nodes that respond faster and with fewer errors will receive the requests first
+type latencyAwareNodesProvider struct {
+	*baseNodeProvider
+	strategy     string
+	mutStats     sync.RWMutex
+	nodesStats   map[string]*nodeRequestsStats
+	getTimeFunc  func() time.Time
+	statsExpiry  time.Duration
+	errorPenalty time.Duration
+}
+
+// NewLatencyAwareNodesProvider returns a new instance of latencyAwareNodesProvider
+func NewLatencyAwareNodesProvider(
+	observers []*data.NodeData,
+	configurationFilePath string,
+	strategy string,
+) (*latencyAwareNodesProvider, error) {
+	if !isLatencyAwareStrategy(strategy) {
+		return nil, ErrInvalidNodesSelectionStrategy
+	}
+
+	bop := &baseNodeProvider{
+		configurationFilePath: configurationFilePath,
+	}
+
+	err := bop.initNodes(observers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &latencyAwareNodesProvider{
+		baseNodeProvider: bop,
+		strategy:         strategy,
+		nodesStats:       make(map[string]*nodeRequestsStats),
+		getTimeFunc:      time.Now,
+		statsExpiry:      statsExpiryDuration,
+		errorPenalty:     errorPenalty,
+	}, nil
+}
+
+// GetNodesByShardId will return a slice of the nodes for the given shard, ordered by their score
+func (lanp *latencyAwareNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	syncedNodesForShard, err := lanp.getSyncedNodesForShardUnprotected(shardId)
+	if err != nil {
+		return nil, err
+	}
+
+	return lanp.sortNodes(syncedNodesForShard), nil
+}
+
+// GetNodesByShardIdForAddress will return the same nodes as GetNodesByShardId, as the nodes are not selected by address
+func (lanp *latencyAwareNodesProvider) GetNodesByShardIdForAddress(shardId uint32, _ []byte) ([]*data.NodeData, error) {
+	return lanp.GetNodesByShardId(shardId)
+}
+
+// GetAllNodes will return a slice containing all the nodes, ordered by their score
+func (lanp *latencyAwareNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	allNodes, err := lanp.getSyncedNodesUnprotected()
+	if err != nil {
+		return nil, err
+	}
+
+	return lanp.sortNodes(allNodes), nil
+}
+
+// UpdateNodesBasedOnSyncState will update the nodes lists based on their sync state and will drop the statistics of
+// the nodes which are no longer known
+func (lanp *latencyAwareNodesProvider) UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*data.NodeData) {
+	lanp.baseNodeProvider.UpdateNodesBasedOnSyncState(nodesWithSyncStatus)
+	lanp.removeStatsOfUnknownNodes()
+}
+
+// ReloadNodes will reload the nodes from the configuration file and will drop the statistics of the removed nodes
+func (lanp *latencyAwareNodesProvider) ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse {
+	response := lanp.baseNodeProvider.ReloadNodes(nodesType)
+	lanp.removeStatsOfUnknownNodes()
+
+	return response
+}
+
+// UpdateNodes will replace the nodes with the provided ones and will drop the statistics of the removed nodes
+func (lanp *latencyAwareNodesProvider) UpdateNodes(nodes []*data.NodeData) error {
+	err := lanp.baseNodeProvider.UpdateNodes(nodes)
+	if err != nil {
+		return err
+	}
+
+	lanp.removeStatsOfUnknownNodes()
+
+	return nil
+}
+
+// RequestStarted marks the beginning of a request towards the node with the provided address
+func (lanp *latencyAwareNodesProvider) RequestStarted(address string) {
+	lanp.mutStats.Lock()
+	defer lanp.mutStats.Unlock()
+
+	stats := lanp.getOrCreateStatsUnprotected(address)
+	stats.numOutstanding++
+	stats.lastRequestTime = lanp.getTimeFunc()
+}
+
+// RequestFinished marks the end of a request towards the node with the provided address and updates its statistics
+func (lanp *latencyAwareNodesProvider) RequestFinished(address string, duration time.Duration, withError bool) {
+	lanp.mutStats.Lock()
+	defer lanp.mutStats.Unlock()
+
+	stats := lanp.getOrCreateStatsUnprotected(address)
+	if stats.numOutstanding > 0 {
+		stats.numOutstanding--
+	}
+
+	errorSample := float64(0)
+	if withError {
+		errorSample = 1
+	}
+
+	if stats.numSamples == 0 {
+		stats.ewmaLatency = float64(duration)
+		stats.ewmaErrorRate = errorSample
+	} else {
+		stats.ewmaLatency = ewma(stats.ewmaLatency, float64(duration))
+		stats.ewmaErrorRate = ewma(stats.ewmaErrorRate, errorSample)
+	}
+
+	stats.numSamples++
+	stats.lastSampleTime = lanp.getTimeFunc()
+}
+
+func (lanp *latencyAwareNodesProvider) getOrCreateStatsUnprotected(address string) *nodeRequestsStats {
+	stats, found := lanp.nodesStats[address]
+	if !found {
+		stats = &nodeRequestsStats{}
+		lanp.nodesStats[address] = stats
+	}
+
+	return stats
+}
+
+func (lanp *latencyAwareNodesProvider) removeStatsOfUnknownNodes() {
+	knownAddresses := make(map[string]struct{})
+	for _, node := range lanp.GetAllNodesWithSyncState() {
+		knownAddresses[node.Address] = struct{}{}
+	}
+	for _, node := range lanp.GetLastSyncedNodes() {
+		knownAddresses[node.Address] = struct{}{}
+	}
+
+	lanp.mutStats.Lock()
+	defer lanp.mutStats.Unlock()
+
+	for address := range lanp.nodesStats {
+		_, isKnown := knownAddresses[address]
+		if !isKnown {
+			delete(lanp.nodesStats, address)
+		}
+	}
+}
+
+func (lanp *latencyAwareNodesProvider) sortNodes(nodes []*data.NodeData) []*data.NodeData {
+	lanp.mutStats.RLock()
+	defer lanp.mutStats.RUnlock()
+
+	sortedNodes := make([]*data.NodeData, len(nodes))
+	copy(sortedNodes, nodes)
+
+	scores := make(map[string]float64, len(nodes))
+	for _, node := range nodes {
+		scores[node.Address] = lanp.computeScoreUnprotected(node.Address)
+	}
+
+	if lanp.strategy == LeastOutstandingRequestsStrategy {
+		sort.SliceStable(sortedNodes, func(i, j int) bool {
+			outstandingI := lanp.numOutstandingUnprotected(sortedNodes[i].Address)
+			outstandingJ := lanp.numOutstandingUnprotected(sortedNodes[j].Address)
+			if outstandingI != outstandingJ {
+				return outstandingI < outstandingJ
+			}
+
+			return scores[sortedNodes[i].Address] < scores[sortedNodes[j].Address]
+		})
+
+		return sortedNodes
+	}
+
+	sort.SliceStable(sortedNodes, func(i, j int) bool {
+		return scores[sortedNodes[i].Address] < scores[sortedNodes[j].Address]
+	})
+
+	return sortedNodes
+}
+
+// computeScoreUnprotected returns the score of a node. The lower the score, the better the node. A node without
+// statistics or with stale statistics will have a score of 0, so it will be probed again
+func (lanp *latencyAwareNodesProvider) computeScoreUnprotected(address string) float64 {
+	stats, found := lanp.nodesStats[address]
+	if !found || stats.numSamples == 0 {
+		return 0
+	}
+
+	now := lanp.getTimeFunc()
+	isStale := now.Sub(stats.lastSampleTime) > lanp.statsExpiry && now.Sub(stats.lastRequestTime) > lanp.statsExpiry
+	if isStale {
+		return 0
+	}
+
+	return stats.ewmaLatency + stats.ewmaErrorRate*float64(lanp.errorPenalty)
+}
+
+func (lanp *latencyAwareNodesProvider) numOutstandingUnprotected(address string) int64 {
+	stats, found := lanp.nodesStats[address]
+	if !found {
+		return 0
+	}
+
+	return stats.numOutstanding
+}
+
+func ewma(oldValue float64, sample float64) float64 {
+	return ewmaSmoothingFactor*sample + (1-ewmaSmoothingFactor)*oldValue
+}
+
+func isLatencyAwareStrategy(strategy string) bool {
+	return strategy == EWMALatencyStrategy || strategy == LeastOutstandingRequestsStrategy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lanp *latencyAwareNodesProvider) IsInterfaceNil() bool {
+	return lanp == nil
+}
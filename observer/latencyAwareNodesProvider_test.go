@@ -0,0 +1,204 @@
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getThreeObserversInShard0() []*data.NodeData {
+	return []*data.NodeData{
+		{
+			Address: "addr1",
+			ShardId: 0,
+		},
+		{
+			Address: "addr2",
+			ShardId: 0,
+		},
+		{
+			Address: "addr3",
+			ShardId: 0,
+		},
+	}
+}
+
+func getAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
+
+func TestNewLatencyAwareNodesProvider_InvalidStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lanp, err := NewLatencyAwareNodesProvider(getDummyConfig().Observers, "path", "invalid")
+	assert.Nil(t, lanp)
+	assert.Equal(t, ErrInvalidNodesSelectionStrategy, err)
+}
+
+func TestNewLatencyAwareNodesProvider_EmptyObserversListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lanp, err := NewLatencyAwareNodesProvider(make([]*data.NodeData, 0), "path", EWMALatencyStrategy)
+	assert.Nil(t, lanp)
+	assert.Equal(t, ErrEmptyObserversList, err)
+}
+
+func TestNewLatencyAwareNodesProvider_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	lanp, err := NewLatencyAwareNodesProvider(getDummyConfig().Observers, "path", LeastOutstandingRequestsStrategy)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(lanp))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdWithoutStatsShouldKeepConfigOrder(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", EWMALatencyStrategy)
+
+	res, err := lanp.GetNodesByShardId(0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr2", "addr3"}, getAddresses(res))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldOrderByLatency(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", EWMALatencyStrategy)
+	lanp.RequestFinished("addr1", 300*time.Millisecond, false)
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+	lanp.RequestFinished("addr3", 200*time.Millisecond, false)
+
+	res, err := lanp.GetNodesByShardId(0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr2", "addr3", "addr1"}, getAddresses(res))
+
+	allNodes, err := lanp.GetAllNodes()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr2", "addr3", "addr1"}, getAddresses(allNodes))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldPenalizeErrors(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", EWMALatencyStrategy)
+	lanp.RequestFinished("addr1", 100*time.Millisecond, false)
+	lanp.RequestFinished("addr2", 10*time.Millisecond, true)
+	lanp.RequestFinished("addr3", 200*time.Millisecond, false)
+
+	res, err := lanp.GetNodesByShardId(0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr3", "addr2"}, getAddresses(res))
+}
+
+func TestLatencyAwareNodesProvider_StaleStatsShouldBeProbedAgain(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", EWMALatencyStrategy)
+	currentTime := time.Now()
+	lanp.getTimeFunc = func() time.Time {
+		return currentTime
+	}
+
+	lanp.RequestFinished("addr1", 300*time.Millisecond, false)
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+	lanp.RequestFinished("addr3", 200*time.Millisecond, false)
+
+	currentTime = currentTime.Add(statsExpiryDuration + time.Second)
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+	lanp.RequestFinished("addr3", 200*time.Millisecond, false)
+
+	res, err := lanp.GetNodesByShardId(0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr2", "addr3"}, getAddresses(res))
+}
+
+func TestLatencyAwareNodesProvider_RemovedNodesShouldHaveTheirStatsDropped(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", EWMALatencyStrategy)
+	lanp.RequestFinished("addr1", 300*time.Millisecond, false)
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+	lanp.RequestStarted("addr3")
+
+	err := lanp.UpdateNodes([]*data.NodeData{{Address: "addr1", ShardId: 0}, {Address: "addr4", ShardId: 0}})
+	require.Nil(t, err)
+
+	lanp.mutStats.RLock()
+	_, hasStatsAddr1 := lanp.nodesStats["addr1"]
+	numStatsAfterUpdate := len(lanp.nodesStats)
+	lanp.mutStats.RUnlock()
+	assert.True(t, hasStatsAddr1)
+	assert.Equal(t, 1, numStatsAfterUpdate)
+
+	// a request finished on a node which was already removed is dropped at the next sync state update
+	lanp.RequestFinished("addr3", 100*time.Millisecond, false)
+	lanp.UpdateNodesBasedOnSyncState(lanp.GetAllNodesWithSyncState())
+
+	lanp.mutStats.RLock()
+	_, hasStatsAddr3 := lanp.nodesStats["addr3"]
+	lanp.mutStats.RUnlock()
+	assert.False(t, hasStatsAddr3)
+}
+
+func TestLatencyAwareNodesProvider_LeastOutstandingRequestsStrategy(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", LeastOutstandingRequestsStrategy)
+	lanp.RequestFinished("addr1", 300*time.Millisecond, false)
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+	lanp.RequestFinished("addr3", 200*time.Millisecond, false)
+
+	lanp.RequestStarted("addr2")
+	lanp.RequestStarted("addr2")
+	lanp.RequestStarted("addr3")
+
+	res, err := lanp.GetNodesByShardId(0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr3", "addr2"}, getAddresses(res))
+
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+	lanp.RequestFinished("addr2", 100*time.Millisecond, false)
+
+	res, err = lanp.GetNodesByShardId(0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr2", "addr1", "addr3"}, getAddresses(res))
+}
+
+func TestLatencyAwareNodesProvider_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getThreeObserversInShard0(), "path", EWMALatencyStrategy)
+
+	numCalls := 100
+	done := make(chan struct{}, numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			address := getThreeObserversInShard0()[idx%3].Address
+			switch idx % 4 {
+			case 0:
+				lanp.RequestStarted(address)
+			case 1:
+				lanp.RequestFinished(address, time.Millisecond*time.Duration(idx), idx%2 == 0)
+			case 2:
+				_, _ = lanp.GetNodesByShardId(0)
+			default:
+				_, _ = lanp.GetAllNodes()
+			}
+			done <- struct{}{}
+		}(i)
+	}
+
+	for i := 0; i < numCalls; i++ {
+		<-done
+	}
+}
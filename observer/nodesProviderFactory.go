@@ -23,6 +23,14 @@ func NewNodesProviderFactory(cfg config.Config, configurationFilePath string) (*
 
 // CreateObservers will create and return an object of type NodesProviderHandler based on a flag
 func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error) {
+	selectionStrategy := npf.cfg.GeneralSettings.ObserversSelectionStrategy
+	if selectionStrategy == ConsistentHashingStrategy {
+		return NewConsistentHashingNodesProvider(npf.cfg.Observers, npf.configurationFilePath)
+	}
+	if len(selectionStrategy) > 0 {
+		return NewLatencyAwareNodesProvider(npf.cfg.Observers, npf.configurationFilePath, selectionStrategy)
+	}
+
 	if npf.cfg.GeneralSettings.BalancedObservers {
 		return NewCircularQueueNodesProvider(npf.cfg.Observers, npf.configurationFilePath)
 	}
@@ -32,6 +40,16 @@ func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error)
 
 // CreateFullHistoryNodes will create and return an object of type NodesProviderHandler based on a flag
 func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler, error) {
+	selectionStrategy := npf.cfg.GeneralSettings.FullHistoryNodesSelectionStrategy
+	if len(selectionStrategy) > 0 {
+		nodesProviderHandler, err := NewLatencyAwareNodesProvider(npf.cfg.FullHistoryNodes, npf.configurationFilePath, selectionStrategy)
+		if err != nil {
+			return getDisabledFullHistoryNodesProviderIfNeeded(err)
+		}
+
+		return nodesProviderHandler, nil
+	}
+
 	if npf.cfg.GeneralSettings.BalancedFullHistoryNodes {
 		nodesProviderHandler, err := NewCircularQueueNodesProvider(npf.cfg.FullHistoryNodes, npf.configurationFilePath)
 		if err != nil {
@@ -40,3 +40,54 @@ func TestObserversProviderFactory_CreateShouldReturnCircularQueue(t *testing.T)
 	_, ok := op.(*circularQueueNodesProvider)
 	assert.True(t, ok)
 }
+
+func TestObserversProviderFactory_CreateShouldReturnLatencyAware(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.BalancedObservers = true
+	cfg.GeneralSettings.ObserversSelectionStrategy = EWMALatencyStrategy
+
+	opf, _ := NewNodesProviderFactory(cfg, "path")
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*latencyAwareNodesProvider)
+	assert.True(t, ok)
+}
+
+func TestObserversProviderFactory_CreateShouldReturnConsistentHashing(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.ObserversSelectionStrategy = ConsistentHashingStrategy
+
+	opf, _ := NewNodesProviderFactory(cfg, "path")
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*consistentHashingNodesProvider)
+	assert.True(t, ok)
+}
+
+func TestObserversProviderFactory_CreateFullHistoryNodesWithConsistentHashingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.FullHistoryNodesSelectionStrategy = ConsistentHashingStrategy
+
+	opf, _ := NewNodesProviderFactory(cfg, "path")
+	op, err := opf.CreateFullHistoryNodes()
+	assert.Nil(t, op)
+	assert.Equal(t, ErrInvalidNodesSelectionStrategy, err)
+}
+
+func TestObserversProviderFactory_CreateWithInvalidStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.ObserversSelectionStrategy = "invalid"
+
+	opf, _ := NewNodesProviderFactory(cfg, "path")
+	op, err := opf.CreateObservers()
+	assert.Nil(t, op)
+	assert.Equal(t, ErrInvalidNodesSelectionStrategy, err)
+}
@@ -34,6 +34,11 @@ func (snp *simpleNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeD
 	return snp.getSyncedNodesForShardUnprotected(shardId)
 }
 
+// GetNodesByShardIdForAddress will return the same nodes as GetNodesByShardId, as the nodes are not selected by address
+func (snp *simpleNodesProvider) GetNodesByShardIdForAddress(shardId uint32, _ []byte) ([]*data.NodeData, error) {
+	return snp.GetNodesByShardId(shardId)
+}
+
 // GetAllNodes will return a slice containing all the nodes
 func (snp *simpleNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
 	snp.mutNodes.RLock()
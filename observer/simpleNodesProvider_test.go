@@ -54,6 +54,56 @@ func TestSimpleObserversProvider_GetObserversByShardIdShouldWork(t *testing.T) {
 	assert.Equal(t, 1, len(res))
 }
 
+func TestSimpleObserversProvider_GetObserversByShardIdShouldNotReturnQuarantinedNodes(t *testing.T) {
+	t.Parallel()
+
+	observerA := &data.NodeData{Address: "addressA", ShardId: 0, IsSynced: true}
+	observerB := &data.NodeData{Address: "addressB", ShardId: 1, IsSynced: true}
+	sop, _ := NewSimpleNodesProvider([]*data.NodeData{observerA, observerB}, "path")
+
+	// both observers report another shard than the configured one
+	for _, observer := range sop.GetAllNodesWithSyncState() {
+		observer.IsSynced = false
+		observer.IsQuarantined = true
+	}
+	sop.UpdateNodesBasedOnSyncState(sop.GetAllNodesWithSyncState())
+
+	res, err := sop.GetNodesByShardId(0)
+	assert.Nil(t, res)
+	assert.Equal(t, ErrShardNotAvailable, err)
+
+	res, err = sop.GetNodesByShardId(1)
+	assert.Nil(t, res)
+	assert.Equal(t, ErrShardNotAvailable, err)
+	assert.Empty(t, sop.GetLastSyncedNodes())
+}
+
+func TestSimpleObserversProvider_GetObserversByShardIdShouldNotReturnQuarantinedBackupNode(t *testing.T) {
+	t.Parallel()
+
+	observerA := &data.NodeData{Address: "addressA", ShardId: 0, IsSynced: true}
+	observerB := &data.NodeData{Address: "addressB", ShardId: 1, IsSynced: true}
+	sop, _ := NewSimpleNodesProvider([]*data.NodeData{observerA, observerB}, "path")
+
+	observerA.IsSynced = false
+	sop.UpdateNodesBasedOnSyncState(sop.GetAllNodesWithSyncState())
+
+	res, err := sop.GetNodesByShardId(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{observerA}, res)
+
+	observerA.IsQuarantined = true
+	sop.UpdateNodesBasedOnSyncState(sop.GetAllNodesWithSyncState())
+
+	res, err = sop.GetNodesByShardId(0)
+	assert.Nil(t, res)
+	assert.Equal(t, ErrShardNotAvailable, err)
+
+	res, err = sop.GetNodesByShardId(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{observerB}, res)
+}
+
 func TestSimpleObserversProvider_GetAllObserversShouldWork(t *testing.T) {
 	t.Parallel()
 
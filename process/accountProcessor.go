@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -19,10 +20,20 @@ type AccountProcessor struct {
 	connector       ExternalStorageConnector
 	proc            Processor
 	pubKeyConverter core.PubkeyConverter
+	hedger          RequestsHedgerHandler
+	responseCache   ResponseCacheHandler
+	sessionAffinity SessionAffinityHandler
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
-func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, connector ExternalStorageConnector) (*AccountProcessor, error) {
+func NewAccountProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	connector ExternalStorageConnector,
+	hedger RequestsHedgerHandler,
+	responseCache ResponseCacheHandler,
+	sessionAffinity SessionAffinityHandler,
+) (*AccountProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
@@ -32,11 +43,23 @@ func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, c
 	if check.IfNil(connector) {
 		return nil, ErrNilDatabaseConnector
 	}
+	if check.IfNil(hedger) {
+		return nil, ErrNilRequestsHedger
+	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
+	if check.IfNil(sessionAffinity) {
+		return nil, ErrNilSessionAffinity
+	}
 
 	return &AccountProcessor{
 		proc:            proc,
 		pubKeyConverter: pubKeyConverter,
 		connector:       connector,
+		hedger:          hedger,
+		responseCache:   responseCache,
+		sessionAffinity: sessionAffinity,
 	}, nil
 }
 
@@ -51,40 +74,65 @@ func (ap *AccountProcessor) GetShardIDForAddress(address string) (uint32, error)
 }
 
 // GetAccount resolves the request by sending the request to the right observer and replies back the answer
-func (ap *AccountProcessor) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	url := common.BuildUrlWithAccountQueryOptions(AddressPath+address, options)
+	cacheKey, isCacheable := getAccountQueryCacheKey(url, options)
+	cachedAccount := &data.AccountModel{}
+	if isCacheable && ap.responseCache.Get(ctx, cacheKey, cachedAccount) {
+		return cachedAccount, nil
+	}
+
+	observers, isPinned, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
+	if isPinned {
+		// a hedged request could be answered first by an observer which did not receive the last transactions of the
+		// sender yet, returning a stale nonce
+		ctx = common.WithHedgingDisallowed(ctx)
+	}
 
-	for _, observer := range observers {
-		responseAccount := &data.AccountApiResponse{}
+	response, err := executeOnObservers(ctx, ap.hedger, accountOperation, observers,
+		func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			responseAccount := &data.AccountApiResponse{}
+			_, errGet := ap.proc.CallGetRestEndPoint(ctx, observer.Address, url, responseAccount)
+			if errGet == nil {
+				log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
+				return &responseAccount.Data, true, nil
+			}
 
-		url := common.BuildUrlWithAccountQueryOptions(AddressPath+address, options)
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, url, responseAccount)
-		if err == nil {
-			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
-			return &responseAccount.Data, nil
-		}
+			log.Error("account request", "observer", observer.Address, "address", address, "error", errGet.Error())
+			return nil, false, errGet
+		})
+	if err != nil {
+		return nil, err
+	}
 
-		log.Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
+	account := response.(*data.AccountModel)
+	if isCacheable {
+		ap.responseCache.Put(cacheKey, account)
 	}
 
-	return nil, ErrSendingRequest
+	return account, nil
 }
 
 // GetValueForKey returns the value for the given address and key
-func (ap *AccountProcessor) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
+	apiPath := common.BuildUrlWithAccountQueryOptions(AddressPath+address+"/key/"+key, options)
+	cacheKey, isCacheable := getAccountQueryCacheKey(apiPath, options)
+	cachedValue := ""
+	if isCacheable && ap.responseCache.Get(ctx, cacheKey, &cachedValue) {
+		return cachedValue, nil
+	}
+
+	observers, _, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return "", err
 	}
 
 	for _, observer := range observers {
 		apiResponse := data.AccountKeyValueResponse{}
-		apiPath := AddressPath + address + "/key/" + key
-		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account value for key request",
 				"address", address,
@@ -95,6 +143,10 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 				return "", errors.New(apiResponse.Error)
 			}
 
+			if isCacheable {
+				ap.responseCache.Put(cacheKey, apiResponse.Data.Value)
+			}
+
 			return apiResponse.Data.Value, nil
 		}
 
@@ -105,17 +157,22 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 }
 
 // GetESDTTokenData returns the token data for a token with the given name
-func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	apiPath := common.BuildUrlWithAccountQueryOptions(AddressPath+address+"/esdt/"+key, options)
+	cacheKey, isCacheable := getAccountQueryCacheKey(apiPath, options)
+	cachedResponse := &data.GenericAPIResponse{}
+	if isCacheable && ap.responseCache.Get(ctx, cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
+	observers, _, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, observer := range observers {
 		apiResponse := data.GenericAPIResponse{}
-		apiPath := AddressPath + address + "/esdt/" + key
-		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDT token data",
 				"address", address,
@@ -127,6 +184,10 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 				return nil, errors.New(apiResponse.Error)
 			}
 
+			if isCacheable {
+				ap.responseCache.Put(cacheKey, &apiResponse)
+			}
+
 			return &apiResponse, nil
 		}
 
@@ -137,7 +198,7 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 }
 
 // GetESDTsWithRole returns the token identifiers where the given address has the given role assigned
-func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	observers, err := ap.proc.GetObservers(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -147,7 +208,7 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 		apiResponse := data.GenericAPIResponse{}
 		apiPath := AddressPath + address + "/esdts-with-role/" + role
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDTs with role",
 				"address", address,
@@ -169,7 +230,7 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 }
 
 // GetESDTsRoles returns all the tokens and their roles for a given address
-func (ap *AccountProcessor) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	observers, err := ap.proc.GetObservers(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -179,7 +240,7 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 		apiResponse := data.GenericAPIResponse{}
 		apiPath := AddressPath + address + "/esdts/roles"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, errGet := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, errGet := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if errGet == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDTs roles",
 				"address", address,
@@ -200,7 +261,7 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	//TODO: refactor the entire proxy so endpoints like this which simply forward the response will use a common
 	// component, as described in task EN-9857.
 	observers, err := ap.proc.GetObservers(core.MetachainShardId)
@@ -212,7 +273,7 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 		apiResponse := data.GenericAPIResponse{}
 		apiPath := AddressPath + address + "/registered-nfts/"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get owned NFTs",
 				"address", address,
@@ -233,8 +294,8 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 }
 
 // GetESDTNftTokenData returns the nft token data for a token with the given identifier and nonce
-func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, _, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +305,7 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 		nonceAsString := fmt.Sprintf("%d", nonce)
 		apiPath := AddressPath + address + "/nft/" + key + "/nonce/" + nonceAsString
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDT NFT token data",
 				"address", address,
@@ -266,17 +327,22 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 }
 
 // GetAllESDTTokens returns all the tokens for a given address
-func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	apiPath := common.BuildUrlWithAccountQueryOptions(AddressPath+address+"/esdt", options)
+	cacheKey, isCacheable := getAccountQueryCacheKey(apiPath, options)
+	cachedResponse := &data.GenericAPIResponse{}
+	if isCacheable && ap.responseCache.Get(ctx, cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
+	observers, _, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, observer := range observers {
 		apiResponse := data.GenericAPIResponse{}
-		apiPath := AddressPath + address + "/esdt"
-		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account all ESDT tokens",
 				"address", address,
@@ -287,6 +353,10 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 				return nil, errors.New(apiResponse.Error)
 			}
 
+			if isCacheable {
+				ap.responseCache.Put(cacheKey, &apiResponse)
+			}
+
 			return &apiResponse, nil
 		}
 
@@ -297,8 +367,8 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 }
 
 // GetKeyValuePairs returns all the key-value pairs for a given address
-func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	observers, _, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +377,7 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 		apiResponse := data.GenericAPIResponse{}
 		apiPath := AddressPath + address + "/keys"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get all key-value pairs",
 				"address", address,
@@ -336,23 +406,39 @@ func (ap *AccountProcessor) GetTransactions(address string) ([]data.DatabaseTran
 	return ap.connector.GetTransactionsByAddress(address)
 }
 
-func (ap *AccountProcessor) getObserversForAddress(address string) ([]*data.NodeData, error) {
+// getAccountQueryCacheKey returns the cache key of an account query and true if the query is pinned to a block hash or
+// to a root hash, case in which its response is immutable. The queries pinned to a block nonce are not cached, as the
+// block might not be final yet
+func getAccountQueryCacheKey(apiPath string, options common.AccountQueryOptions) (string, bool) {
+	isPinnedToBlock := len(options.BlockHash) > 0 || len(options.BlockRootHash) > 0
+	if !isPinnedToBlock {
+		return "", false
+	}
+
+	return "account_" + apiPath, true
+}
+
+// getObserversForAddress returns the observers of the address' shard, sorted by session affinity, and true if the first
+// observer is pinned by the session affinity
+func (ap *AccountProcessor) getObserversForAddress(ctx context.Context, address string) ([]*data.NodeData, bool, error) {
 	addressBytes, err := ap.pubKeyConverter.Decode(address)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	shardID, err := ap.proc.ComputeShardId(addressBytes)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	observers, err := ap.proc.GetObservers(shardID)
+	observers, err := ap.proc.GetObserversForAddress(shardID, addressBytes)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return observers, nil
+	sortedObservers, isPinned := ap.sessionAffinity.SortObservers(ctx, address, observers)
+
+	return sortedObservers, isPinned, nil
 }
 
 // GetBaseProcessor returns the base processor
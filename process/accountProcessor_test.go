@@ -1,9 +1,13 @@
 package process_test
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
@@ -23,7 +27,7 @@ import (
 func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector())
+	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector(), &mock.RequestsHedgerStub{}, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -32,7 +36,7 @@ func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, database.NewDisabledElasticSearchConnector())
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, database.NewDisabledElasticSearchConnector(), &mock.RequestsHedgerStub{}, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -41,19 +45,46 @@ func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector())
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector(), &mock.RequestsHedgerStub{}, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{})
 
 	assert.NotNil(t, ap)
 	assert.Nil(t, err)
 }
 
+func TestNewAccountProcessor_NilRequestsHedgerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector(), nil, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{})
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilRequestsHedger, err)
+}
+
+func TestNewAccountProcessor_NilResponseCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector(), &mock.RequestsHedgerStub{}, nil, &mock.SessionAffinityStub{})
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilResponseCache, err)
+}
+
+func TestNewAccountProcessor_NilSessionAffinityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector(), &mock.RequestsHedgerStub{}, &mock.ResponseCacheStub{}, nil)
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilSessionAffinity, err)
+}
+
 //------- GetAccount
 
 func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector())
-	accnt, err := ap.GetAccount("invalid hex number", common.AccountQueryOptions{})
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector(), &mock.RequestsHedgerStub{}, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{})
+	accnt, err := ap.GetAccount(context.Background(), "invalid hex number", common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.NotNil(t, err)
@@ -72,9 +103,12 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -95,9 +129,12 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -124,14 +161,99 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, process.ErrSendingRequest, err)
 }
 
+func createHedgingTestAccountProcessor(
+	slowObserverDelay time.Duration,
+	numCalls *uint32,
+	sessionAffinity process.SessionAffinityHandler,
+) *process.AccountProcessor {
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "slow", ShardId: 0},
+					{Address: "fast", ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				atomic.AddUint32(numCalls, 1)
+				if address == "slow" {
+					time.Sleep(slowObserverDelay)
+				}
+
+				value.(*data.AccountApiResponse).Data.Account.Address = address
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{
+			GetHedgingDelayCalled: func(operation string) (time.Duration, bool) {
+				return 10 * time.Millisecond, true
+			},
+		},
+		&mock.ResponseCacheStub{},
+		sessionAffinity,
+	)
+
+	return ap
+}
+
+func TestAccountProcessor_GetAccountWithHedgingAllowedShouldReturnFastestResponse(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	ap := createHedgingTestAccountProcessor(time.Second, &numCalls, &mock.SessionAffinityStub{})
+
+	startTime := time.Now()
+	accnt, err := ap.GetAccount(common.WithHedgingAllowed(context.Background()), "DEADBEEF", common.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "fast", accnt.Account.Address)
+	assert.True(t, time.Since(startTime) < time.Second)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+}
+
+func TestAccountProcessor_GetAccountWithoutHedgingAllowedShouldWaitForFirstObserver(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	ap := createHedgingTestAccountProcessor(100*time.Millisecond, &numCalls, &mock.SessionAffinityStub{})
+
+	accnt, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "slow", accnt.Account.Address)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestAccountProcessor_GetAccountFromPinnedObserverShouldNotHedge(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	ap := createHedgingTestAccountProcessor(100*time.Millisecond, &numCalls, &mock.SessionAffinityStub{
+		SortObserversCalled: func(ctx context.Context, address string, observers []*data.NodeData) ([]*data.NodeData, bool) {
+			return observers, true
+		},
+	})
+
+	accnt, err := ap.GetAccount(common.WithHedgingAllowed(context.Background()), "DEADBEEF", common.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "slow", accnt.Account.Address)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
 func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t *testing.T) {
 	t.Parallel()
 
@@ -165,14 +287,59 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 	address := "DEADBEEF"
-	accountModel, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accountModel, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Equal(t, respondedAccount.Account, accountModel.Account)
 	assert.Nil(t, err)
 }
 
+func TestAccountProcessor_GetAccountShouldUseTheObserversSortedBySessionAffinity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	address := "DEADBEEF"
+	pinnedObserver := &data.NodeData{Address: "address2", ShardId: 0}
+	queriedObservers := make([]string, 0)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "address1", ShardId: 0},
+					pinnedObserver,
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				queriedObservers = append(queriedObservers, address)
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{
+			SortObserversCalled: func(providedCtx context.Context, providedAddress string, observers []*data.NodeData) ([]*data.NodeData, bool) {
+				assert.Equal(t, ctx, providedCtx)
+				assert.Equal(t, address, providedAddress)
+
+				return []*data.NodeData{pinnedObserver, observers[0]}, true
+			},
+		},
+	)
+
+	_, err := ap.GetAccount(ctx, address, common.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"address2"}, queriedObservers)
+}
+
 func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -195,15 +362,75 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, expectedValue, value)
 }
 
+func TestAccountProcessor_GetValueForAKeyShouldCacheOnlyHistoricalQueries(t *testing.T) {
+	t.Parallel()
+
+	numObserverCalls := 0
+	cachedValues := make(map[string]string)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "address", ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				numObserverCalls++
+				valRespond := value.(*data.AccountKeyValueResponse)
+				valRespond.Data.Value = "dummyValue"
+				return 0, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{
+			GetCalled: func(key string, value interface{}) bool {
+				cachedValue, found := cachedValues[key]
+				if found {
+					*value.(*string) = cachedValue
+				}
+				return found
+			},
+			PutCalled: func(key string, value interface{}) {
+				cachedValues[key] = value.(string)
+			},
+		},
+		&mock.SessionAffinityStub{},
+	)
+
+	addr := "DEADBEEF"
+	_, _ = ap.GetValueForKey(context.Background(), addr, "key", common.AccountQueryOptions{})
+	_, _ = ap.GetValueForKey(context.Background(), addr, "key", common.AccountQueryOptions{})
+	assert.Equal(t, 2, numObserverCalls)
+	assert.Empty(t, cachedValues)
+
+	options := common.AccountQueryOptions{BlockHash: []byte("hash")}
+	value, err := ap.GetValueForKey(context.Background(), addr, "key", options)
+	assert.Nil(t, err)
+	assert.Equal(t, "dummyValue", value)
+	value, err = ap.GetValueForKey(context.Background(), addr, "key", options)
+	assert.Nil(t, err)
+	assert.Equal(t, "dummyValue", value)
+	assert.Equal(t, 3, numObserverCalls)
+	assert.Len(t, cachedValues, 1)
+}
+
 func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -224,11 +451,14 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Equal(t, "", value)
 	assert.Equal(t, process.ErrSendingRequest, err)
 }
@@ -258,6 +488,9 @@ func TestAccountProcessor_GetShardIForAddressShouldWork(t *testing.T) {
 		},
 		bech32C,
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress(addressShard1)
@@ -281,6 +514,9 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress("aaaa")
@@ -299,6 +535,9 @@ func TestAccountProcessor_GetTransactions(t *testing.T) {
 		&mock.ProcessorStub{},
 		converter,
 		&mock.ElasticSearchConnectorMock{},
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
 	_, err := ap.GetTransactions("invalidAddress")
@@ -323,9 +562,12 @@ func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		&mock.ElasticSearchConnectorMock{},
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -351,9 +593,12 @@ func TestAccountProcessor_GetESDTsWithRoleApiCallFails(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		&mock.ElasticSearchConnectorMock{},
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -380,9 +625,12 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsWithRole(address, "role", common.AccountQueryOptions{})
+	response, err := ap.GetESDTsWithRole(context.Background(), address, "role", common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -399,9 +647,12 @@ func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		&mock.ElasticSearchConnectorMock{},
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -427,9 +678,12 @@ func TestAccountProcessor_GetESDTsRolesApiCallFails(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		&mock.ElasticSearchConnectorMock{},
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -456,9 +710,12 @@ func TestAccountProcessor_GetESDTsRolesShouldWork(t *testing.T) {
 		},
 		&mock.PubKeyConverterMock{},
 		database.NewDisabledElasticSearchConnector(),
+		&mock.RequestsHedgerStub{},
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsRoles(address, common.AccountQueryOptions{})
+	response, err := ap.GetESDTsRoles(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -0,0 +1,47 @@
+package process
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ActionsProcessor handles the actions which reload the observers and the configs while the proxy is running
+type ActionsProcessor struct {
+	nodesReloader  NodesReloaderHandler
+	configReloader ConfigReloaderHandler
+}
+
+// NewActionsProcessor creates a new instance of ActionsProcessor
+func NewActionsProcessor(nodesReloader NodesReloaderHandler, configReloader ConfigReloaderHandler) (*ActionsProcessor, error) {
+	if check.IfNil(nodesReloader) {
+		return nil, ErrNilNodesReloader
+	}
+	if check.IfNil(configReloader) {
+		return nil, ErrNilConfigReloader
+	}
+
+	return &ActionsProcessor{
+		nodesReloader:  nodesReloader,
+		configReloader: configReloader,
+	}, nil
+}
+
+// ReloadObservers will reload the observers from the config file
+func (ap *ActionsProcessor) ReloadObservers() data.NodesReloadResponse {
+	return ap.nodesReloader.ReloadObservers()
+}
+
+// ReloadFullHistoryObservers will reload the full history observers from the config file
+func (ap *ActionsProcessor) ReloadFullHistoryObservers() data.NodesReloadResponse {
+	return ap.nodesReloader.ReloadFullHistoryObservers()
+}
+
+// ReloadConfig will reload the configs and apply them to the running components
+func (ap *ActionsProcessor) ReloadConfig() data.NodesReloadResponse {
+	return ap.configReloader.ReloadConfig()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ap *ActionsProcessor) IsInterfaceNil() bool {
+	return ap == nil
+}
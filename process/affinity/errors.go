@@ -0,0 +1,9 @@
+package affinity
+
+import "errors"
+
+// ErrInvalidWindowDuration signals that an invalid affinity window duration has been provided
+var ErrInvalidWindowDuration = errors.New("invalid affinity window duration")
+
+// ErrInvalidIdentificationMethod signals that an unknown method of identifying the clients has been provided
+var ErrInvalidIdentificationMethod = errors.New("invalid identification method")
@@ -0,0 +1,16 @@
+package affinity
+
+import "time"
+
+// SetGetTimeFunc -
+func (sa *sessionAffinity) SetGetTimeFunc(getTimeFunc func() time.Time) {
+	sa.getTimeFunc = getTimeFunc
+}
+
+// NumEntries -
+func (sa *sessionAffinity) NumEntries() int {
+	sa.mutEntries.Lock()
+	defer sa.mutEntries.Unlock()
+
+	return len(sa.entries)
+}
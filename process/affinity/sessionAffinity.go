@@ -0,0 +1,162 @@
+package affinity
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/affinity")
+
+const (
+	// AddressIdentification makes the queries be routed for each sender address
+	AddressIdentification = "address"
+
+	// SessionIdentification makes the queries be routed for each session ID, as supplied by the client in the session
+	// header. The requests without a session ID are not routed
+	SessionIdentification = "session"
+)
+
+// ArgsSessionAffinity holds the arguments needed for creating a new session affinity component
+type ArgsSessionAffinity struct {
+	WindowDuration time.Duration
+	IdentifyBy     string
+}
+
+type affinityEntry struct {
+	observerAddress string
+	expiryTime      time.Time
+}
+
+// sessionAffinity remembers which observer accepted the last transaction of a sender (or of a session) and moves
+// that observer in front of the others for the sender's queries made during the affinity window, so the client can
+// read its own writes (e.g. the nonce) even if the other observers did not receive the transaction yet
+type sessionAffinity struct {
+	windowDuration time.Duration
+	identifyBy     string
+	getTimeFunc    func() time.Time
+
+	mutEntries      sync.Mutex
+	entries         map[string]affinityEntry
+	lastCleanupTime time.Time
+}
+
+// NewSessionAffinity returns a new instance of sessionAffinity
+func NewSessionAffinity(args ArgsSessionAffinity) (*sessionAffinity, error) {
+	if args.WindowDuration <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWindowDuration, args.WindowDuration)
+	}
+	if args.IdentifyBy != AddressIdentification && args.IdentifyBy != SessionIdentification {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIdentificationMethod, args.IdentifyBy)
+	}
+
+	return &sessionAffinity{
+		windowDuration: args.WindowDuration,
+		identifyBy:     args.IdentifyBy,
+		getTimeFunc:    time.Now,
+		entries:        make(map[string]affinityEntry),
+	}, nil
+}
+
+// RecordObserver remembers that the provided observer accepted a transaction of the provided sender
+func (sa *sessionAffinity) RecordObserver(ctx context.Context, address string, observer *data.NodeData) {
+	if observer == nil {
+		return
+	}
+
+	key, ok := sa.computeKey(ctx, address, observer.ShardId)
+	if !ok {
+		return
+	}
+
+	now := sa.getTimeFunc()
+
+	sa.mutEntries.Lock()
+	defer sa.mutEntries.Unlock()
+
+	sa.removeExpiredEntries(now)
+	sa.entries[key] = affinityEntry{
+		observerAddress: observer.Address,
+		expiryTime:      now.Add(sa.windowDuration),
+	}
+
+	log.Trace("recorded observer affinity", "key", key, "observer", observer.Address)
+}
+
+// SortObservers returns the provided observers of the address' shard, having the observer which accepted the last
+// transaction of the sender in front, if it is still in the list and the affinity window did not pass. Otherwise,
+// the observers are returned in the same order. The returned flag is true if the observers were sorted by affinity,
+// case in which only the first observer is known to hold the latest writes of the sender
+func (sa *sessionAffinity) SortObservers(ctx context.Context, address string, observers []*data.NodeData) ([]*data.NodeData, bool) {
+	if len(observers) < 2 {
+		return observers, false
+	}
+
+	key, ok := sa.computeKey(ctx, address, observers[0].ShardId)
+	if !ok {
+		return observers, false
+	}
+
+	sa.mutEntries.Lock()
+	entry, found := sa.entries[key]
+	sa.mutEntries.Unlock()
+
+	if !found || !sa.getTimeFunc().Before(entry.expiryTime) {
+		return observers, false
+	}
+
+	for idx, observer := range observers {
+		if observer.Address != entry.observerAddress {
+			continue
+		}
+		if idx == 0 {
+			return observers, true
+		}
+
+		sortedObservers := make([]*data.NodeData, 0, len(observers))
+		sortedObservers = append(sortedObservers, observer)
+		sortedObservers = append(sortedObservers, observers[:idx]...)
+		sortedObservers = append(sortedObservers, observers[idx+1:]...)
+
+		return sortedObservers, true
+	}
+
+	return observers, false
+}
+
+func (sa *sessionAffinity) computeKey(ctx context.Context, address string, shardID uint32) (string, bool) {
+	if sa.identifyBy == AddressIdentification {
+		return address, len(address) > 0
+	}
+
+	sessionID := common.GetSessionID(ctx)
+	if len(sessionID) == 0 {
+		return "", false
+	}
+
+	return fmt.Sprintf("session:%s/%d", sessionID, shardID), true
+}
+
+// removeExpiredEntries removes the expired entries, at most once per affinity window
+func (sa *sessionAffinity) removeExpiredEntries(now time.Time) {
+	if now.Sub(sa.lastCleanupTime) < sa.windowDuration {
+		return
+	}
+
+	for key, entry := range sa.entries {
+		if !now.Before(entry.expiryTime) {
+			delete(sa.entries, key)
+		}
+	}
+	sa.lastCleanupTime = now
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sa *sessionAffinity) IsInterfaceNil() bool {
+	return sa == nil
+}
@@ -0,0 +1,167 @@
+package affinity_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/affinity"
+	"github.com/stretchr/testify/require"
+)
+
+var observers = []*data.NodeData{
+	{Address: "observer0", ShardId: 0},
+	{Address: "observer1", ShardId: 0},
+	{Address: "observer2", ShardId: 0},
+}
+
+func getAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
+
+func getSortedAddresses(nodes []*data.NodeData, _ bool) []string {
+	return getAddresses(nodes)
+}
+
+func TestNewSessionAffinity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid window duration should err", func(t *testing.T) {
+		t.Parallel()
+
+		sa, err := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+			IdentifyBy: affinity.AddressIdentification,
+		})
+		require.True(t, errors.Is(err, affinity.ErrInvalidWindowDuration))
+		require.True(t, check.IfNil(sa))
+	})
+	t.Run("invalid identification method should err", func(t *testing.T) {
+		t.Parallel()
+
+		sa, err := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+			WindowDuration: time.Minute,
+			IdentifyBy:     "cookie",
+		})
+		require.True(t, errors.Is(err, affinity.ErrInvalidIdentificationMethod))
+		require.True(t, check.IfNil(sa))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sa, err := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+			WindowDuration: time.Minute,
+			IdentifyBy:     affinity.SessionIdentification,
+		})
+		require.NoError(t, err)
+		require.False(t, check.IfNil(sa))
+	})
+}
+
+func TestSessionAffinity_SortObserversByAddress(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+		WindowDuration: time.Minute,
+		IdentifyBy:     affinity.AddressIdentification,
+	})
+	currentTime := time.Unix(1000, 0)
+	sa.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	ctx := context.Background()
+	require.Equal(t, []string{"observer0", "observer1", "observer2"}, getSortedAddresses(sa.SortObservers(ctx, "alice", observers)))
+
+	sa.RecordObserver(ctx, "alice", observers[2])
+	require.Equal(t, []string{"observer2", "observer0", "observer1"}, getSortedAddresses(sa.SortObservers(ctx, "alice", observers)))
+	require.Equal(t, []string{"observer0", "observer1", "observer2"}, getSortedAddresses(sa.SortObservers(ctx, "bob", observers)))
+	require.Equal(t, []string{"observer0", "observer1", "observer2"}, getAddresses(observers))
+
+	// the pinned observer is no longer synced
+	require.Equal(t, []string{"observer0", "observer1"}, getSortedAddresses(sa.SortObservers(ctx, "alice", observers[:2])))
+
+	currentTime = currentTime.Add(time.Minute)
+	require.Equal(t, []string{"observer0", "observer1", "observer2"}, getSortedAddresses(sa.SortObservers(ctx, "alice", observers)))
+}
+
+func TestSessionAffinity_SortObserversShouldTellIfTheFirstObserverIsPinned(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+		WindowDuration: time.Minute,
+		IdentifyBy:     affinity.AddressIdentification,
+	})
+
+	ctx := context.Background()
+	_, isPinned := sa.SortObservers(ctx, "alice", observers)
+	require.False(t, isPinned)
+
+	sa.RecordObserver(ctx, "alice", observers[0])
+	_, isPinned = sa.SortObservers(ctx, "alice", observers)
+	require.True(t, isPinned)
+
+	sa.RecordObserver(ctx, "alice", observers[1])
+	_, isPinned = sa.SortObservers(ctx, "alice", observers)
+	require.True(t, isPinned)
+
+	_, isPinned = sa.SortObservers(ctx, "alice", []*data.NodeData{observers[0], observers[2]})
+	require.False(t, isPinned)
+}
+
+func TestSessionAffinity_SortObserversBySession(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+		WindowDuration: time.Minute,
+		IdentifyBy:     affinity.SessionIdentification,
+	})
+
+	sessionCtx := common.WithSessionID(context.Background(), "session-1")
+	otherSessionCtx := common.WithSessionID(context.Background(), "session-2")
+
+	sa.RecordObserver(context.Background(), "alice", observers[1])
+	require.Equal(t, 0, sa.NumEntries())
+
+	sa.RecordObserver(sessionCtx, "alice", observers[1])
+	require.Equal(t, []string{"observer1", "observer0", "observer2"}, getSortedAddresses(sa.SortObservers(sessionCtx, "bob", observers)))
+	require.Equal(t, []string{"observer0", "observer1", "observer2"}, getSortedAddresses(sa.SortObservers(otherSessionCtx, "alice", observers)))
+	require.Equal(t, []string{"observer0", "observer1", "observer2"}, getSortedAddresses(sa.SortObservers(context.Background(), "alice", observers)))
+
+	otherShardObservers := []*data.NodeData{
+		{Address: "observer3", ShardId: 1},
+		{Address: "observer4", ShardId: 1},
+	}
+	require.Equal(t, []string{"observer3", "observer4"}, getSortedAddresses(sa.SortObservers(sessionCtx, "bob", otherShardObservers)))
+}
+
+func TestSessionAffinity_RecordObserverShouldRemoveExpiredEntries(t *testing.T) {
+	t.Parallel()
+
+	sa, _ := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+		WindowDuration: time.Minute,
+		IdentifyBy:     affinity.AddressIdentification,
+	})
+	currentTime := time.Unix(1000, 0)
+	sa.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	ctx := context.Background()
+	sa.RecordObserver(ctx, "alice", observers[0])
+	sa.RecordObserver(ctx, "bob", observers[1])
+	require.Equal(t, 2, sa.NumEntries())
+
+	currentTime = currentTime.Add(time.Minute)
+	sa.RecordObserver(ctx, "carol", observers[2])
+	require.Equal(t, 1, sa.NumEntries())
+	require.Equal(t, []string{"observer2", "observer0", "observer1"}, getSortedAddresses(sa.SortObservers(ctx, "carol", observers)))
+}
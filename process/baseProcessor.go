@@ -9,7 +9,7 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
-	"strconv"
+	"sort"
 	"sync"
 	"time"
 
@@ -17,43 +17,66 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	proxyData "github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/nodeshealth"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/syncstate"
 )
 
 var log = logger.GetOrCreate("process")
-var mutHttpClient sync.RWMutex
 
 const (
-	nodeSyncedNonceDifferenceThreshold = 10
-	stepDelayForCheckingNodesSyncState = 1 * time.Minute
-	timeoutDurationForNodeStatus       = 2 * time.Second
+	timeoutDurationForNodeStatus    = 2 * time.Second
+	numLatencySamplesForNodesHealth = 100
+	defaultMaxParallelSyncChecks    = 10
 )
 
 // BaseProcessor represents an implementation of CoreProcessor that helps
 // processing requests
 type BaseProcessor struct {
-	mutState                       sync.RWMutex
-	shardCoordinator               sharding.Coordinator
-	observersProvider              observer.NodesProviderHandler
-	fullHistoryNodesProvider       observer.NodesProviderHandler
-	pubKeyConverter                core.PubkeyConverter
-	shardIDs                       []uint32
-	nodeStatusFetcher              func(url string) (*proxyData.NodeStatusAPIResponse, int, error)
-	chanTriggerNodesState          chan struct{}
-	delayForCheckingNodesSyncState time.Duration
-	cancelFunc                     func()
+	mutState                 sync.RWMutex
+	shardCoordinator         sharding.Coordinator
+	observersProvider        observer.NodesProviderHandler
+	fullHistoryNodesProvider observer.NodesProviderHandler
+	pubKeyConverter          core.PubkeyConverter
+	shardIDs                 []uint32
+	nodeStatusFetcher        func(url string) (*proxyData.NodeStatusAPIResponse, int, error)
+	chanTriggerNodesState    chan struct{}
+	syncStateChecker         NodesSyncStateCheckerHandler
+	maxParallelSyncChecks    int
+	lastNodesMetrics         map[string]*proxyData.NodeWithMetrics
+	cancelFunc               func()
+	requestsTrackers         []observer.NodesRequestsTracker
+	circuitBreaker           CircuitBreakerHandler
+	requestsCoalescer        RequestsCoalescerHandler
+	nodesSanityChecker       NodesSanityCheckerHandler
+	nodesHealthTracker       NodesHealthTrackerHandler
 
 	httpClient *http.Client
 }
 
+// getEndPointResponse holds the outcome of a GET request towards a node, before being decoded, so it can be shared
+// between the coalesced callers
+type getEndPointResponse struct {
+	statusCode        int
+	body              []byte
+	err               error
+	isAbortedByCaller bool
+}
+
 // NewBaseProcessor creates a new instance of BaseProcessor struct
 func NewBaseProcessor(
 	requestTimeoutSec int,
+	httpClientConfig config.HttpClientConfig,
+	syncCheckConfig config.NodesSyncCheckConfig,
 	shardCoord sharding.Coordinator,
 	observersProvider observer.NodesProviderHandler,
 	fullHistoryNodesProvider observer.NodesProviderHandler,
 	pubKeyConverter core.PubkeyConverter,
+	circuitBreaker CircuitBreakerHandler,
+	requestsCoalescer RequestsCoalescerHandler,
+	nodesSanityChecker NodesSanityCheckerHandler,
 ) (*BaseProcessor, error) {
 	if check.IfNil(shardCoord) {
 		return nil, ErrNilShardCoordinator
@@ -70,21 +93,54 @@ func NewBaseProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(circuitBreaker) {
+		return nil, ErrNilCircuitBreaker
+	}
+	if check.IfNil(requestsCoalescer) {
+		return nil, ErrNilRequestsCoalescer
+	}
+	if check.IfNil(nodesSanityChecker) {
+		return nil, ErrNilNodesSanityChecker
+	}
+
+	httpClient, err := newHttpClient(requestTimeoutSec, httpClientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	shardIDs := computeShardIDs(shardCoord)
+	syncStateChecker, err := syncstate.CreateNodesSyncStateChecker(syncCheckConfig, shardIDs)
+	if err != nil {
+		return nil, err
+	}
+	maxParallelSyncChecks := syncCheckConfig.MaxParallelChecks
+	if maxParallelSyncChecks <= 0 {
+		maxParallelSyncChecks = defaultMaxParallelSyncChecks
+	}
 
-	httpClient := http.DefaultClient
-	mutHttpClient.Lock()
-	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
-	mutHttpClient.Unlock()
+	nodesHealthTracker, err := nodeshealth.NewNodesHealthTracker(numLatencySamplesForNodesHealth)
+	if err != nil {
+		return nil, err
+	}
+	requestsTrackers := extractRequestsTrackers(observersProvider, fullHistoryNodesProvider)
+	requestsTrackers = append(requestsTrackers, nodesHealthTracker)
 
 	bp := &BaseProcessor{
-		shardCoordinator:               shardCoord,
-		observersProvider:              observersProvider,
-		fullHistoryNodesProvider:       fullHistoryNodesProvider,
-		httpClient:                     httpClient,
-		pubKeyConverter:                pubKeyConverter,
-		shardIDs:                       computeShardIDs(shardCoord),
-		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
-		chanTriggerNodesState:          make(chan struct{}),
+		shardCoordinator:         shardCoord,
+		observersProvider:        observersProvider,
+		fullHistoryNodesProvider: fullHistoryNodesProvider,
+		httpClient:               httpClient,
+		pubKeyConverter:          pubKeyConverter,
+		shardIDs:                 shardIDs,
+		syncStateChecker:         syncStateChecker,
+		maxParallelSyncChecks:    maxParallelSyncChecks,
+		lastNodesMetrics:         make(map[string]*proxyData.NodeWithMetrics),
+		chanTriggerNodesState:    make(chan struct{}),
+		requestsTrackers:         requestsTrackers,
+		circuitBreaker:           circuitBreaker,
+		requestsCoalescer:        requestsCoalescer,
+		nodesSanityChecker:       nodesSanityChecker,
+		nodesHealthTracker:       nodesHealthTracker,
 	}
 	bp.nodeStatusFetcher = bp.getNodeStatusResponseFromAPI
 
@@ -101,6 +157,10 @@ func (bp *BaseProcessor) StartNodesSyncStateChecks() {
 	var ctx context.Context
 	ctx, bp.cancelFunc = context.WithCancel(context.Background())
 
+	// the first check is done before serving any request, so the misconfigured nodes are never used
+	bp.syncStateChecker.ScheduleNextChecks(bp.shardIDs)
+	bp.updateNodesWithSync(bp.shardIDs)
+
 	go bp.handleOutOfSyncNodes(ctx)
 }
 
@@ -121,32 +181,58 @@ func (bp *BaseProcessor) ReloadFullHistoryObservers() proxyData.NodesReloadRespo
 
 // GetObservers returns the registered observers on a shard
 func (bp *BaseProcessor) GetObservers(shardID uint32) ([]*proxyData.NodeData, error) {
-	return bp.observersProvider.GetNodesByShardId(shardID)
+	return bp.removeQuarantinedNodes(bp.observersProvider.GetNodesByShardId(shardID))
+}
+
+// GetObserversForAddress returns the registered observers on a shard, ordered for the requests made for the provided
+// address, if the observers provider selects the observers by address
+func (bp *BaseProcessor) GetObserversForAddress(shardID uint32, address []byte) ([]*proxyData.NodeData, error) {
+	return bp.removeQuarantinedNodes(bp.observersProvider.GetNodesByShardIdForAddress(shardID, address))
 }
 
 // GetAllObservers will return all the observers, regardless of shard ID
 func (bp *BaseProcessor) GetAllObservers() ([]*proxyData.NodeData, error) {
-	return bp.observersProvider.GetAllNodes()
+	return bp.removeQuarantinedNodes(bp.observersProvider.GetAllNodes())
 }
 
 // GetObserversOnePerShard will return a slice containing an observer for each shard
 func (bp *BaseProcessor) GetObserversOnePerShard() ([]*proxyData.NodeData, error) {
-	return bp.getNodesOnePerShard(bp.observersProvider.GetNodesByShardId)
+	return bp.getNodesOnePerShard(bp.GetObservers)
 }
 
 // GetFullHistoryNodes returns the registered full history nodes on a shard
 func (bp *BaseProcessor) GetFullHistoryNodes(shardID uint32) ([]*proxyData.NodeData, error) {
-	return bp.fullHistoryNodesProvider.GetNodesByShardId(shardID)
+	return bp.removeQuarantinedNodes(bp.fullHistoryNodesProvider.GetNodesByShardId(shardID))
 }
 
 // GetAllFullHistoryNodes will return all the full history nodes, regardless of shard ID
 func (bp *BaseProcessor) GetAllFullHistoryNodes() ([]*proxyData.NodeData, error) {
-	return bp.fullHistoryNodesProvider.GetAllNodes()
+	return bp.removeQuarantinedNodes(bp.fullHistoryNodesProvider.GetAllNodes())
 }
 
 // GetFullHistoryNodesOnePerShard will return a slice containing a full history node for each shard
 func (bp *BaseProcessor) GetFullHistoryNodesOnePerShard() ([]*proxyData.NodeData, error) {
-	return bp.getNodesOnePerShard(bp.fullHistoryNodesProvider.GetNodesByShardId)
+	return bp.getNodesOnePerShard(bp.GetFullHistoryNodes)
+}
+
+// removeQuarantinedNodes removes the quarantined nodes, as the nodes providers learn about the quarantine state of the
+// nodes only on the sync state checks, so a reloaded node is not known as quarantined until its shard is checked again
+func (bp *BaseProcessor) removeQuarantinedNodes(nodes []*proxyData.NodeData, err error) ([]*proxyData.NodeData, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	usableNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if !bp.nodesSanityChecker.IsQuarantined(node) {
+			usableNodes = append(usableNodes, node)
+		}
+	}
+	if len(usableNodes) == 0 && len(nodes) > 0 {
+		return nil, ErrAllNodesQuarantined
+	}
+
+	return usableNodes, nil
 }
 
 func (bp *BaseProcessor) getNodesOnePerShard(
@@ -184,31 +270,65 @@ func (bp *BaseProcessor) ComputeShardId(addressBuff []byte) (uint32, error) {
 	return bp.shardCoordinator.ComputeId(addressBuff), nil
 }
 
-// CallGetRestEndPoint calls an external end point (sends a request on a node)
+// CallGetRestEndPoint calls an external end point (sends a request on a node). The request is aborted as soon as the
+// provided context is done. Identical requests towards the same node which are in progress at the same time are
+// coalesced into a single call, each caller decoding the shared response on its own
 func (bp *BaseProcessor) CallGetRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	value interface{},
 ) (int, error) {
+	result, isShared, err := bp.requestsCoalescer.CoalesceRequest(ctx, address, path, func() (interface{}, error) {
+		return bp.getEndPointResponse(ctx, address, path), nil
+	})
+	if err != nil {
+		// the caller stopped waiting for the shared request
+		return bp.getStatusCodeForRequestError(ctx, address, err), err
+	}
 
-	req, err := http.NewRequest("GET", address+path, nil)
+	response, ok := result.(*getEndPointResponse)
+	if !ok {
+		return http.StatusInternalServerError, ErrSendingRequest
+	}
+	if isShared && response.isAbortedByCaller && ctx.Err() == nil {
+		// the shared request was aborted by the caller which started it, so it is retried on behalf of this caller
+		response = bp.getEndPointResponse(ctx, address, path)
+	}
+	if response.err != nil {
+		return response.statusCode, response.err
+	}
+
+	err = json.Unmarshal(response.body, value)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
+	if response.statusCode == http.StatusOK { // everything ok, return status ok and the expected response
+		return response.statusCode, nil
+	}
+
+	// status response not ok, return the error
+	return response.statusCode, errors.New(string(response.body))
+}
+
+func (bp *BaseProcessor) getEndPointResponse(ctx context.Context, address string, path string) *getEndPointResponse {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address+path, nil)
+	if err != nil {
+		return &getEndPointResponse{statusCode: http.StatusInternalServerError, err: err}
+	}
+
 	userAgent := "Elrond Proxy / 1.0.0 <Requesting data from nodes>"
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, err := bp.doRequest(ctx, address, req)
 	if err != nil {
-		if isTimeoutError(err) {
-			bp.triggerNodesSyncCheck(address)
-			return http.StatusRequestTimeout, err
+		return &getEndPointResponse{
+			statusCode:        bp.getStatusCodeForRequestError(ctx, address, err),
+			err:               err,
+			isAbortedByCaller: ctx.Err() != nil,
 		}
-
-		bp.triggerNodesSyncCheck(address)
-		return http.StatusNotFound, err
 	}
 
 	defer func() {
@@ -220,25 +340,23 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 
 	responseBodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return http.StatusInternalServerError, err
-	}
-
-	err = json.Unmarshal(responseBodyBytes, value)
-	if err != nil {
-		return http.StatusInternalServerError, err
+		return &getEndPointResponse{
+			statusCode:        http.StatusInternalServerError,
+			err:               err,
+			isAbortedByCaller: ctx.Err() != nil,
+		}
 	}
 
-	responseStatusCode := resp.StatusCode
-	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
-		return responseStatusCode, nil
+	return &getEndPointResponse{
+		statusCode: resp.StatusCode,
+		body:       responseBodyBytes,
 	}
-
-	// status response not ok, return the error
-	return responseStatusCode, errors.New(string(responseBodyBytes))
 }
 
-// CallPostRestEndPoint calls an external end point (sends a request on a node)
+// CallPostRestEndPoint calls an external end point (sends a request on a node). The request is aborted as soon as the
+// provided context is done
 func (bp *BaseProcessor) CallPostRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	data interface{},
@@ -250,7 +368,7 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 		return http.StatusInternalServerError, err
 	}
 
-	req, err := http.NewRequest("POST", address+path, bytes.NewReader(buff))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address+path, bytes.NewReader(buff))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -260,15 +378,9 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, err := bp.doRequest(ctx, address, req)
 	if err != nil {
-		if isTimeoutError(err) {
-			bp.triggerNodesSyncCheck(address)
-			return http.StatusRequestTimeout, err
-		}
-
-		bp.triggerNodesSyncCheck(address)
-		return http.StatusNotFound, err
+		return bp.getStatusCodeForRequestError(ctx, address, err), err
 	}
 
 	defer func() {
@@ -298,6 +410,77 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	return responseStatusCode, errors.New(genericApiResponse.Error)
 }
 
+func (bp *BaseProcessor) doRequest(ctx context.Context, address string, req *http.Request) (*http.Response, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if !bp.circuitBreaker.IsRequestAllowed(address) {
+		log.Trace("request skipped", "address", address, "error", ErrNodeCircuitOpen)
+		return nil, fmt.Errorf("%w: %s", ErrNodeCircuitOpen, address)
+	}
+
+	bp.notifyRequestStarted(address)
+	startTime := time.Now()
+
+	resp, err := bp.httpClient.Do(req)
+	isAbortedByCaller := err != nil && ctx.Err() != nil
+	isServerError := err == nil && resp.StatusCode >= http.StatusInternalServerError
+	bp.notifyRequestFinished(address, time.Since(startTime), (err != nil && !isAbortedByCaller) || isServerError)
+
+	if isAbortedByCaller {
+		return nil, err
+	}
+	if err != nil {
+		bp.circuitBreaker.RecordFailure(address)
+		return nil, err
+	}
+
+	bp.circuitBreaker.RecordSuccess(address)
+
+	return resp, nil
+}
+
+func (bp *BaseProcessor) getStatusCodeForRequestError(ctx context.Context, address string, err error) int {
+	if errors.Is(err, ErrNodeCircuitOpen) {
+		return http.StatusServiceUnavailable
+	}
+	if ctx.Err() != nil {
+		// the request was canceled by the caller or its deadline was exceeded, so the node is not to blame
+		return http.StatusRequestTimeout
+	}
+
+	bp.triggerNodesSyncCheck(address)
+	if isTimeoutError(err) {
+		return http.StatusRequestTimeout
+	}
+
+	return http.StatusNotFound
+}
+
+func (bp *BaseProcessor) notifyRequestStarted(address string) {
+	for _, tracker := range bp.requestsTrackers {
+		tracker.RequestStarted(address)
+	}
+}
+
+func (bp *BaseProcessor) notifyRequestFinished(address string, duration time.Duration, withError bool) {
+	for _, tracker := range bp.requestsTrackers {
+		tracker.RequestFinished(address, duration, withError)
+	}
+}
+
+func extractRequestsTrackers(providers ...observer.NodesProviderHandler) []observer.NodesRequestsTracker {
+	trackers := make([]observer.NodesRequestsTracker, 0, len(providers))
+	for _, provider := range providers {
+		tracker, ok := provider.(observer.NodesRequestsTracker)
+		if ok {
+			trackers = append(trackers, tracker)
+		}
+	}
+
+	return trackers
+}
+
 func (bp *BaseProcessor) triggerNodesSyncCheck(address string) {
 	log.Info("triggering nodes state checks because of an offline node", "address of offline node", address)
 	select {
@@ -334,6 +517,60 @@ func (bp *BaseProcessor) GetFullHistoryNodesProvider() observer.NodesProviderHan
 	return bp.fullHistoryNodesProvider
 }
 
+// GetQuarantinedNodes returns the nodes which are not used because they do not match their configuration
+func (bp *BaseProcessor) GetQuarantinedNodes() []*proxyData.QuarantinedNode {
+	return bp.nodesSanityChecker.GetQuarantinedNodes()
+}
+
+// GetNodesHealth returns the health of all the observers and of all the full history nodes, as seen at the latest
+// sync state check and from the requests sent towards them
+func (bp *BaseProcessor) GetNodesHealth() []*proxyData.NodeHealthStatus {
+	statuses := createNodesHealthStatuses(bp.observersProvider, proxyData.Observer)
+	statuses = append(statuses, createNodesHealthStatuses(bp.fullHistoryNodesProvider, proxyData.FullHistoryNode)...)
+
+	for _, status := range statuses {
+		status.IsQuarantined = bp.nodesSanityChecker.IsQuarantined(&proxyData.NodeData{
+			Address: status.Address,
+			ShardId: status.ShardId,
+		})
+	}
+	bp.nodesHealthTracker.PopulateNodesHealth(statuses)
+
+	return statuses
+}
+
+func createNodesHealthStatuses(provider observer.NodesProviderHandler, nodeType proxyData.NodeType) []*proxyData.NodeHealthStatus {
+	nodes := provider.GetAllNodesWithSyncState()
+	lastSyncedNodes := provider.GetLastSyncedNodes()
+
+	statuses := make([]*proxyData.NodeHealthStatus, 0, len(nodes))
+	for _, node := range nodes {
+		lastSyncedNode, hasLastSyncedNode := lastSyncedNodes[node.ShardId]
+		statuses = append(statuses, &proxyData.NodeHealthStatus{
+			Address:            node.Address,
+			Type:               nodeType,
+			ShardId:            node.ShardId,
+			IsFallback:         node.IsFallback,
+			IsLastSyncedBackup: hasLastSyncedNode && lastSyncedNode.Address == node.Address,
+		})
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].ShardId != statuses[j].ShardId {
+			return statuses[i].ShardId < statuses[j].ShardId
+		}
+
+		return statuses[i].Address < statuses[j].Address
+	})
+
+	return statuses
+}
+
+// GetCircuitBreakersStatuses returns the statuses of the nodes' circuit breakers
+func (bp *BaseProcessor) GetCircuitBreakersStatuses() []*proxyData.NodeCircuitBreakerStatus {
+	return bp.circuitBreaker.GetStatuses()
+}
+
 func computeShardIDs(shardCoordinator sharding.Coordinator) []uint32 {
 	shardIDs := make([]uint32, 0)
 	for i := uint32(0); i < shardCoordinator.NumberOfShards(); i++ {
@@ -346,88 +583,167 @@ func computeShardIDs(shardCoordinator sharding.Coordinator) []uint32 {
 }
 
 func (bp *BaseProcessor) handleOutOfSyncNodes(ctx context.Context) {
-	timer := time.NewTimer(bp.delayForCheckingNodesSyncState)
+	timer := time.NewTimer(bp.syncStateChecker.GetDelayUntilNextCheck())
 	defer timer.Stop()
 
-	bp.updateNodesWithSync()
 	for {
-		timer.Reset(bp.delayForCheckingNodesSyncState)
+		timer.Reset(bp.syncStateChecker.GetDelayUntilNextCheck())
 
+		var shardIDs []uint32
 		select {
 		case <-timer.C:
+			shardIDs = bp.syncStateChecker.GetShardsToCheck()
 		case <-bp.chanTriggerNodesState:
+			shardIDs = bp.shardIDs
 		case <-ctx.Done():
 			log.Info("finishing BaseProcessor nodes state update...")
 			return
 		}
 
-		bp.updateNodesWithSync()
+		if len(shardIDs) == 0 {
+			continue
+		}
+
+		bp.syncStateChecker.ScheduleNextChecks(shardIDs)
+		bp.updateNodesWithSync(shardIDs)
 	}
 }
 
-func (bp *BaseProcessor) updateNodesWithSync() {
+// updateNodesWithSync asks the nodes of the provided shards for their status and recomputes the sync state of all the
+// nodes, the nodes of the other shards keeping the metrics from their latest check. The nodes which were never checked,
+// such as the newly discovered ones, are considered synced until their shard is checked
+func (bp *BaseProcessor) updateNodesWithSync(shardIDs []uint32) {
 	observers := bp.observersProvider.GetAllNodesWithSyncState()
-	observersWithSyncStatus := bp.getNodesWithSyncStatus(observers)
-	bp.observersProvider.UpdateNodesBasedOnSyncState(observersWithSyncStatus)
-
 	fullHistoryNodes := bp.fullHistoryNodesProvider.GetAllNodesWithSyncState()
-	fullHistoryNodesWithSyncStatus := bp.getNodesWithSyncStatus(fullHistoryNodes)
-	bp.fullHistoryNodesProvider.UpdateNodesBasedOnSyncState(fullHistoryNodesWithSyncStatus)
-}
 
-func (bp *BaseProcessor) getNodesWithSyncStatus(nodes []*proxyData.NodeData) []*proxyData.NodeData {
-	nodesToReturn := make([]*proxyData.NodeData, 0)
+	nodesToCheck := bp.filterNodesToCheck(observers, shardIDs)
+	nodesToCheck = append(nodesToCheck, bp.filterNodesToCheck(fullHistoryNodes, shardIDs)...)
+	checkedNodes := bp.getNodesWithMetrics(nodesToCheck)
+
+	lastNodesMetrics := make(map[string]*proxyData.NodeWithMetrics, len(observers)+len(fullHistoryNodes))
+	for _, nodeWithMetrics := range checkedNodes {
+		lastNodesMetrics[computeNodeKey(nodeWithMetrics.Node)] = nodeWithMetrics
+	}
+
+	nodes := make([]*proxyData.NodeData, 0, len(observers)+len(fullHistoryNodes))
+	nodes = append(nodes, observers...)
+	nodes = append(nodes, fullHistoryNodes...)
+
+	allNodes := make([]*proxyData.NodeWithMetrics, 0, len(nodes))
 	for _, node := range nodes {
-		isSynced, err := bp.isNodeSynced(node)
-		if err != nil {
-			log.Warn("cannot get node status. will mark as inactive", "address", node.Address, "error", err)
-			isSynced = false
+		key := computeNodeKey(node)
+		nodeWithMetrics, isChecked := lastNodesMetrics[key]
+		if !isChecked {
+			nodeWithMetrics, isChecked = bp.lastNodesMetrics[key]
+		}
+		if !isChecked {
+			node.IsSynced = true
+			continue
+		}
+
+		lastNodesMetrics[key] = nodeWithMetrics
+		allNodes = append(allNodes, &proxyData.NodeWithMetrics{
+			Node:    node,
+			Metrics: nodeWithMetrics.Metrics,
+			Error:   nodeWithMetrics.Error,
+		})
+	}
+	bp.lastNodesMetrics = lastNodesMetrics
+
+	bp.nodesSanityChecker.CheckNodes(allNodes)
+	bp.computeSyncState(allNodes)
+
+	bp.observersProvider.UpdateNodesBasedOnSyncState(observers)
+	bp.fullHistoryNodesProvider.UpdateNodesBasedOnSyncState(fullHistoryNodes)
+	bp.nodesHealthTracker.RecordNodesStatus(checkedNodes, nodes)
+}
+
+// computeSyncState marks the nodes as synced or not, the quarantined nodes being always marked as not synced and not
+// being taken into account when searching for the best node of a shard. The quarantine state is also set on the nodes,
+// so the nodes providers do not keep a quarantined node as backup or fallback of its shard
+func (bp *BaseProcessor) computeSyncState(nodesWithMetrics []*proxyData.NodeWithMetrics) {
+	nodesToEvaluate := make([]*proxyData.NodeWithMetrics, 0, len(nodesWithMetrics))
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		nodeWithMetrics.Node.IsQuarantined = bp.nodesSanityChecker.IsQuarantined(nodeWithMetrics.Node)
+		if nodeWithMetrics.Node.IsQuarantined {
+			nodeWithMetrics.Node.IsSynced = false
+			continue
 		}
 
-		node.IsSynced = isSynced
-		nodesToReturn = append(nodesToReturn, node)
+		nodesToEvaluate = append(nodesToEvaluate, nodeWithMetrics)
 	}
 
-	return nodesToReturn
+	bp.syncStateChecker.ComputeSyncState(nodesToEvaluate)
+}
+
+// getNodesWithMetrics asks the provided nodes for their status, at most maxParallelSyncChecks at a time
+func (bp *BaseProcessor) getNodesWithMetrics(nodes []*proxyData.NodeData) []*proxyData.NodeWithMetrics {
+	nodesWithMetrics := make([]*proxyData.NodeWithMetrics, len(nodes))
+	throttler := make(chan struct{}, bp.maxParallelSyncChecks)
+	wg := sync.WaitGroup{}
+	wg.Add(len(nodes))
+	for index, node := range nodes {
+		throttler <- struct{}{}
+		go func(index int, node *proxyData.NodeData) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			metrics, err := bp.getNodeMetrics(node)
+			if err != nil {
+				log.Warn("cannot get node status. will mark as inactive", "address", node.Address, "error", err)
+			}
+
+			nodesWithMetrics[index] = &proxyData.NodeWithMetrics{
+				Node:    node,
+				Metrics: metrics,
+				Error:   err,
+			}
+		}(index, node)
+	}
+	wg.Wait()
+
+	return nodesWithMetrics
 }
 
-func (bp *BaseProcessor) isNodeSynced(node *proxyData.NodeData) (bool, error) {
+func (bp *BaseProcessor) getNodeMetrics(node *proxyData.NodeData) (*proxyData.NodeStatusResponse, error) {
 	nodeStatusResponse, httpCode, err := bp.nodeStatusFetcher(node.Address)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	if httpCode != http.StatusOK {
-		return false, fmt.Errorf("observer %s responded with code %d", node.Address, httpCode)
+		return nil, fmt.Errorf("observer %s responded with code %d", node.Address, httpCode)
 	}
 
-	nonce := nodeStatusResponse.Data.Metrics.Nonce
-	probableHighestNonce := nodeStatusResponse.Data.Metrics.ProbableHighestNonce
-	isReadyForVMQueries := parseBool(nodeStatusResponse.Data.Metrics.AreVmQueriesReady)
-
-	// In some cases, the probableHighestNonce can be lower than the nonce. In this case we consider the node as synced
-	// as the nonce metric can be updated faster than the other one
-	probableHighestNonceLessThanOrEqualToNonce := probableHighestNonce <= nonce
-
-	// In normal conditions, the node's nonce should be equal to or very close to the probable highest nonce
-	nonceDifferenceBelowThreshold := probableHighestNonce-nonce < nodeSyncedNonceDifferenceThreshold
+	return &nodeStatusResponse.Data.Metrics, nil
+}
 
-	// If any of the above 2 conditions are met, the node is considered synced
-	isNodeSynced := nonceDifferenceBelowThreshold || probableHighestNonceLessThanOrEqualToNonce
+// filterNodesToCheck returns the nodes of the provided shards together with the nodes of the shards unknown to the
+// shard coordinator, as the latter are never scheduled for checking
+func (bp *BaseProcessor) filterNodesToCheck(nodes []*proxyData.NodeData, shardIDs []uint32) []*proxyData.NodeData {
+	filteredNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if containsShardID(shardIDs, node.ShardId) || !containsShardID(bp.shardIDs, node.ShardId) {
+			filteredNodes = append(filteredNodes, node)
+		}
+	}
 
-	log.Info("node status",
-		"address", node.Address,
-		"shard", node.ShardId,
-		"nonce", nonce,
-		"probable highest nonce", probableHighestNonce,
-		"is synced", isNodeSynced,
-		"is ready for VM Queries", isReadyForVMQueries,
-		"is fallback", node.IsFallback)
+	return filteredNodes
+}
 
-	if !isReadyForVMQueries {
-		isNodeSynced = false
+func containsShardID(shardIDs []uint32, shardID uint32) bool {
+	for _, id := range shardIDs {
+		if id == shardID {
+			return true
+		}
 	}
 
-	return isNodeSynced, nil
+	return false
+}
+
+func computeNodeKey(node *proxyData.NodeData) string {
+	return fmt.Sprintf("%s/%d", node.Address, node.ShardId)
 }
 
 func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.NodeStatusAPIResponse, int, error) {
@@ -469,24 +785,18 @@ func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.No
 	return &nodeStatusResponse, resp.StatusCode, nil
 }
 
-func parseBool(metricValue string) bool {
-	if strconv.FormatBool(true) == metricValue {
-		return true
-	}
-
-	return false
-}
-
 // IsInterfaceNil returns true if there is no value under the interface
 func (bp *BaseProcessor) IsInterfaceNil() bool {
 	return bp == nil
 }
 
-// Close will handle the closing of the cache update go routine
+// Close will handle the closing of the cache update go routine and of the idle connections towards the nodes
 func (bp *BaseProcessor) Close() error {
 	if bp.cancelFunc != nil {
 		bp.cancelFunc()
 	}
 
+	bp.httpClient.CloseIdleConnections()
+
 	return nil
 }
@@ -2,19 +2,24 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/coalescing"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -49,25 +54,55 @@ func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
 
 	bp, err := process.NewBaseProcessor(
 		-5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	assert.Nil(t, bp)
 	assert.Equal(t, process.ErrInvalidRequestTimeout, err)
 }
 
+func TestNewBaseProcessor_WithInvalidHttpClientConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{MaxIdleConnsPerHost: -1},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	assert.Nil(t, bp)
+	assert.True(t, errors.Is(err, process.ErrInvalidHttpClientConfig))
+}
+
 func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		nil,
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	assert.Nil(t, bp)
@@ -79,10 +114,15 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 
 	bp, err := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		nil,
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	assert.Nil(t, bp)
@@ -94,31 +134,158 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 
 	bp, err := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		nil,
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
 }
 
+func TestNewBaseProcessor_WithNilCircuitBreakerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		nil,
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilCircuitBreaker, err)
+}
+
+func TestNewBaseProcessor_WithNilRequestsCoalescerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		nil,
+		&disabled.NodesSanityChecker{},
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilRequestsCoalescer, err)
+}
+
+func TestNewBaseProcessor_WithNilNodesSanityCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilNodesSanityChecker, err)
+}
+
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	assert.NotNil(t, bp)
 	assert.Nil(t, err)
 }
 
+func TestNewBaseProcessor_ShouldOwnItsHttpTransport(t *testing.T) {
+	t.Parallel()
+
+	httpClientConfig := config.HttpClientConfig{
+		MaxIdleConnsPerHost:      50,
+		MaxConnsPerHost:          200,
+		IdleConnTimeoutSec:       60,
+		ResponseHeaderTimeoutSec: 3,
+		EnableHTTP2:              true,
+	}
+	bp1, _ := process.NewBaseProcessor(
+		5,
+		httpClientConfig,
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+	bp2, _ := process.NewBaseProcessor(
+		10,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	client1 := bp1.GetHttpClient()
+	client2 := bp2.GetHttpClient()
+	assert.False(t, client1 == http.DefaultClient)
+	assert.False(t, client1 == client2)
+	assert.Equal(t, 5*time.Second, client1.Timeout)
+	assert.Equal(t, 10*time.Second, client2.Timeout)
+
+	transport1, ok := client1.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 50, transport1.MaxIdleConnsPerHost)
+	assert.Equal(t, 200, transport1.MaxConnsPerHost)
+	assert.Equal(t, time.Minute, transport1.IdleConnTimeout)
+	assert.Equal(t, 3*time.Second, transport1.ResponseHeaderTimeout)
+	assert.True(t, transport1.ForceAttemptHTTP2)
+
+	transport2, ok := client2.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.False(t, transport1 == transport2)
+	assert.True(t, transport2.MaxIdleConnsPerHost > http.DefaultMaxIdleConnsPerHost)
+	assert.False(t, transport2.ForceAttemptHTTP2)
+}
+
 //------- GetObservers
 
 func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
@@ -127,6 +294,8 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 	observersSlice := []*data.NodeData{{Address: "addr1"}}
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
@@ -135,6 +304,9 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 	observers, err := bp.GetObservers(0)
 
@@ -142,6 +314,36 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 	assert.Equal(t, observersSlice, observers)
 }
 
+func TestBaseProcessor_GetObserversForAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	observersSlice := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetNodesByShardIdForAddressCalled: func(shardId uint32, providedAddress []byte) ([]*data.NodeData, error) {
+				assert.Equal(t, uint32(1), shardId)
+				assert.Equal(t, address, providedAddress)
+
+				return observersSlice, nil
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+	observers, err := bp.GetObserversForAddress(1, address)
+
+	assert.Nil(t, err)
+	assert.Equal(t, observersSlice, observers)
+}
+
 //------- ComputeShardId
 
 func TestBaseProcessor_ComputeShardId(t *testing.T) {
@@ -161,6 +363,8 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 	msc, _ := sharding.NewMultiShardCoordinator(3, 0)
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		msc,
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
@@ -169,6 +373,9 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	//there are 2 shards, compute ID should correctly process
@@ -199,17 +406,89 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 	tsRecovered := &testStruct{}
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
-	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", tsRecovered)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecovered)
 }
 
+func TestBaseProcessor_CallGetRestEndPointShouldCoalesceIdenticalRequests(t *testing.T) {
+	t.Parallel()
+
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be sent and received",
+	}
+	response, _ := json.Marshal(ts)
+
+	numReceivedRequests := int32(0)
+	chRelease := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&numReceivedRequests, 1)
+		<-chRelease
+		_, _ = rw.Write(response)
+	}))
+	defer server.Close()
+
+	numCoalesced := int32(0)
+	coalescer, _ := coalescing.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{
+		AddCoalescedRequestCalled: func(address string) {
+			atomic.AddInt32(&numCoalesced, 1)
+		},
+	})
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		coalescer,
+		&disabled.NodesSanityChecker{},
+	)
+
+	numCallers := 5
+	recovered := make([]*testStruct, numCallers)
+	wg := sync.WaitGroup{}
+	wg.Add(numCallers)
+	for i := 0; i < numCallers; i++ {
+		recovered[i] = &testStruct{}
+		go func(value *testStruct) {
+			defer wg.Done()
+
+			statusCode, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", value)
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, statusCode)
+		}(recovered[i])
+	}
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numCoalesced) == int32(numCallers-1)
+	}, time.Second, time.Millisecond)
+	close(chRelease)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numReceivedRequests))
+	for i := 0; i < numCallers; i++ {
+		assert.Equal(t, ts, recovered[i])
+	}
+	// every caller must have decoded the response into its own value
+	recovered[0].Nonce = 0
+	assert.Equal(t, ts.Nonce, recovered[1].Nonce)
+}
+
 func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -227,12 +506,17 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	tsRecovered := &testStruct{}
 	bp, _ := process.NewBaseProcessor(
 		1,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
-	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
+	_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", tsRecovered)
 
 	assert.NotEqual(t, ts.Name, tsRecovered.Name)
 	assert.NotNil(t, err)
@@ -251,12 +535,17 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
-	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", ts, tsRecv)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecv)
@@ -281,18 +570,225 @@ func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		1,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
-	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/some/path", ts, tsRecv)
 
 	assert.NotEqual(t, tsRecv.Name, ts.Name)
 	assert.NotNil(t, err)
 	assert.Equal(t, http.StatusRequestTimeout, rc)
 }
 
+func TestBaseProcessor_CallGetRestEndPointShouldNotifyRequestsTrackers(t *testing.T) {
+	t.Parallel()
+
+	server := createTestHttpServer("/some/path", []byte("{}"))
+	defer server.Close()
+
+	numStarted := uint32(0)
+	numFinished := uint32(0)
+	numFinishedWithError := uint32(0)
+	tracker := &mock.NodesRequestsTrackerProviderStub{
+		RequestStartedCalled: func(address string) {
+			atomic.AddUint32(&numStarted, 1)
+		},
+		RequestFinishedCalled: func(address string, duration time.Duration, withError bool) {
+			atomic.AddUint32(&numFinished, 1)
+			if withError {
+				atomic.AddUint32(&numFinishedWithError, 1)
+			}
+		},
+	}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		tracker,
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	require.Nil(t, err)
+
+	_, err = bp.CallGetRestEndPoint(context.Background(), "http://127.0.0.1:1", "/some/path", &testStruct{})
+	require.NotNil(t, err)
+
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numStarted))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numFinished))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numFinishedWithError))
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldNotifyServerErrorsAsFailedRequests(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		_, _ = rw.Write([]byte(`{"error":"internal error"}`))
+	}))
+	defer server.Close()
+
+	numFinishedWithError := uint32(0)
+	tracker := &mock.NodesRequestsTrackerProviderStub{
+		RequestFinishedCalled: func(address string, duration time.Duration, withError bool) {
+			if withError {
+				atomic.AddUint32(&numFinishedWithError, 1)
+			}
+		},
+	}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		tracker,
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	rc, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rc)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numFinishedWithError))
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldRecordCircuitBreakerOutcomes(t *testing.T) {
+	t.Parallel()
+
+	server := createTestHttpServer("/some/path", []byte("{}"))
+	defer server.Close()
+
+	numSuccesses := uint32(0)
+	numFailures := uint32(0)
+	circuitBreaker := &mock.CircuitBreakerStub{
+		RecordSuccessCalled: func(address string) {
+			atomic.AddUint32(&numSuccesses, 1)
+		},
+		RecordFailureCalled: func(address string) {
+			atomic.AddUint32(&numFailures, 1)
+		},
+	}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		circuitBreaker,
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	require.Nil(t, err)
+
+	_, err = bp.CallGetRestEndPoint(context.Background(), "http://127.0.0.1:1", "/some/path", &testStruct{})
+	require.NotNil(t, err)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numSuccesses))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numFailures))
+}
+
+func TestBaseProcessor_CallGetRestEndPointWithOpenCircuitShouldNotSendRequest(t *testing.T) {
+	t.Parallel()
+
+	numRequests := uint32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(&numRequests, 1)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	circuitBreaker := &mock.CircuitBreakerStub{
+		IsRequestAllowedCalled: func(address string) bool {
+			return false
+		},
+	}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		circuitBreaker,
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	respCode, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	assert.True(t, errors.Is(err, process.ErrNodeCircuitOpen))
+	assert.Equal(t, http.StatusServiceUnavailable, respCode)
+
+	respCode, err = bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{}, &testStruct{})
+	assert.True(t, errors.Is(err, process.ErrNodeCircuitOpen))
+	assert.Equal(t, http.StatusServiceUnavailable, respCode)
+
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numRequests))
+}
+
+func TestBaseProcessor_CallGetRestEndPointWithCanceledContextShouldNotRecordFailure(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(time.Second)
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	numFailures := uint32(0)
+	circuitBreaker := &mock.CircuitBreakerStub{
+		RecordFailureCalled: func(address string) {
+			atomic.AddUint32(&numFailures, 1)
+		},
+	}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		circuitBreaker,
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	startTime := time.Now()
+	respCode, err := bp.CallGetRestEndPoint(ctx, testServer.URL, "/some/path", &testStruct{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, http.StatusRequestTimeout, respCode)
+	assert.True(t, time.Since(startTime) < time.Second)
+
+	respCode, err = bp.CallPostRestEndPoint(ctx, testServer.URL, "/some/path", &testStruct{}, &testStruct{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, http.StatusRequestTimeout, respCode)
+
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numFailures))
+}
+
 func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 	t.Parallel()
 
@@ -317,6 +813,8 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesCalled: func() ([]*data.NodeData, error) {
@@ -325,6 +823,9 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	assert.Nil(t, err)
@@ -358,6 +859,8 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
@@ -375,6 +878,9 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard()
@@ -406,6 +912,8 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
@@ -423,6 +931,9 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard()
@@ -454,6 +965,8 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
@@ -471,6 +984,9 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard()
@@ -506,6 +1022,8 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{
@@ -523,6 +1041,9 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	observers, err := bp.GetFullHistoryNodesOnePerShard()
@@ -539,10 +1060,15 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{NumShards: 3},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	expected := []uint32{0, 1, 2, core.MetachainShardId}
@@ -554,6 +1080,8 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -570,6 +1098,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -599,6 +1130,8 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -630,6 +1163,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -672,6 +1208,8 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -691,6 +1229,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -707,7 +1248,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 	go func() {
 		// trigger a HTTP error that will trigger the nodes sync state checks
 		time.Sleep(90 * time.Millisecond)
-		_, _ = bp.CallGetRestEndPoint("address1", "/node/status", nil)
+		_, _ = bp.CallGetRestEndPoint(context.Background(), "address1", "/node/status", nil)
 	}()
 
 	time.Sleep(300 * time.Millisecond)
@@ -725,6 +1266,8 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -739,6 +1282,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -770,6 +1316,8 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 
 	bp, _ := process.NewBaseProcessor(
 		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -798,6 +1346,9 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -827,6 +1378,200 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestBaseProcessor_QuarantinedNodesShouldNotBeUsed(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "address0", ShardId: 0},
+		{Address: "address1", ShardId: 0},
+	}
+	var checkedNodes []*data.NodeWithMetrics
+	var nodesWithSyncStatus []*data.NodeData
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return observers
+			},
+			UpdateNodesBasedOnSyncStateCalled: func(nodes []*data.NodeData) {
+				nodesWithSyncStatus = nodes
+			},
+			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return observers, nil
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&mock.NodesSanityCheckerStub{
+			CheckNodesCalled: func(nodesWithMetrics []*data.NodeWithMetrics) {
+				checkedNodes = nodesWithMetrics
+			},
+			IsQuarantinedCalled: func(node *data.NodeData) bool {
+				return node.Address == "address1"
+			},
+		},
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		return getResponseForNodeStatus(true, "true"), http.StatusOK, nil
+	})
+
+	bp.StartNodesSyncStateChecks()
+	_ = bp.Close()
+
+	require.Equal(t, 2, len(checkedNodes))
+	require.Equal(t, uint64(10), checkedNodes[1].Metrics.Nonce)
+	require.True(t, nodesWithSyncStatus[0].IsSynced)
+	require.False(t, nodesWithSyncStatus[0].IsQuarantined)
+	require.False(t, nodesWithSyncStatus[1].IsSynced)
+	require.True(t, nodesWithSyncStatus[1].IsQuarantined)
+
+	nodes, err := bp.GetObservers(0)
+	require.NoError(t, err)
+	require.Equal(t, []*data.NodeData{observers[0]}, nodes)
+
+	observers = observers[1:]
+	nodes, err = bp.GetObservers(0)
+	require.Equal(t, process.ErrAllNodesQuarantined, err)
+	require.Nil(t, nodes)
+}
+
+func TestBaseProcessor_GetNodesHealth(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "address1", ShardId: 1},
+		{Address: "address0", ShardId: 0, IsFallback: true},
+	}
+	fullHistoryNodes := []*data.NodeData{
+		{Address: "address2", ShardId: 0},
+	}
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return observers
+			},
+			GetLastSyncedNodesCalled: func() map[uint32]*data.NodeData {
+				return map[uint32]*data.NodeData{1: observers[0]}
+			},
+		},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return fullHistoryNodes
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&mock.NodesSanityCheckerStub{
+			IsQuarantinedCalled: func(node *data.NodeData) bool {
+				return node.Address == "address2"
+			},
+		},
+	)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		if url == "address1" {
+			return nil, http.StatusNotFound, errors.New("connection refused")
+		}
+
+		return getResponseForNodeStatus(true, "true"), http.StatusOK, nil
+	})
+
+	bp.StartNodesSyncStateChecks()
+	_ = bp.Close()
+
+	nodesHealth := bp.GetNodesHealth()
+	require.Equal(t, 3, len(nodesHealth))
+
+	require.Equal(t, "address0", nodesHealth[0].Address)
+	require.Equal(t, data.Observer, nodesHealth[0].Type)
+	require.True(t, nodesHealth[0].IsFallback)
+	require.True(t, nodesHealth[0].IsSynced)
+	require.Equal(t, uint64(10), nodesHealth[0].Nonce)
+
+	require.Equal(t, "address1", nodesHealth[1].Address)
+	require.True(t, nodesHealth[1].IsLastSyncedBackup)
+	require.False(t, nodesHealth[1].IsSynced)
+	require.Equal(t, "connection refused", nodesHealth[1].LastCheckError)
+	require.Equal(t, uint64(1), nodesHealth[1].NumFailedChecks)
+
+	require.Equal(t, "address2", nodesHealth[2].Address)
+	require.Equal(t, data.FullHistoryNode, nodesHealth[2].Type)
+	require.True(t, nodesHealth[2].IsQuarantined)
+	require.False(t, nodesHealth[2].IsSynced)
+}
+
+func TestBaseProcessor_UpdateNodesWithSyncShouldCheckOnlyTheProvidedShards(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "address0", ShardId: 0},
+		{Address: "address1", ShardId: core.MetachainShardId},
+	}
+	var nodesWithSyncStatus []*data.NodeData
+	bp, _ := process.NewBaseProcessor(
+		5,
+		config.HttpClientConfig{},
+		config.NodesSyncCheckConfig{},
+		&mock.ShardCoordinatorMock{NumShards: 1},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return observers
+			},
+			UpdateNodesBasedOnSyncStateCalled: func(nodes []*data.NodeData) {
+				nodesWithSyncStatus = nodes
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&disabled.CircuitBreaker{},
+		&disabled.RequestsCoalescer{},
+		&disabled.NodesSanityChecker{},
+	)
+
+	mutCheckedAddresses := sync.Mutex{}
+	checkedAddresses := make([]string, 0)
+	isMetachainObserverSynced := false
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		mutCheckedAddresses.Lock()
+		checkedAddresses = append(checkedAddresses, url)
+		mutCheckedAddresses.Unlock()
+
+		if url == "address1" {
+			return getResponseForNodeStatus(isMetachainObserverSynced, "true"), http.StatusOK, nil
+		}
+
+		return getResponseForNodeStatus(true, "true"), http.StatusOK, nil
+	})
+
+	bp.UpdateNodesWithSync([]uint32{0, core.MetachainShardId})
+	require.Equal(t, 2, len(checkedAddresses))
+	require.True(t, nodesWithSyncStatus[0].IsSynced)
+	require.False(t, nodesWithSyncStatus[1].IsSynced)
+
+	// the metachain observer keeps the sync state from its latest check, while the new one is considered synced
+	isMetachainObserverSynced = true
+	checkedAddresses = make([]string, 0)
+	observers = append(observers, &data.NodeData{Address: "address2", ShardId: core.MetachainShardId})
+	bp.UpdateNodesWithSync([]uint32{0})
+	require.Equal(t, []string{"address0"}, checkedAddresses)
+	require.Equal(t, 3, len(nodesWithSyncStatus))
+	require.True(t, nodesWithSyncStatus[0].IsSynced)
+	require.False(t, nodesWithSyncStatus[1].IsSynced)
+	require.True(t, nodesWithSyncStatus[2].IsSynced)
+
+	bp.UpdateNodesWithSync([]uint32{core.MetachainShardId})
+	require.True(t, nodesWithSyncStatus[1].IsSynced)
+}
+
 func getResponseForNodeStatus(synced bool, vmQueriesReadyStr string) *data.NodeStatusAPIResponse {
 	nonce, probableHighestNonce := uint64(10), uint64(11)
 	if !synced {
@@ -1,7 +1,9 @@
 package process
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -29,24 +31,36 @@ const (
 	rawPathStr  = "raw"
 )
 
+// blockStatusOnChain is the status of the blocks which are part of the chain, as opposed to the reverted ones
+const blockStatusOnChain = "on-chain"
+
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc     Processor
-	dbReader ExternalStorageConnector
+	proc          Processor
+	dbReader      ExternalStorageConnector
+	responseCache ResponseCacheHandler
+
+	mutFinalNonces     sync.RWMutex
+	highestFinalNonces map[uint32]uint64
 }
 
 // NewBlockProcessor will create a new block processor
-func NewBlockProcessor(dbReader ExternalStorageConnector, proc Processor) (*BlockProcessor, error) {
+func NewBlockProcessor(dbReader ExternalStorageConnector, proc Processor, responseCache ResponseCacheHandler) (*BlockProcessor, error) {
 	if check.IfNil(dbReader) {
 		return nil, ErrNilDatabaseConnector
 	}
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
 
 	return &BlockProcessor{
-		dbReader: dbReader,
-		proc:     proc,
+		dbReader:           dbReader,
+		proc:               proc,
+		responseCache:      responseCache,
+		highestFinalNonces: make(map[uint32]uint64),
 	}, nil
 }
 
@@ -56,24 +70,33 @@ func (bp *BlockProcessor) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce u
 }
 
 // GetBlockByHash will return the block based on its hash
-func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%s", blockByHashPath, hash), options)
+	cacheKey := fmt.Sprintf("block_%d_%s", shardID, path)
+	cachedResponse := &data.BlockApiResponse{}
+	if bp.responseCache.Get(ctx, cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
 	}
 
-	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%s", blockByHashPath, hash), options)
-
 	for _, observer := range observers {
 		var response data.BlockApiResponse
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
 		log.Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		if bp.isBlockImmutable(ctx, shardID, observer.Address, &response) {
+			bp.responseCache.Put(cacheKey, &response)
+		}
+
 		return &response, nil
 
 	}
@@ -82,7 +105,7 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 }
 
 // GetBlockByNonce will return the block based on the nonce
-func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -93,7 +116,7 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	for _, observer := range observers {
 		var response data.BlockApiResponse
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -107,6 +130,58 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	return nil, ErrSendingRequest
 }
 
+// isBlockImmutable returns true if the block is on chain and final. The content of a block fetched by its hash can not
+// change, but its status can, until the block is final
+func (bp *BlockProcessor) isBlockImmutable(ctx context.Context, shardID uint32, address string, response *data.BlockApiResponse) bool {
+	block := response.Data.Block
+	isOnChain := len(response.Error) == 0 && len(block.Hash) > 0 && block.Status == blockStatusOnChain
+	if !isOnChain {
+		return false
+	}
+
+	return bp.isBlockFinal(ctx, shardID, address, block.Nonce)
+}
+
+// isBlockFinal returns true if the nonce is not above the highest final nonce of the shard. As the highest final nonce
+// only grows, the observer is asked for it only when the nonce is above the last known one
+func (bp *BlockProcessor) isBlockFinal(ctx context.Context, shardID uint32, address string, nonce uint64) bool {
+	bp.mutFinalNonces.RLock()
+	highestFinalNonce, found := bp.highestFinalNonces[shardID]
+	bp.mutFinalNonces.RUnlock()
+	if found && nonce <= highestFinalNonce {
+		return true
+	}
+
+	highestFinalNonce, err := bp.fetchHighestFinalNonce(ctx, address)
+	if err != nil {
+		log.Debug("cannot get the highest final nonce", "shard ID", shardID, "observer", address, "error", err)
+		return false
+	}
+
+	bp.mutFinalNonces.Lock()
+	if highestFinalNonce > bp.highestFinalNonces[shardID] {
+		bp.highestFinalNonces[shardID] = highestFinalNonce
+	}
+	bp.mutFinalNonces.Unlock()
+
+	return nonce <= highestFinalNonce
+}
+
+func (bp *BlockProcessor) fetchHighestFinalNonce(ctx context.Context, address string) (uint64, error) {
+	var response data.GenericAPIResponse
+	_, err := bp.proc.CallGetRestEndPoint(ctx, address, NodeStatusPath, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	highestFinalNonce, ok := getHighestFinalNonceFromStatus(response.Data)
+	if !ok {
+		return 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return highestFinalNonce, nil
+}
+
 func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*data.NodeData, error) {
 	fullHistoryNodes, err := bp.proc.GetFullHistoryNodes(shardID)
 	if err == nil {
@@ -117,7 +192,7 @@ func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*dat
 }
 
 // GetHyperBlockByHash returns the hyperblock by hash
-func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &HyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -125,7 +200,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 		WithLogs:         options.WithLogs,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByHash(core.MetachainShardId, hash, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByHash(ctx, core.MetachainShardId, hash, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +209,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	builder.addMetaBlock(&metaBlock)
 
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlockResponse, err := bp.GetBlockByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
 		if err != nil {
 			return nil, err
 		}
@@ -147,7 +222,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 }
 
 // GetHyperBlockByNonce returns the hyperblock by nonce
-func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &HyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -155,7 +230,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 		WithLogs:         options.WithLogs,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByNonce(core.MetachainShardId, nonce, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByNonce(ctx, core.MetachainShardId, nonce, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +239,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	builder.addMetaBlock(&metaBlock)
 
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlockResponse, err := bp.GetBlockByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
 		if err != nil {
 			return nil, err
 		}
@@ -177,7 +252,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
-func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -191,7 +266,7 @@ func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, fo
 	for _, observer := range observers {
 		var response data.InternalBlockApiResponse
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -223,7 +298,7 @@ func getInternalBlockByHashPath(shardID uint32, format common.OutputFormat, hash
 }
 
 // GetInternalBlockByNonce will return the internal block based on its nonce
-func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -237,7 +312,7 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 	for _, observer := range observers {
 		var response data.InternalBlockApiResponse
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -269,7 +344,7 @@ func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, non
 }
 
 // GetInternalMiniBlockByHash will return the miniblock based on its hash
-func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -284,7 +359,7 @@ func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string
 	for _, observer := range observers {
 		var response data.InternalMiniBlockApiResponse
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("miniblock request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -314,7 +389,7 @@ func getOutputFormat(format common.OutputFormat) (string, error) {
 }
 
 // GetInternalStartOfEpochMetaBlock will return the internal start of epoch meta block based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -330,7 +405,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 	for _, observer := range observers {
 		var response data.InternalBlockApiResponse
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &response)
 		if err != nil {
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -343,3 +418,8 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 
 	return nil, ErrSendingRequest
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *BlockProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -19,7 +20,7 @@ import (
 func TestNewBlockProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil, &mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(nil, &mock.ProcessorStub{}, &mock.ResponseCacheStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilDatabaseConnector, err)
 }
@@ -27,15 +28,23 @@ func TestNewBlockProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, nil)
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, nil, &mock.ResponseCacheStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_NilResponseCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, nil)
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilResponseCache, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -43,7 +52,7 @@ func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 func TestBlockProcessor_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
 	t.Parallel()
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{})
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetAtlasBlockByShardIDAndNonce(0, 1)
@@ -68,10 +77,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -94,10 +103,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -116,10 +125,10 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -137,10 +146,10 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
@@ -160,10 +169,10 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -171,6 +180,114 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 	require.Equal(t, nonce, block.Nonce)
 }
 
+func TestBlockProcessor_GetBlockByHashCachedShouldNotCallObservers(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(37)
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	responseCache := &mock.ResponseCacheStub{
+		GetCalled: func(key string, value interface{}) bool {
+			valResp := value.(*data.BlockApiResponse)
+			valResp.Data.Block = api.Block{Nonce: nonce}
+			return true
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responseCache)
+
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, nonce, res.Data.Block.Nonce)
+}
+
+func createBlockProcessorStubWithFinalNonce(blockNonce uint64, status *string, highestFinalNonce *uint64, numStatusRequests *int) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			if path == process.NodeStatusPath {
+				*numStatusRequests++
+				valResp := value.(*data.GenericAPIResponse)
+				valResp.Data = map[string]interface{}{
+					"metrics": map[string]interface{}{
+						"erd_highest_final_nonce": float64(*highestFinalNonce),
+					},
+				}
+				return 200, nil
+			}
+
+			valResp := value.(*data.BlockApiResponse)
+			valResp.Data.Block = api.Block{Hash: "hash", Nonce: blockNonce, Status: *status}
+			return 200, nil
+		},
+	}
+}
+
+func TestBlockProcessor_GetBlockByHashShouldCacheOnlyOnChainBlocks(t *testing.T) {
+	t.Parallel()
+
+	status := "on-chain"
+	highestFinalNonce := uint64(10)
+	numStatusRequests := 0
+	proc := createBlockProcessorStubWithFinalNonce(10, &status, &highestFinalNonce, &numStatusRequests)
+	cachedKeys := make([]string, 0)
+	responseCache := &mock.ResponseCacheStub{
+		PutCalled: func(key string, value interface{}) {
+			cachedKeys = append(cachedKeys, key)
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responseCache)
+
+	_, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"block_0_/block/by-hash/hash"}, cachedKeys)
+
+	status = "reverted"
+	_, err = bp.GetBlockByHash(context.Background(), 1, "hash", common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"block_0_/block/by-hash/hash"}, cachedKeys)
+}
+
+func TestBlockProcessor_GetBlockByHashShouldNotCacheNonFinalBlocks(t *testing.T) {
+	t.Parallel()
+
+	status := "on-chain"
+	highestFinalNonce := uint64(9)
+	numStatusRequests := 0
+	proc := createBlockProcessorStubWithFinalNonce(10, &status, &highestFinalNonce, &numStatusRequests)
+	cachedKeys := make([]string, 0)
+	responseCache := &mock.ResponseCacheStub{
+		PutCalled: func(key string, value interface{}) {
+			cachedKeys = append(cachedKeys, key)
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responseCache)
+
+	_, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, cachedKeys)
+	require.Equal(t, 1, numStatusRequests)
+
+	highestFinalNonce = 12
+	_, err = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"block_0_/block/by-hash/hash"}, cachedKeys)
+	require.Equal(t, 2, numStatusRequests)
+
+	// the highest final nonce is remembered, so the observer is not asked again for the blocks below it
+	_, err = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, numStatusRequests)
+}
+
 func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T) {
 	t.Parallel()
 
@@ -188,10 +305,10 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -217,10 +334,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -243,10 +360,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -265,10 +382,10 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -286,10 +403,10 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
@@ -309,10 +426,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, nonce, common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -337,10 +454,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 3, common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -376,12 +493,12 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	processor, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
 	numGetBlockCalled = 0
-	response, err := processor.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{})
+	response, err := processor.GetHyperBlockByHash(context.Background(), "abcd", common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -389,7 +506,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 
 	numGetBlockCalled = 0
-	response, err = processor.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+	response, err = processor.GetHyperBlockByNonce(context.Background(), 42, common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -408,10 +525,10 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
+	blk, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -433,10 +550,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -459,10 +576,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -481,10 +598,10 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -502,10 +619,10 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
@@ -531,15 +648,15 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, nonce, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByNonce(core.MetachainShardId, nonce, common.Proto)
+	res, err = bp.GetInternalBlockByNonce(context.Background(), core.MetachainShardId, nonce, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -556,10 +673,10 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
+	blk, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -581,10 +698,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -607,10 +724,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -629,10 +746,10 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -650,10 +767,10 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
@@ -678,15 +795,15 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByHash(core.MetachainShardId, "aaaa", common.Proto)
+	res, err = bp.GetInternalBlockByHash(context.Background(), core.MetachainShardId, "aaaa", common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -703,10 +820,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
+	blk, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -728,10 +845,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -754,10 +871,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -776,10 +893,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -797,10 +914,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
@@ -825,15 +942,15 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalMiniBlockByHash(core.MetachainShardId, "aaaa", 1, common.Proto)
+	res, err = bp.GetInternalMiniBlockByHash(context.Background(), core.MetachainShardId, "aaaa", 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -850,10 +967,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
+	blk, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -875,10 +992,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -901,10 +1018,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -923,10 +1040,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -945,10 +1062,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
@@ -973,15 +1090,15 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ResponseCacheStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalStartOfEpochMetaBlock(1, common.Proto)
+	res, err = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
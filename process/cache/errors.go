@@ -2,20 +2,20 @@ package cache
 
 import "errors"
 
-// ErrNilHeartbeatsInCache signals that the heartbeats response stored in cache is nil
-var ErrNilHeartbeatsInCache = errors.New("nil heartbeat response in cache")
+// ErrInvalidCacheSize signals that an invalid cache size has been provided
+var ErrInvalidCacheSize = errors.New("invalid cache size")
 
-// ErrNilHeartbeatsToStoreInCache signals that the provided heartbeats response is nil
-var ErrNilHeartbeatsToStoreInCache = errors.New("nil heartbeat response to store in cache")
+// ErrNilCacheMetricsHandler signals that a nil cache metrics handler has been provided
+var ErrNilCacheMetricsHandler = errors.New("nil cache metrics handler")
 
-// ErrNilValidatorStatsInCache signals that the heartbeats response stored in cache is nil
-var ErrNilValidatorStatsInCache = errors.New("nil validator statistics response in cache")
+// ErrInvalidKeyConfig signals that an invalid cache key configuration has been provided
+var ErrInvalidKeyConfig = errors.New("invalid cache key config")
 
-// ErrNilValidatorStatsToStoreInCache signals that the provided validator statistics is nil
-var ErrNilValidatorStatsToStoreInCache = errors.New("nil validator statistics to store in cache")
+// ErrNilFetchHandler signals that a nil fetch handler has been provided
+var ErrNilFetchHandler = errors.New("nil fetch handler")
 
-// ErrNilGenericApiResponseInCache signals that the generic api response stored in cache is nil
-var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in cache")
+// ErrUnknownCacheKey signals that a key which was not registered has been requested
+var ErrUnknownCacheKey = errors.New("unknown cache key")
 
-// ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
-var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+// ErrNilSharedStore signals that a nil shared store has been provided
+var ErrNilSharedStore = errors.New("nil shared store")
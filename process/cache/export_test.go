@@ -1,42 +1,31 @@
 package cache
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
-
-func (hmc *HeartbeatMemoryCacher) GetStoredHbts() []data.PubKeyHeartbeat {
-	hmc.mutHeartbeats.RLock()
-	defer hmc.mutHeartbeats.RUnlock()
-
-	return hmc.storedHeartbeats
-}
-
-func (hmc *HeartbeatMemoryCacher) SetStoredHbts(hbts []data.PubKeyHeartbeat) {
-	hmc.mutHeartbeats.Lock()
-	hmc.storedHeartbeats = hbts
-	hmc.mutHeartbeats.Unlock()
+import (
+	"context"
+	"time"
+)
+
+// TTLCacheForTests -
+type TTLCacheForTests interface {
+	Register(key string, config KeyConfig, fetch FetchHandler) error
+	UpdateKeyConfig(key string, config KeyConfig) error
+	Get(ctx context.Context, key string) (interface{}, error)
+	Load(key string) (interface{}, bool)
+	SetTimeHandler(handler func() time.Time)
+	IsFetchInProgress(key string) bool
+	SetSharedStore(sharedStore SharedStoreHandler)
 }
 
-func (vsmc *validatorsStatsMemoryCacher) GetStoredValStats() map[string]*data.ValidatorApiResponse {
-	vsmc.mutValidatorsStatss.RLock()
-	defer vsmc.mutValidatorsStatss.RUnlock()
-
-	return vsmc.storedValidatorsStats
-}
-
-func (vsmc *validatorsStatsMemoryCacher) SetStoredValStats(valStats map[string]*data.ValidatorApiResponse) {
-	vsmc.mutValidatorsStatss.Lock()
-	vsmc.storedValidatorsStats = valStats
-	vsmc.mutValidatorsStatss.Unlock()
+func (tc *ttlCache) SetTimeHandler(handler func() time.Time) {
+	tc.mutKeys.Lock()
+	tc.getTimeHandler = handler
+	tc.mutKeys.Unlock()
 }
 
-func (garmc *genericApiResponseMemoryCacher) GetGenericApiResponse() *data.GenericAPIResponse {
-	garmc.mutGenericApiResponse.RLock()
-	defer garmc.mutGenericApiResponse.RUnlock()
-
-	return garmc.storedResponse
+func (tc *ttlCache) IsFetchInProgress(key string) bool {
+	return tc.fetches.IsInProgress(key)
 }
 
-func (garmc *genericApiResponseMemoryCacher) SetGenericApiResponse(response *data.GenericAPIResponse) {
-	garmc.mutGenericApiResponse.Lock()
-	garmc.storedResponse = response
-	garmc.mutGenericApiResponse.Unlock()
+func (tc *ttlCache) SetSharedStore(sharedStore SharedStoreHandler) {
+	tc.sharedStore = sharedStore
 }
@@ -0,0 +1,21 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// CacheMetricsHandler defines what a component which records the caches' hits and misses should be able to do
+type CacheMetricsHandler interface {
+	AddCacheHit(cacheName string)
+	AddCacheMiss(cacheName string)
+	IsInterfaceNil() bool
+}
+
+// SharedStoreHandler defines the operations of the store through which the cached values are shared between several
+// proxy instances
+type SharedStoreHandler interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, expiration time.Duration) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+// sharedKeyPrefix is prepended to the keys of the values kept in the shared store
+const sharedKeyPrefix = "cache:"
+
+const (
+	// sharedResponsesExpiration represents the duration the immutable responses are kept in the shared store
+	sharedResponsesExpiration = time.Hour
+
+	// sharedStoreReadTimeout bounds a read from the shared store, which is done on the request path
+	sharedStoreReadTimeout = 100 * time.Millisecond
+
+	// sharedStoreWriteTimeout bounds a write to the shared store, which is done in background
+	sharedStoreWriteTimeout = time.Second
+
+	// maxPendingSharedWrites bounds the number of writes to the shared store in progress. While the limit is reached,
+	// the new responses are only kept locally
+	maxPendingSharedWrites = 100
+)
+
+// ArgsResponseCache holds the arguments needed for creating a new response cache
+type ArgsResponseCache struct {
+	Name           string
+	MaxNumEntries  int
+	MaxSizeInBytes int64
+	MetricsHandler CacheMetricsHandler
+	SharedStore    SharedStoreHandler
+}
+
+// responseCache is a bounded LRU cache for the responses which can never change. The responses are stored in their
+// serialized form, so the callers can never alter the cached values and the size of the cache can be capped. The
+// responses are also put in the shared store, so they can be reused by the other proxy instances. A slow shared store
+// never holds a request for longer than sharedStoreReadTimeout, as the writes are done in background
+type responseCache struct {
+	name            string
+	cacher          storage.Cacher
+	metricsHandler  CacheMetricsHandler
+	sharedStore     SharedStoreHandler
+	chPendingWrites chan struct{}
+}
+
+// NewResponseCache returns a new instance of responseCache
+func NewResponseCache(args ArgsResponseCache) (*responseCache, error) {
+	if args.MaxNumEntries <= 0 || args.MaxSizeInBytes <= 0 {
+		return nil, ErrInvalidCacheSize
+	}
+	if check.IfNil(args.MetricsHandler) {
+		return nil, ErrNilCacheMetricsHandler
+	}
+	if check.IfNil(args.SharedStore) {
+		return nil, ErrNilSharedStore
+	}
+
+	cacher, err := lrucache.NewCacheWithSizeInBytes(args.MaxNumEntries, args.MaxSizeInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responseCache{
+		name:            args.Name,
+		cacher:          cacher,
+		metricsHandler:  args.MetricsHandler,
+		sharedStore:     args.SharedStore,
+		chPendingWrites: make(chan struct{}, maxPendingSharedWrites),
+	}, nil
+}
+
+// Get loads the response stored under the provided key into the provided value. It returns false if the response
+// is not cached
+func (rc *responseCache) Get(ctx context.Context, key string, value interface{}) bool {
+	buff, found := rc.getSerialized(ctx, key)
+	if !found {
+		rc.metricsHandler.AddCacheMiss(rc.name)
+		return false
+	}
+
+	err := json.Unmarshal(buff, value)
+	if err != nil {
+		log.Warn("cannot unmarshal cached response", "cache", rc.name, "error", err)
+		rc.metricsHandler.AddCacheMiss(rc.name)
+		return false
+	}
+
+	rc.metricsHandler.AddCacheHit(rc.name)
+	return true
+}
+
+func (rc *responseCache) getSerialized(ctx context.Context, key string) ([]byte, bool) {
+	cachedValue, found := rc.cacher.Get([]byte(key))
+	if found {
+		buff, ok := cachedValue.([]byte)
+		return buff, ok
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, sharedStoreReadTimeout)
+	defer cancel()
+
+	buff, found, err := rc.sharedStore.Get(readCtx, rc.sharedKey(key))
+	if err != nil {
+		log.Debug("cannot load shared response", "cache", rc.name, "error", err)
+		return nil, false
+	}
+	if found {
+		_ = rc.cacher.Put([]byte(key), buff, len(key)+len(buff))
+	}
+
+	return buff, found
+}
+
+// Put stores the provided response under the provided key. The caller must ensure that the response is immutable
+func (rc *responseCache) Put(key string, value interface{}) {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("cannot marshal response to be cached", "cache", rc.name, "error", err)
+		return
+	}
+
+	_ = rc.cacher.Put([]byte(key), buff, len(key)+len(buff))
+
+	select {
+	case rc.chPendingWrites <- struct{}{}:
+		go rc.storeShared(key, buff)
+	default:
+		log.Debug("too many pending shared writes, the response is only cached locally", "cache", rc.name)
+	}
+}
+
+func (rc *responseCache) storeShared(key string, buff []byte) {
+	defer func() {
+		<-rc.chPendingWrites
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), sharedStoreWriteTimeout)
+	defer cancel()
+
+	err := rc.sharedStore.Set(ctx, rc.sharedKey(key), buff, sharedResponsesExpiration)
+	if err != nil {
+		log.Debug("cannot store shared response", "cache", rc.name, "error", err)
+	}
+}
+
+func (rc *responseCache) sharedKey(key string) string {
+	return sharedKeyPrefix + rc.name + ":" + key
+}
+
+// IsEnabled returns true as the responses are cached
+func (rc *responseCache) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *responseCache) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,209 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsResponseCache() cache.ArgsResponseCache {
+	return cache.ArgsResponseCache{
+		Name:           "test",
+		MaxNumEntries:  10,
+		MaxSizeInBytes: 1024,
+		MetricsHandler: &mock.CacheMetricsHandlerStub{},
+		SharedStore:    &mock.SharedStoreStub{},
+	}
+}
+
+func TestNewResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of entries should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseCache()
+		args.MaxNumEntries = 0
+		rc, err := cache.NewResponseCache(args)
+		require.Nil(t, rc)
+		require.Equal(t, cache.ErrInvalidCacheSize, err)
+	})
+	t.Run("invalid size should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseCache()
+		args.MaxSizeInBytes = 0
+		rc, err := cache.NewResponseCache(args)
+		require.Nil(t, rc)
+		require.Equal(t, cache.ErrInvalidCacheSize, err)
+	})
+	t.Run("nil metrics handler should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseCache()
+		args.MetricsHandler = nil
+		rc, err := cache.NewResponseCache(args)
+		require.Nil(t, rc)
+		require.Equal(t, cache.ErrNilCacheMetricsHandler, err)
+	})
+	t.Run("nil shared store should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseCache()
+		args.SharedStore = nil
+		rc, err := cache.NewResponseCache(args)
+		require.Nil(t, rc)
+		require.Equal(t, cache.ErrNilSharedStore, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := cache.NewResponseCache(createMockArgsResponseCache())
+		require.Nil(t, err)
+		require.False(t, rc.IsInterfaceNil())
+	})
+}
+
+func TestResponseCache_GetShouldRecordHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	numHits, numMisses := 0, 0
+	args := createMockArgsResponseCache()
+	args.MetricsHandler = &mock.CacheMetricsHandlerStub{
+		AddCacheHitCalled: func(cacheName string) {
+			require.Equal(t, "test", cacheName)
+			numHits++
+		},
+		AddCacheMissCalled: func(cacheName string) {
+			require.Equal(t, "test", cacheName)
+			numMisses++
+		},
+	}
+	rc, _ := cache.NewResponseCache(args)
+
+	response := &data.GenericAPIResponse{}
+	require.False(t, rc.Get(context.Background(), "key", response))
+
+	rc.Put("key", &data.GenericAPIResponse{Data: "data", Code: data.ReturnCodeSuccess})
+	require.True(t, rc.Get(context.Background(), "key", response))
+	require.Equal(t, "data", response.Data)
+	require.Equal(t, data.ReturnCodeSuccess, response.Code)
+
+	require.Equal(t, 1, numHits)
+	require.Equal(t, 1, numMisses)
+}
+
+func TestResponseCache_GetShouldReturnIndependentCopies(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := cache.NewResponseCache(createMockArgsResponseCache())
+	storedResponse := &data.GenericAPIResponse{Error: "original"}
+	rc.Put("key", storedResponse)
+	storedResponse.Error = "changed after put"
+
+	firstResponse := &data.GenericAPIResponse{}
+	_ = rc.Get(context.Background(), "key", firstResponse)
+	firstResponse.Error = "changed after get"
+
+	secondResponse := &data.GenericAPIResponse{}
+	_ = rc.Get(context.Background(), "key", secondResponse)
+	require.Equal(t, "original", secondResponse.Error)
+}
+
+func TestResponseCache_PutShouldEvictWhenSizeIsExceeded(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsResponseCache()
+	args.MaxSizeInBytes = 100
+	rc, _ := cache.NewResponseCache(args)
+
+	rc.Put("key0", "a value of about forty bytes, serialized")
+	rc.Put("key1", "a value of about forty bytes, serialized")
+	rc.Put("key2", "a value of about forty bytes, serialized")
+
+	value := ""
+	require.False(t, rc.Get(context.Background(), "key0", &value))
+	require.True(t, rc.Get(context.Background(), "key2", &value))
+}
+
+func TestResponseCache_ResponsesShouldBeSharedThroughTheSharedStore(t *testing.T) {
+	t.Parallel()
+
+	sharedStore := statestore.NewMemoryStore()
+	args := createMockArgsResponseCache()
+	args.SharedStore = sharedStore
+	rc0, _ := cache.NewResponseCache(args)
+	rc1, _ := cache.NewResponseCache(args)
+
+	block := &data.BlockApiResponse{Code: data.ReturnCodeSuccess}
+	rc0.Put("key", block)
+
+	recovered := &data.BlockApiResponse{}
+	require.Eventually(t, func() bool {
+		return rc1.Get(context.Background(), "key", recovered)
+	}, time.Second, time.Millisecond)
+	require.Equal(t, block, recovered)
+}
+
+func TestResponseCache_SharedStoreFailureShouldNotAffectTheLocalCache(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsResponseCache()
+	args.SharedStore = &mock.SharedStoreStub{
+		GetCalled: func(_ context.Context, _ string) ([]byte, bool, error) {
+			return nil, false, expectedErr
+		},
+		SetCalled: func(_ context.Context, _ string, _ []byte, _ time.Duration) error {
+			return expectedErr
+		},
+	}
+	rc, _ := cache.NewResponseCache(args)
+
+	recovered := &data.BlockApiResponse{}
+	require.False(t, rc.Get(context.Background(), "key", recovered))
+
+	block := &data.BlockApiResponse{Code: data.ReturnCodeSuccess}
+	rc.Put("key", block)
+	require.True(t, rc.Get(context.Background(), "key", recovered))
+	require.Equal(t, block, recovered)
+}
+
+func TestResponseCache_SlowSharedStoreShouldNotHoldTheRequests(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	defer close(chRelease)
+
+	args := createMockArgsResponseCache()
+	args.SharedStore = &mock.SharedStoreStub{
+		GetCalled: func(ctx context.Context, _ string) ([]byte, bool, error) {
+			<-ctx.Done()
+			return nil, false, ctx.Err()
+		},
+		SetCalled: func(_ context.Context, _ string, _ []byte, _ time.Duration) error {
+			<-chRelease
+			return nil
+		},
+	}
+	rc, _ := cache.NewResponseCache(args)
+
+	startTime := time.Now()
+	recovered := &data.BlockApiResponse{}
+	require.False(t, rc.Get(context.Background(), "key", recovered))
+
+	block := &data.BlockApiResponse{Code: data.ReturnCodeSuccess}
+	for i := 0; i < 200; i++ {
+		rc.Put("key", block)
+	}
+	require.True(t, rc.Get(context.Background(), "key", recovered))
+	require.Equal(t, block, recovered)
+	require.Less(t, time.Since(startTime), time.Second)
+}
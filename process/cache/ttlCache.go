@@ -0,0 +1,445 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/common/singleflight"
+)
+
+// fetchTimeout bounds a fetch shared by the concurrent misses of a key, which does not depend on any of the callers'
+// contexts
+const fetchTimeout = 30 * time.Second
+
+// FetchHandler fetches the up-to-date value of a cache entry
+type FetchHandler func(ctx context.Context) (interface{}, error)
+
+// KeyConfig holds the caching settings of a key
+type KeyConfig struct {
+	// TTL is the duration for which a fetched value is considered fresh
+	TTL time.Duration
+	// StaleDuration is the duration, after the TTL passed, for which the value is still returned while being
+	// revalidated in background. After that, the value is dropped and the next request will wait for a fetch
+	StaleDuration time.Duration
+	// RefreshInterval, if positive, makes the refresh scheduler fetch the value proactively at this interval
+	RefreshInterval time.Duration
+	// NewValue, if set, makes the value shared through the shared store: a fresh value fetched by another proxy
+	// instance is used instead of fetching it again. It returns the empty value into which a shared value is decoded
+	NewValue func() interface{}
+}
+
+// sharedTTLCacheValue is the form in which a value is kept in the shared store
+type sharedTTLCacheValue struct {
+	FetchedAt int64           `json:"fetchedAt"`
+	Value     json.RawMessage `json:"value"`
+}
+
+type ttlCacheKey struct {
+	config      KeyConfig
+	fetch       FetchHandler
+	value       interface{}
+	hasValue    bool
+	fetchedAt   time.Time
+	nextRefresh time.Time
+}
+
+// ArgsTTLCache holds the arguments needed for creating a new TTL cache
+type ArgsTTLCache struct {
+	Name           string
+	MetricsHandler CacheMetricsHandler
+	SharedStore    SharedStoreHandler
+}
+
+// ttlCache is a keyed cache in which every key has its own time-to-live. Concurrent misses of the same key are
+// deduplicated, expired values are served while being revalidated in background (within the key's stale duration)
+// and the keys which need to be kept warm are refreshed by a single shared scheduler
+type ttlCache struct {
+	name           string
+	metricsHandler CacheMetricsHandler
+	sharedStore    SharedStoreHandler
+	mutKeys        sync.RWMutex
+	keys           map[string]*ttlCacheKey
+	fetches        *singleflight.Group
+	refreshCtx     context.Context
+	cancelFunc     func()
+	chWakeUp       chan struct{}
+	mutStart       sync.Mutex
+	isStarted      bool
+	getTimeHandler func() time.Time
+}
+
+// NewTTLCache returns a new instance of ttlCache
+func NewTTLCache(args ArgsTTLCache) (*ttlCache, error) {
+	if check.IfNil(args.MetricsHandler) {
+		return nil, ErrNilCacheMetricsHandler
+	}
+	if check.IfNil(args.SharedStore) {
+		return nil, ErrNilSharedStore
+	}
+
+	refreshCtx, cancelFunc := context.WithCancel(context.Background())
+
+	return &ttlCache{
+		name:           args.Name,
+		metricsHandler: args.MetricsHandler,
+		sharedStore:    args.SharedStore,
+		keys:           make(map[string]*ttlCacheKey),
+		fetches:        singleflight.NewGroup(),
+		refreshCtx:     refreshCtx,
+		cancelFunc:     cancelFunc,
+		chWakeUp:       make(chan struct{}, 1),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// Register defines a key, along with its caching settings and the handler used for fetching its value
+func (tc *ttlCache) Register(key string, config KeyConfig, fetch FetchHandler) error {
+	err := checkKeyConfig(key, config)
+	if err != nil {
+		return err
+	}
+	if fetch == nil {
+		return fmt.Errorf("%w for key %s", ErrNilFetchHandler, key)
+	}
+
+	tc.mutKeys.Lock()
+	tc.keys[key] = &ttlCacheKey{
+		config:      config,
+		fetch:       fetch,
+		nextRefresh: tc.getTimeHandler(),
+	}
+	tc.mutKeys.Unlock()
+
+	tc.wakeUpScheduler()
+
+	return nil
+}
+
+// UpdateKeyConfig replaces the caching settings of a registered key. The cached value is kept and its age is checked
+// against the new settings, while the next refresh is rescheduled by the new refresh interval
+func (tc *ttlCache) UpdateKeyConfig(key string, config KeyConfig) error {
+	err := checkKeyConfig(key, config)
+	if err != nil {
+		return err
+	}
+
+	tc.mutKeys.Lock()
+	cacheKey, found := tc.keys[key]
+	if !found {
+		tc.mutKeys.Unlock()
+		return fmt.Errorf("%w: %s", ErrUnknownCacheKey, key)
+	}
+	cacheKey.config = config
+	cacheKey.nextRefresh = cacheKey.fetchedAt.Add(config.RefreshInterval)
+	tc.mutKeys.Unlock()
+
+	tc.wakeUpScheduler()
+
+	return nil
+}
+
+func checkKeyConfig(key string, config KeyConfig) error {
+	if config.TTL <= 0 || config.StaleDuration < 0 || config.RefreshInterval < 0 {
+		return fmt.Errorf("%w for key %s", ErrInvalidKeyConfig, key)
+	}
+
+	return nil
+}
+
+// Get returns the value of the provided key. A fresh value is returned right away, a stale one is returned while
+// being revalidated in background and, if there is no usable value, the value is fetched. Concurrent fetches of the
+// same key are deduplicated
+func (tc *ttlCache) Get(ctx context.Context, key string) (interface{}, error) {
+	tc.mutKeys.RLock()
+	cacheKey, found := tc.keys[key]
+	if !found {
+		tc.mutKeys.RUnlock()
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCacheKey, key)
+	}
+
+	value, hasValue := cacheKey.value, cacheKey.hasValue
+	age := tc.getTimeHandler().Sub(cacheKey.fetchedAt)
+	config := cacheKey.config
+	tc.mutKeys.RUnlock()
+
+	if hasValue && age < config.TTL {
+		tc.metricsHandler.AddCacheHit(tc.name)
+		return value, nil
+	}
+	if hasValue && age < config.TTL+config.StaleDuration {
+		tc.metricsHandler.AddCacheHit(tc.name)
+		tc.revalidateInBackground(key)
+		return value, nil
+	}
+
+	tc.metricsHandler.AddCacheMiss(tc.name)
+
+	return tc.fetch(ctx, key)
+}
+
+// Load returns the value of the provided key, if it is still usable, without fetching it
+func (tc *ttlCache) Load(key string) (interface{}, bool) {
+	tc.mutKeys.RLock()
+	defer tc.mutKeys.RUnlock()
+
+	cacheKey, found := tc.keys[key]
+	if !found || !cacheKey.hasValue {
+		return nil, false
+	}
+
+	age := tc.getTimeHandler().Sub(cacheKey.fetchedAt)
+	if age >= cacheKey.config.TTL+cacheKey.config.StaleDuration {
+		return nil, false
+	}
+
+	return cacheKey.value, true
+}
+
+func (tc *ttlCache) revalidateInBackground(key string) {
+	if tc.fetches.IsInProgress(key) {
+		return
+	}
+
+	go func() {
+		_, err := tc.fetch(tc.refreshCtx, key)
+		if err != nil {
+			log.Debug("cannot revalidate stale cache value", "cache", tc.name, "key", key, "error", err)
+		}
+	}()
+}
+
+type fetchResult struct {
+	value interface{}
+	err   error
+}
+
+// fetch returns the up-to-date value of the provided key. The concurrent fetches of the same key share a single call,
+// which runs on the cache's own context, bounded by fetchTimeout, so it is not aborted when the caller which started
+// it goes away. Each caller, including the one which started the call, stops waiting as soon as its own context is done
+func (tc *ttlCache) fetch(ctx context.Context, key string) (interface{}, error) {
+	chResult := make(chan fetchResult, 1)
+	go func() {
+		value, _, err := tc.fetches.Do(tc.refreshCtx, key, func() (interface{}, error) {
+			fetchCtx, cancel := context.WithTimeout(tc.refreshCtx, fetchTimeout)
+			defer cancel()
+
+			return tc.fetchAndStore(fetchCtx, key)
+		}, nil)
+		chResult <- fetchResult{value: value, err: err}
+	}()
+
+	select {
+	case result := <-chResult:
+		return result.value, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (tc *ttlCache) fetchAndStore(ctx context.Context, key string) (interface{}, error) {
+	tc.mutKeys.RLock()
+	cacheKey, found := tc.keys[key]
+	tc.mutKeys.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCacheKey, key)
+	}
+
+	value, fetchedAt, found := tc.loadShared(ctx, key, cacheKey.config)
+	if !found {
+		var err error
+		value, err = cacheKey.fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		fetchedAt = tc.getTimeHandler()
+		tc.storeShared(ctx, key, cacheKey.config, value, fetchedAt)
+	}
+
+	tc.mutKeys.Lock()
+	cacheKey.value = value
+	cacheKey.hasValue = true
+	cacheKey.fetchedAt = fetchedAt
+	tc.mutKeys.Unlock()
+
+	return value, nil
+}
+
+// loadShared returns the value of the provided key from the shared store, if it is still fresh
+func (tc *ttlCache) loadShared(ctx context.Context, key string, config KeyConfig) (interface{}, time.Time, bool) {
+	if config.NewValue == nil {
+		return nil, time.Time{}, false
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, sharedStoreReadTimeout)
+	defer cancel()
+
+	buff, found, err := tc.sharedStore.Get(readCtx, tc.sharedKey(key))
+	if err != nil {
+		log.Debug("cannot load shared cache value", "cache", tc.name, "key", key, "error", err)
+		return nil, time.Time{}, false
+	}
+	if !found {
+		return nil, time.Time{}, false
+	}
+
+	sharedValue := &sharedTTLCacheValue{}
+	err = json.Unmarshal(buff, sharedValue)
+	if err != nil {
+		log.Warn("cannot unmarshal shared cache value", "cache", tc.name, "key", key, "error", err)
+		return nil, time.Time{}, false
+	}
+
+	fetchedAt := time.Unix(0, sharedValue.FetchedAt)
+	if tc.getTimeHandler().Sub(fetchedAt) >= config.TTL {
+		return nil, time.Time{}, false
+	}
+
+	value := config.NewValue()
+	err = json.Unmarshal(sharedValue.Value, value)
+	if err != nil {
+		log.Warn("cannot unmarshal shared cache value", "cache", tc.name, "key", key, "error", err)
+		return nil, time.Time{}, false
+	}
+
+	return value, fetchedAt, true
+}
+
+// storeShared puts the provided value in the shared store, so the other proxy instances can use it
+func (tc *ttlCache) storeShared(ctx context.Context, key string, config KeyConfig, value interface{}, fetchedAt time.Time) {
+	if config.NewValue == nil {
+		return
+	}
+
+	valueBuff, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("cannot marshal shared cache value", "cache", tc.name, "key", key, "error", err)
+		return
+	}
+
+	buff, err := json.Marshal(&sharedTTLCacheValue{
+		FetchedAt: fetchedAt.UnixNano(),
+		Value:     valueBuff,
+	})
+	if err != nil {
+		log.Warn("cannot marshal shared cache value", "cache", tc.name, "key", key, "error", err)
+		return
+	}
+
+	writeCtx, cancel := context.WithTimeout(ctx, sharedStoreWriteTimeout)
+	defer cancel()
+
+	err = tc.sharedStore.Set(writeCtx, tc.sharedKey(key), buff, config.TTL)
+	if err != nil {
+		log.Debug("cannot store shared cache value", "cache", tc.name, "key", key, "error", err)
+	}
+}
+
+func (tc *ttlCache) sharedKey(key string) string {
+	return sharedKeyPrefix + tc.name + ":" + key
+}
+
+// StartRefresh starts the scheduler which refreshes the keys that have a refresh interval
+func (tc *ttlCache) StartRefresh() {
+	tc.mutStart.Lock()
+	defer tc.mutStart.Unlock()
+
+	if tc.isStarted {
+		log.Error("ttlCache - refresh already started", "cache", tc.name)
+		return
+	}
+	tc.isStarted = true
+
+	go tc.refreshLoop()
+}
+
+func (tc *ttlCache) refreshLoop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-tc.chWakeUp:
+		case <-tc.refreshCtx.Done():
+			log.Debug("finishing ttlCache refresh...", "cache", tc.name)
+			return
+		}
+
+		untilNextRefresh := tc.refreshDueKeys()
+		resetTimer(timer, untilNextRefresh)
+	}
+}
+
+// refreshDueKeys launches the refresh of the keys whose refresh time came and returns the duration until the next
+// refresh is due
+func (tc *ttlCache) refreshDueKeys() time.Duration {
+	const idleInterval = time.Minute
+
+	untilNextRefresh := idleInterval
+	dueKeys := make([]string, 0)
+
+	tc.mutKeys.Lock()
+	now := tc.getTimeHandler()
+	for key, cacheKey := range tc.keys {
+		if cacheKey.config.RefreshInterval <= 0 {
+			continue
+		}
+
+		if !now.Before(cacheKey.nextRefresh) {
+			dueKeys = append(dueKeys, key)
+			cacheKey.nextRefresh = now.Add(cacheKey.config.RefreshInterval)
+		}
+
+		untilKeyRefresh := cacheKey.nextRefresh.Sub(now)
+		if untilKeyRefresh < untilNextRefresh {
+			untilNextRefresh = untilKeyRefresh
+		}
+	}
+	tc.mutKeys.Unlock()
+
+	for _, key := range dueKeys {
+		go func(key string) {
+			_, err := tc.fetch(tc.refreshCtx, key)
+			if err != nil {
+				log.Warn("cannot refresh cache value", "cache", tc.name, "key", key, "error", err)
+			}
+		}(key)
+	}
+
+	return untilNextRefresh
+}
+
+func (tc *ttlCache) wakeUpScheduler() {
+	select {
+	case tc.chWakeUp <- struct{}{}:
+	default:
+	}
+}
+
+func resetTimer(timer *time.Timer, duration time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+
+	timer.Reset(duration)
+}
+
+// Close stops the refresh scheduler and cancels the background fetches
+func (tc *ttlCache) Close() error {
+	tc.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tc *ttlCache) IsInterfaceNil() bool {
+	return tc == nil
+}
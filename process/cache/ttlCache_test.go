@@ -0,0 +1,467 @@
+package cache_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	"github.com/stretchr/testify/require"
+)
+
+type manualClock struct {
+	mut         sync.Mutex
+	currentTime time.Time
+}
+
+func (mc *manualClock) now() time.Time {
+	mc.mut.Lock()
+	defer mc.mut.Unlock()
+
+	return mc.currentTime
+}
+
+func (mc *manualClock) advance(duration time.Duration) {
+	mc.mut.Lock()
+	mc.currentTime = mc.currentTime.Add(duration)
+	mc.mut.Unlock()
+}
+
+func createTestTTLCache(t *testing.T) (cache.TTLCacheForTests, *manualClock) {
+	tc, err := cache.NewTTLCache(cache.ArgsTTLCache{
+		Name:           "test",
+		MetricsHandler: &mock.CacheMetricsHandlerStub{},
+		SharedStore:    &mock.SharedStoreStub{},
+	})
+	require.Nil(t, err)
+
+	clock := &manualClock{currentTime: time.Unix(1000, 0)}
+	tc.SetTimeHandler(clock.now)
+
+	return tc, clock
+}
+
+func TestNewTTLCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics handler should err", func(t *testing.T) {
+		t.Parallel()
+
+		tc, err := cache.NewTTLCache(cache.ArgsTTLCache{SharedStore: &mock.SharedStoreStub{}})
+		require.Nil(t, tc)
+		require.Equal(t, cache.ErrNilCacheMetricsHandler, err)
+	})
+	t.Run("nil shared store should err", func(t *testing.T) {
+		t.Parallel()
+
+		tc, err := cache.NewTTLCache(cache.ArgsTTLCache{MetricsHandler: &mock.CacheMetricsHandlerStub{}})
+		require.Nil(t, tc)
+		require.Equal(t, cache.ErrNilSharedStore, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tc, err := cache.NewTTLCache(cache.ArgsTTLCache{
+			MetricsHandler: &mock.CacheMetricsHandlerStub{},
+			SharedStore:    &mock.SharedStoreStub{},
+		})
+		require.Nil(t, err)
+		require.False(t, tc.IsInterfaceNil())
+	})
+}
+
+func TestTTLCache_Register(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := createTestTTLCache(t)
+	fetch := func(ctx context.Context) (interface{}, error) {
+		return nil, nil
+	}
+
+	err := tc.Register("key", cache.KeyConfig{}, fetch)
+	require.True(t, errors.Is(err, cache.ErrInvalidKeyConfig))
+
+	err = tc.Register("key", cache.KeyConfig{TTL: time.Second, StaleDuration: -time.Second}, fetch)
+	require.True(t, errors.Is(err, cache.ErrInvalidKeyConfig))
+
+	err = tc.Register("key", cache.KeyConfig{TTL: time.Second}, nil)
+	require.True(t, errors.Is(err, cache.ErrNilFetchHandler))
+
+	err = tc.Register("key", cache.KeyConfig{TTL: time.Second}, fetch)
+	require.Nil(t, err)
+}
+
+func TestTTLCache_GetUnknownKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := createTestTTLCache(t)
+
+	value, err := tc.Get(context.Background(), "missing")
+	require.Nil(t, value)
+	require.True(t, errors.Is(err, cache.ErrUnknownCacheKey))
+}
+
+func TestTTLCache_GetShouldFetchOnlyAfterTTL(t *testing.T) {
+	t.Parallel()
+
+	tc, clock := createTestTTLCache(t)
+	numFetches := int32(0)
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		return atomic.AddInt32(&numFetches, 1), nil
+	})
+
+	value, err := tc.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.Equal(t, int32(1), value)
+
+	clock.advance(59 * time.Second)
+	value, _ = tc.Get(context.Background(), "key")
+	require.Equal(t, int32(1), value)
+
+	clock.advance(time.Second)
+	value, _ = tc.Get(context.Background(), "key")
+	require.Equal(t, int32(2), value)
+}
+
+func TestTTLCache_UpdateKeyConfigShouldApplyNewTTLToCachedValue(t *testing.T) {
+	t.Parallel()
+
+	tc, clock := createTestTTLCache(t)
+	numFetches := int32(0)
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		return atomic.AddInt32(&numFetches, 1), nil
+	})
+
+	err := tc.UpdateKeyConfig("missing", cache.KeyConfig{TTL: time.Minute})
+	require.True(t, errors.Is(err, cache.ErrUnknownCacheKey))
+	err = tc.UpdateKeyConfig("key", cache.KeyConfig{})
+	require.True(t, errors.Is(err, cache.ErrInvalidKeyConfig))
+
+	value, _ := tc.Get(context.Background(), "key")
+	require.Equal(t, int32(1), value)
+
+	err = tc.UpdateKeyConfig("key", cache.KeyConfig{TTL: 2 * time.Minute})
+	require.Nil(t, err)
+
+	clock.advance(90 * time.Second)
+	value, _ = tc.Get(context.Background(), "key")
+	require.Equal(t, int32(1), value)
+
+	err = tc.UpdateKeyConfig("key", cache.KeyConfig{TTL: 30 * time.Second})
+	require.Nil(t, err)
+
+	value, _ = tc.Get(context.Background(), "key")
+	require.Equal(t, int32(2), value)
+}
+
+func TestTTLCache_GetShouldServeStaleValueWhileRevalidating(t *testing.T) {
+	t.Parallel()
+
+	tc, clock := createTestTTLCache(t)
+	numFetches := int32(0)
+	chRevalidated := make(chan struct{}, 1)
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute, StaleDuration: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		newValue := atomic.AddInt32(&numFetches, 1)
+		if newValue > 1 {
+			chRevalidated <- struct{}{}
+		}
+		return newValue, nil
+	})
+
+	_, _ = tc.Get(context.Background(), "key")
+	clock.advance(90 * time.Second)
+
+	value, err := tc.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.Equal(t, int32(1), value)
+
+	select {
+	case <-chRevalidated:
+	case <-time.After(time.Second):
+		require.Fail(t, "stale value was not revalidated")
+	}
+
+	require.Eventually(t, func() bool {
+		value, _ = tc.Get(context.Background(), "key")
+		return value == int32(2)
+	}, time.Second, time.Millisecond)
+}
+
+func TestTTLCache_GetAfterStaleDurationShouldFetch(t *testing.T) {
+	t.Parallel()
+
+	tc, clock := createTestTTLCache(t)
+	expectedErr := errors.New("expected error")
+	shouldFail := false
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute, StaleDuration: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		if shouldFail {
+			return nil, expectedErr
+		}
+		return "value", nil
+	})
+
+	_, _ = tc.Get(context.Background(), "key")
+	shouldFail = true
+	clock.advance(2 * time.Minute)
+
+	value, err := tc.Get(context.Background(), "key")
+	require.Nil(t, value)
+	require.Equal(t, expectedErr, err)
+
+	value, found := tc.Load("key")
+	require.Nil(t, value)
+	require.False(t, found)
+}
+
+func TestTTLCache_ConcurrentMissesShouldFetchOnce(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := createTestTTLCache(t)
+	numFetches := int32(0)
+	chRelease := make(chan struct{})
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		atomic.AddInt32(&numFetches, 1)
+		<-chRelease
+		return "value", nil
+	})
+
+	numCallers := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func() {
+			value, err := tc.Get(context.Background(), "key")
+			require.Nil(t, err)
+			require.Equal(t, "value", value)
+			wg.Done()
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return tc.IsFetchInProgress("key")
+	}, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(chRelease)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&numFetches))
+}
+
+func TestTTLCache_SharedFetchShouldNotBeAbortedWhenTheFirstCallerGoesAway(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := createTestTTLCache(t)
+	chRelease := make(chan struct{})
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		select {
+		case <-chRelease:
+			return "value", nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	})
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	chFirstDone := make(chan error, 1)
+	go func() {
+		_, err := tc.Get(firstCtx, "key")
+		chFirstDone <- err
+	}()
+	require.Eventually(t, func() bool {
+		return tc.IsFetchInProgress("key")
+	}, time.Second, time.Millisecond)
+
+	chSecondDone := make(chan interface{}, 1)
+	go func() {
+		value, err := tc.Get(context.Background(), "key")
+		require.Nil(t, err)
+		chSecondDone <- value
+	}()
+
+	cancelFirst()
+	require.Equal(t, context.Canceled, <-chFirstDone)
+
+	close(chRelease)
+	require.Equal(t, "value", <-chSecondDone)
+
+	value, found := tc.Load("key")
+	require.True(t, found)
+	require.Equal(t, "value", value)
+}
+
+func TestTTLCache_GetShouldRecordHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	numHits, numMisses := int32(0), int32(0)
+	tc, _ := cache.NewTTLCache(cache.ArgsTTLCache{
+		Name: "test",
+		MetricsHandler: &mock.CacheMetricsHandlerStub{
+			AddCacheHitCalled: func(cacheName string) {
+				atomic.AddInt32(&numHits, 1)
+			},
+			AddCacheMissCalled: func(cacheName string) {
+				atomic.AddInt32(&numMisses, 1)
+			},
+		},
+		SharedStore: &mock.SharedStoreStub{},
+	})
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		return "value", nil
+	})
+
+	_, _ = tc.Get(context.Background(), "key")
+	_, _ = tc.Get(context.Background(), "key")
+	_, _ = tc.Get(context.Background(), "key")
+
+	require.Equal(t, int32(2), atomic.LoadInt32(&numHits))
+	require.Equal(t, int32(1), atomic.LoadInt32(&numMisses))
+}
+
+func TestTTLCache_StartRefreshShouldRefreshRegisteredKeys(t *testing.T) {
+	t.Parallel()
+
+	tc, err := cache.NewTTLCache(cache.ArgsTTLCache{
+		Name:           "test",
+		MetricsHandler: &mock.CacheMetricsHandlerStub{},
+		SharedStore:    &mock.SharedStoreStub{},
+	})
+	require.Nil(t, err)
+
+	numRefreshedFetches := int32(0)
+	numOnDemandFetches := int32(0)
+	_ = tc.Register("refreshed", cache.KeyConfig{TTL: 20 * time.Millisecond, RefreshInterval: 20 * time.Millisecond},
+		func(ctx context.Context) (interface{}, error) {
+			return atomic.AddInt32(&numRefreshedFetches, 1), nil
+		})
+	_ = tc.Register("on-demand", cache.KeyConfig{TTL: 20 * time.Millisecond},
+		func(ctx context.Context) (interface{}, error) {
+			return atomic.AddInt32(&numOnDemandFetches, 1), nil
+		})
+
+	tc.StartRefresh()
+	defer func() {
+		_ = tc.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numRefreshedFetches) >= 3
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int32(0), atomic.LoadInt32(&numOnDemandFetches))
+
+	value, found := tc.Load("refreshed")
+	require.True(t, found)
+	require.NotNil(t, value)
+
+	_ = tc.Close()
+	time.Sleep(50 * time.Millisecond)
+	numFetchesAfterClose := atomic.LoadInt32(&numRefreshedFetches)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, numFetchesAfterClose, atomic.LoadInt32(&numRefreshedFetches))
+}
+
+func TestTTLCache_FreshSharedValueShouldBeUsedInsteadOfFetching(t *testing.T) {
+	t.Parallel()
+
+	sharedStore := statestore.NewMemoryStore()
+	createInstance := func() cache.TTLCacheForTests {
+		tc, err := cache.NewTTLCache(cache.ArgsTTLCache{
+			Name:           "test",
+			MetricsHandler: &mock.CacheMetricsHandlerStub{},
+			SharedStore:    sharedStore,
+		})
+		require.Nil(t, err)
+
+		return tc
+	}
+
+	numFetches := int32(0)
+	keyConfig := cache.KeyConfig{
+		TTL: time.Minute,
+		NewValue: func() interface{} {
+			return &data.GenericAPIResponse{}
+		},
+	}
+	fetch := func(ctx context.Context) (interface{}, error) {
+		atomic.AddInt32(&numFetches, 1)
+		return &data.GenericAPIResponse{Data: "value", Code: data.ReturnCodeSuccess}, nil
+	}
+
+	tc0 := createInstance()
+	tc1 := createInstance()
+	_ = tc0.Register("key", keyConfig, fetch)
+	_ = tc1.Register("key", keyConfig, fetch)
+
+	value0, err := tc0.Get(context.Background(), "key")
+	require.Nil(t, err)
+	value1, err := tc1.Get(context.Background(), "key")
+	require.Nil(t, err)
+
+	require.Equal(t, value0, value1)
+	require.Equal(t, int32(1), atomic.LoadInt32(&numFetches))
+}
+
+func TestTTLCache_ExpiredSharedValueShouldBeFetched(t *testing.T) {
+	t.Parallel()
+
+	tc, clock := createTestTTLCache(t)
+	staleValue, _ := json.Marshal(map[string]interface{}{
+		"fetchedAt": clock.now().Add(-time.Minute).UnixNano(),
+		"value":     "stale",
+	})
+	keysSet := make([]string, 0)
+	tc.SetSharedStore(&mock.SharedStoreStub{
+		GetCalled: func(_ context.Context, _ string) ([]byte, bool, error) {
+			return staleValue, true, nil
+		},
+		SetCalled: func(_ context.Context, key string, _ []byte, expiration time.Duration) error {
+			keysSet = append(keysSet, key)
+			require.Equal(t, time.Minute, expiration)
+			return nil
+		},
+	})
+
+	keyConfig := cache.KeyConfig{
+		TTL: time.Minute,
+		NewValue: func() interface{} {
+			return new(string)
+		},
+	}
+	_ = tc.Register("key", keyConfig, func(ctx context.Context) (interface{}, error) {
+		return "fetched", nil
+	})
+
+	value, err := tc.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.Equal(t, "fetched", value)
+	require.Equal(t, []string{"cache:test:key"}, keysSet)
+}
+
+func TestTTLCache_KeysWithoutNewValueShouldNotBeShared(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := createTestTTLCache(t)
+	tc.SetSharedStore(&mock.SharedStoreStub{
+		GetCalled: func(_ context.Context, _ string) ([]byte, bool, error) {
+			require.Fail(t, "should have not been called")
+			return nil, false, nil
+		},
+		SetCalled: func(_ context.Context, _ string, _ []byte, _ time.Duration) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	})
+	_ = tc.Register("key", cache.KeyConfig{TTL: time.Minute}, func(ctx context.Context) (interface{}, error) {
+		return "value", nil
+	})
+
+	value, err := tc.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.Equal(t, "value", value)
+}
@@ -0,0 +1,9 @@
+package circuitbreaker
+
+import "errors"
+
+// ErrInvalidFailureThreshold signals that an invalid failure threshold has been provided
+var ErrInvalidFailureThreshold = errors.New("invalid failure threshold")
+
+// ErrInvalidCooldownDuration signals that an invalid cooldown duration has been provided
+var ErrInvalidCooldownDuration = errors.New("invalid cooldown duration")
@@ -0,0 +1,9 @@
+package circuitbreaker
+
+import "time"
+
+func (ncb *nodesCircuitBreaker) SetGetTimeFunc(getTimeFunc func() time.Time) {
+	ncb.mutBreakers.Lock()
+	ncb.getTimeFunc = getTimeFunc
+	ncb.mutBreakers.Unlock()
+}
@@ -0,0 +1,169 @@
+package circuitbreaker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/circuitbreaker")
+
+type nodeBreaker struct {
+	state               data.CircuitBreakerState
+	consecutiveFailures uint32
+	lastStateChange     time.Time
+	isProbeInProgress   bool
+	probeStartTime      time.Time
+}
+
+// nodesCircuitBreaker holds a circuit breaker for each node. After a number of consecutive transport errors,
+// the node's breaker opens and the requests towards it are rejected for a cooldown period. After the cooldown,
+// a single probe request is allowed (half-open state): on success the breaker closes, on failure it opens again. If the
+// probe's outcome is never reported (e.g. the request was aborted by the caller), another probe is allowed after a new
+// cooldown period
+type nodesCircuitBreaker struct {
+	mutBreakers      sync.Mutex
+	breakers         map[string]*nodeBreaker
+	failureThreshold uint32
+	cooldownDuration time.Duration
+	getTimeFunc      func() time.Time
+}
+
+// NewNodesCircuitBreaker returns a new instance of nodesCircuitBreaker
+func NewNodesCircuitBreaker(failureThreshold uint32, cooldownDuration time.Duration) (*nodesCircuitBreaker, error) {
+	if failureThreshold == 0 {
+		return nil, ErrInvalidFailureThreshold
+	}
+	if cooldownDuration <= 0 {
+		return nil, ErrInvalidCooldownDuration
+	}
+
+	return &nodesCircuitBreaker{
+		breakers:         make(map[string]*nodeBreaker),
+		failureThreshold: failureThreshold,
+		cooldownDuration: cooldownDuration,
+		getTimeFunc:      time.Now,
+	}, nil
+}
+
+// IsRequestAllowed returns true if a request can be sent towards the node with the provided address
+func (ncb *nodesCircuitBreaker) IsRequestAllowed(address string) bool {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		return true
+	}
+
+	switch breaker.state {
+	case data.CircuitBreakerOpen:
+		if ncb.getTimeFunc().Sub(breaker.lastStateChange) < ncb.cooldownDuration {
+			return false
+		}
+
+		ncb.changeStateUnprotected(address, breaker, data.CircuitBreakerHalfOpen)
+		ncb.startProbeUnprotected(breaker)
+		return true
+	case data.CircuitBreakerHalfOpen:
+		isProbeExpired := ncb.getTimeFunc().Sub(breaker.probeStartTime) >= ncb.cooldownDuration
+		if breaker.isProbeInProgress && !isProbeExpired {
+			return false
+		}
+
+		ncb.startProbeUnprotected(breaker)
+		return true
+	default:
+		return true
+	}
+}
+
+// RecordSuccess marks a successful request towards the node with the provided address
+func (ncb *nodesCircuitBreaker) RecordSuccess(address string) {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		return
+	}
+
+	breaker.consecutiveFailures = 0
+	breaker.isProbeInProgress = false
+	if breaker.state != data.CircuitBreakerClosed {
+		ncb.changeStateUnprotected(address, breaker, data.CircuitBreakerClosed)
+	}
+}
+
+// RecordFailure marks a failed request towards the node with the provided address
+func (ncb *nodesCircuitBreaker) RecordFailure(address string) {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		breaker = &nodeBreaker{
+			state:           data.CircuitBreakerClosed,
+			lastStateChange: ncb.getTimeFunc(),
+		}
+		ncb.breakers[address] = breaker
+	}
+
+	breaker.consecutiveFailures++
+	breaker.isProbeInProgress = false
+
+	switch breaker.state {
+	case data.CircuitBreakerHalfOpen:
+		ncb.changeStateUnprotected(address, breaker, data.CircuitBreakerOpen)
+	case data.CircuitBreakerClosed:
+		if breaker.consecutiveFailures >= ncb.failureThreshold {
+			ncb.changeStateUnprotected(address, breaker, data.CircuitBreakerOpen)
+		}
+	}
+}
+
+func (ncb *nodesCircuitBreaker) startProbeUnprotected(breaker *nodeBreaker) {
+	breaker.isProbeInProgress = true
+	breaker.probeStartTime = ncb.getTimeFunc()
+}
+
+func (ncb *nodesCircuitBreaker) changeStateUnprotected(address string, breaker *nodeBreaker, newState data.CircuitBreakerState) {
+	log.Debug("circuit breaker state changed",
+		"address", address,
+		"old state", breaker.state,
+		"new state", newState,
+		"consecutive failures", breaker.consecutiveFailures)
+
+	breaker.state = newState
+	breaker.lastStateChange = ncb.getTimeFunc()
+}
+
+// GetStatuses returns the statuses of all the circuit breakers, sorted by the nodes' addresses
+func (ncb *nodesCircuitBreaker) GetStatuses() []*data.NodeCircuitBreakerStatus {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	statuses := make([]*data.NodeCircuitBreakerStatus, 0, len(ncb.breakers))
+	for address, breaker := range ncb.breakers {
+		statuses = append(statuses, &data.NodeCircuitBreakerStatus{
+			Address:             address,
+			State:               breaker.state,
+			ConsecutiveFailures: breaker.consecutiveFailures,
+			LastStateChange:     breaker.lastStateChange,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Address < statuses[j].Address
+	})
+
+	return statuses
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncb *nodesCircuitBreaker) IsInterfaceNil() bool {
+	return ncb == nil
+}
@@ -0,0 +1,185 @@
+package circuitbreaker_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/circuitbreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "http://observer:8080"
+
+func TestNewNodesCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid failure threshold - should error", func(t *testing.T) {
+		t.Parallel()
+
+		ncb, err := circuitbreaker.NewNodesCircuitBreaker(0, time.Second)
+		assert.Nil(t, ncb)
+		assert.Equal(t, circuitbreaker.ErrInvalidFailureThreshold, err)
+	})
+
+	t.Run("invalid cooldown duration - should error", func(t *testing.T) {
+		t.Parallel()
+
+		ncb, err := circuitbreaker.NewNodesCircuitBreaker(3, 0)
+		assert.Nil(t, ncb)
+		assert.Equal(t, circuitbreaker.ErrInvalidCooldownDuration, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ncb, err := circuitbreaker.NewNodesCircuitBreaker(3, time.Second)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ncb))
+	})
+}
+
+func TestNodesCircuitBreaker_ShouldOpenAfterThresholdIsReached(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(3, time.Minute)
+
+	ncb.RecordFailure(testAddress)
+	ncb.RecordFailure(testAddress)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+
+	ncb.RecordFailure(testAddress)
+	assert.False(t, ncb.IsRequestAllowed(testAddress))
+	assert.True(t, ncb.IsRequestAllowed("another address"))
+
+	statuses := ncb.GetStatuses()
+	require.Equal(t, 1, len(statuses))
+	assert.Equal(t, testAddress, statuses[0].Address)
+	assert.Equal(t, data.CircuitBreakerOpen, statuses[0].State)
+	assert.Equal(t, uint32(3), statuses[0].ConsecutiveFailures)
+}
+
+func TestNodesCircuitBreaker_SuccessShouldResetTheFailuresCounter(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(2, time.Minute)
+
+	ncb.RecordFailure(testAddress)
+	ncb.RecordSuccess(testAddress)
+	ncb.RecordFailure(testAddress)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+
+	statuses := ncb.GetStatuses()
+	require.Equal(t, 1, len(statuses))
+	assert.Equal(t, data.CircuitBreakerClosed, statuses[0].State)
+	assert.Equal(t, uint32(1), statuses[0].ConsecutiveFailures)
+}
+
+func TestNodesCircuitBreaker_HalfOpenShouldAllowASingleProbe(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(1, time.Minute)
+	ncb.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	ncb.RecordFailure(testAddress)
+	assert.False(t, ncb.IsRequestAllowed(testAddress))
+
+	currentTime = currentTime.Add(time.Minute)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+	assert.False(t, ncb.IsRequestAllowed(testAddress))
+	assert.Equal(t, data.CircuitBreakerHalfOpen, ncb.GetStatuses()[0].State)
+
+	ncb.RecordSuccess(testAddress)
+	assert.Equal(t, data.CircuitBreakerClosed, ncb.GetStatuses()[0].State)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+}
+
+func TestNodesCircuitBreaker_FailedProbeShouldReopenTheCircuit(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(1, time.Minute)
+	ncb.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	ncb.RecordFailure(testAddress)
+	currentTime = currentTime.Add(time.Minute)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+
+	ncb.RecordFailure(testAddress)
+	assert.Equal(t, data.CircuitBreakerOpen, ncb.GetStatuses()[0].State)
+	assert.False(t, ncb.IsRequestAllowed(testAddress))
+
+	currentTime = currentTime.Add(time.Minute)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+}
+
+func TestNodesCircuitBreaker_UnreportedProbeShouldExpire(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(1, time.Minute)
+	ncb.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	ncb.RecordFailure(testAddress)
+	currentTime = currentTime.Add(time.Minute)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+	assert.False(t, ncb.IsRequestAllowed(testAddress))
+
+	currentTime = currentTime.Add(time.Minute)
+	assert.True(t, ncb.IsRequestAllowed(testAddress))
+	assert.False(t, ncb.IsRequestAllowed(testAddress))
+}
+
+func TestNodesCircuitBreaker_GetStatusesShouldBeSortedByAddress(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(1, time.Minute)
+	ncb.RecordFailure("addr2")
+	ncb.RecordFailure("addr0")
+	ncb.RecordFailure("addr1")
+
+	statuses := ncb.GetStatuses()
+	require.Equal(t, 3, len(statuses))
+	assert.Equal(t, "addr0", statuses[0].Address)
+	assert.Equal(t, "addr1", statuses[1].Address)
+	assert.Equal(t, "addr2", statuses[2].Address)
+}
+
+func TestNodesCircuitBreaker_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := circuitbreaker.NewNodesCircuitBreaker(2, time.Millisecond)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 4 {
+			case 0:
+				ncb.RecordFailure(testAddress)
+			case 1:
+				ncb.RecordSuccess(testAddress)
+			case 2:
+				_ = ncb.IsRequestAllowed(testAddress)
+			default:
+				_ = ncb.GetStatuses()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
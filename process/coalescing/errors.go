@@ -0,0 +1,6 @@
+package coalescing
+
+import "errors"
+
+// ErrNilCoalescingMetricsHandler signals that a nil coalescing metrics handler has been provided
+var ErrNilCoalescingMetricsHandler = errors.New("nil coalescing metrics handler")
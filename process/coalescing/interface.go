@@ -0,0 +1,7 @@
+package coalescing
+
+// CoalescingMetricsHandler defines what a component which records the coalesced requests should be able to do
+type CoalescingMetricsHandler interface {
+	AddCoalescedRequest(address string)
+	IsInterfaceNil() bool
+}
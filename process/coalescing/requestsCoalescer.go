@@ -0,0 +1,46 @@
+package coalescing
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/common/singleflight"
+)
+
+// requestsCoalescer makes sure that identical requests towards the same node, issued while one of them is still in
+// progress, result in a single upstream call. The callers which join a request in progress share its result
+type requestsCoalescer struct {
+	metricsHandler CoalescingMetricsHandler
+	requests       *singleflight.Group
+}
+
+// NewRequestsCoalescer returns a new instance of requestsCoalescer
+func NewRequestsCoalescer(metricsHandler CoalescingMetricsHandler) (*requestsCoalescer, error) {
+	if check.IfNil(metricsHandler) {
+		return nil, ErrNilCoalescingMetricsHandler
+	}
+
+	return &requestsCoalescer{
+		metricsHandler: metricsHandler,
+		requests:       singleflight.NewGroup(),
+	}, nil
+}
+
+// CoalesceRequest executes the provided handler, unless an identical request towards the same node is already in
+// progress, case in which it waits for that request and returns its result. The returned flag is true if the result
+// is shared with another caller. A caller waiting for a shared result stops waiting as soon as its context is done
+func (rc *requestsCoalescer) CoalesceRequest(
+	ctx context.Context,
+	address string,
+	path string,
+	handler func() (interface{}, error),
+) (interface{}, bool, error) {
+	return rc.requests.Do(ctx, address+path, handler, func() {
+		rc.metricsHandler.AddCoalescedRequest(address)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *requestsCoalescer) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,172 @@
+package coalescing_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/coalescing"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequestsCoalescer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics handler - should error", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := coalescing.NewRequestsCoalescer(nil)
+		assert.True(t, check.IfNil(rc))
+		assert.Equal(t, coalescing.ErrNilCoalescingMetricsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := coalescing.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{})
+		assert.False(t, check.IfNil(rc))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRequestsCoalescer_IdenticalRequestsShouldBeExecutedOnce(t *testing.T) {
+	t.Parallel()
+
+	numCoalesced := int32(0)
+	rc, _ := coalescing.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{
+		AddCoalescedRequestCalled: func(address string) {
+			assert.Equal(t, "addr", address)
+			atomic.AddInt32(&numCoalesced, 1)
+		},
+	})
+
+	numExecutions := int32(0)
+	chRelease := make(chan struct{})
+	handler := func() (interface{}, error) {
+		atomic.AddInt32(&numExecutions, 1)
+		<-chRelease
+		return "result", nil
+	}
+
+	numCallers := 10
+	numShared := int32(0)
+	wg := sync.WaitGroup{}
+	wg.Add(numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func() {
+			defer wg.Done()
+
+			result, isShared, err := rc.CoalesceRequest(context.Background(), "addr", "/path?a=b", handler)
+			assert.Nil(t, err)
+			assert.Equal(t, "result", result)
+			if isShared {
+				atomic.AddInt32(&numShared, 1)
+			}
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numCoalesced) == int32(numCallers-1)
+	}, time.Second, time.Millisecond)
+	close(chRelease)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numExecutions))
+	assert.Equal(t, int32(numCallers-1), atomic.LoadInt32(&numShared))
+}
+
+func TestRequestsCoalescer_DifferentRequestsShouldNotBeCoalesced(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := coalescing.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{
+		AddCoalescedRequestCalled: func(address string) {
+			assert.Fail(t, "should have not coalesced")
+		},
+	})
+
+	numExecutions := int32(0)
+	chRelease := make(chan struct{})
+	handler := func() (interface{}, error) {
+		atomic.AddInt32(&numExecutions, 1)
+		<-chRelease
+		return nil, nil
+	}
+
+	requests := [][2]string{{"addr0", "/path0"}, {"addr0", "/path1"}, {"addr1", "/path0"}}
+	wg := sync.WaitGroup{}
+	wg.Add(len(requests))
+	for _, request := range requests {
+		go func(address string, path string) {
+			_, isShared, _ := rc.CoalesceRequest(context.Background(), address, path, handler)
+			assert.False(t, isShared)
+			wg.Done()
+		}(request[0], request[1])
+	}
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numExecutions) == int32(len(requests))
+	}, time.Second, time.Millisecond)
+	close(chRelease)
+	wg.Wait()
+}
+
+func TestRequestsCoalescer_WaiterShouldStopWhenItsContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	chCoalesced := make(chan struct{}, 1)
+	rc, _ := coalescing.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{
+		AddCoalescedRequestCalled: func(address string) {
+			chCoalesced <- struct{}{}
+		},
+	})
+
+	chRelease := make(chan struct{})
+	chStarted := make(chan struct{})
+	go func() {
+		_, _, _ = rc.CoalesceRequest(context.Background(), "addr", "/path", func() (interface{}, error) {
+			close(chStarted)
+			<-chRelease
+			return nil, nil
+		})
+	}()
+	<-chStarted
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-chCoalesced
+		cancel()
+	}()
+
+	result, isShared, err := rc.CoalesceRequest(ctx, "addr", "/path", func() (interface{}, error) {
+		assert.Fail(t, "should have not been called")
+		return nil, nil
+	})
+	assert.Nil(t, result)
+	assert.True(t, isShared)
+	assert.Equal(t, context.Canceled, err)
+
+	close(chRelease)
+}
+
+func TestRequestsCoalescer_RequestAfterCompletionShouldBeExecutedAgain(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := coalescing.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{})
+
+	numExecutions := int32(0)
+	handler := func() (interface{}, error) {
+		return atomic.AddInt32(&numExecutions, 1), nil
+	}
+
+	result, isShared, _ := rc.CoalesceRequest(context.Background(), "addr", "/path", handler)
+	assert.Equal(t, int32(1), result)
+	assert.False(t, isShared)
+
+	result, isShared, _ = rc.CoalesceRequest(context.Background(), "addr", "/path", handler)
+	assert.Equal(t, int32(2), result)
+	assert.False(t, isShared)
+}
@@ -0,0 +1,30 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// CircuitBreaker represents a disabled struct that implements the CircuitBreakerHandler interface
+type CircuitBreaker struct {
+}
+
+// IsRequestAllowed returns true as this is a disabled component
+func (cb *CircuitBreaker) IsRequestAllowed(_ string) bool {
+	return true
+}
+
+// RecordSuccess won't do anything as this is a disabled component
+func (cb *CircuitBreaker) RecordSuccess(_ string) {
+}
+
+// RecordFailure won't do anything as this is a disabled component
+func (cb *CircuitBreaker) RecordFailure(_ string) {
+}
+
+// GetStatuses returns an empty slice as this is a disabled component
+func (cb *CircuitBreaker) GetStatuses() []*data.NodeCircuitBreakerStatus {
+	return make([]*data.NodeCircuitBreakerStatus, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cb *CircuitBreaker) IsInterfaceNil() bool {
+	return cb == nil
+}
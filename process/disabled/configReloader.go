@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/configreload"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const configReloadNotEnabledError = "config reload is not enabled"
+
+// ConfigReloader represents a disabled struct that implements the ConfigReloaderHandler interface
+type ConfigReloader struct {
+}
+
+// RegisterComponent returns nil as this is a disabled component
+func (cr *ConfigReloader) RegisterComponent(_ configreload.ReloadableComponent) error {
+	return nil
+}
+
+// ReloadConfig returns an error response as this is a disabled component
+func (cr *ConfigReloader) ReloadConfig() data.NodesReloadResponse {
+	return data.NodesReloadResponse{
+		OkRequest:   false,
+		Description: "not reloaded",
+		Error:       configReloadNotEnabledError,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *ConfigReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
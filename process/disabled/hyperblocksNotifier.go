@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"context"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// HyperblocksNotifier represents a disabled struct that implements the HyperblocksNotifierHandler interface
+type HyperblocksNotifier struct {
+}
+
+// Subscribe returns an error as this is a disabled component
+func (hn *HyperblocksNotifier) Subscribe(_ context.Context, _ common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+	return nil, apiErrors.ErrHyperblocksSubscriptionsNotEnabled
+}
+
+// Close returns nil as this is a disabled component
+func (hn *HyperblocksNotifier) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hn *HyperblocksNotifier) IsInterfaceNil() bool {
+	return hn == nil
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// NodesSanityChecker represents a disabled struct that implements the NodesSanityCheckerHandler interface
+type NodesSanityChecker struct {
+}
+
+// CheckNodes won't do anything as this is a disabled component
+func (nsc *NodesSanityChecker) CheckNodes(_ []*data.NodeWithMetrics) {
+}
+
+// IsQuarantined returns false as this is a disabled component
+func (nsc *NodesSanityChecker) IsQuarantined(_ *data.NodeData) bool {
+	return false
+}
+
+// GetQuarantinedNodes returns an empty slice as this is a disabled component
+func (nsc *NodesSanityChecker) GetQuarantinedNodes() []*data.QuarantinedNode {
+	return make([]*data.QuarantinedNode, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsc *NodesSanityChecker) IsInterfaceNil() bool {
+	return nsc == nil
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"context"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// NonceManager represents a disabled struct that implements the NonceManagerHandler interface
+type NonceManager struct {
+}
+
+// ReserveNonce returns an error as this is a disabled component
+func (nm *NonceManager) ReserveNonce(_ context.Context, _ string) (*data.NonceReservation, error) {
+	return nil, apiErrors.ErrNonceReservationsNotEnabled
+}
+
+// RecordSentNonce does nothing as this is a disabled component
+func (nm *NonceManager) RecordSentNonce(_ string, _ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nm *NonceManager) IsInterfaceNil() bool {
+	return nm == nil
+}
@@ -0,0 +1,19 @@
+package disabled
+
+import "context"
+
+// RequestsCoalescer represents a disabled struct that implements the RequestsCoalescerHandler interface
+type RequestsCoalescer struct {
+}
+
+// CoalesceRequest executes the provided handler, as this is a disabled component
+func (rc *RequestsCoalescer) CoalesceRequest(_ context.Context, _ string, _ string, handler func() (interface{}, error)) (interface{}, bool, error) {
+	result, err := handler()
+
+	return result, false, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *RequestsCoalescer) IsInterfaceNil() bool {
+	return rc == nil
+}
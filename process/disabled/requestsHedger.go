@@ -0,0 +1,21 @@
+package disabled
+
+import "time"
+
+// RequestsHedger represents a disabled struct that implements the RequestsHedgerHandler interface
+type RequestsHedger struct {
+}
+
+// GetHedgingDelay returns false as this is a disabled component
+func (rh *RequestsHedger) GetHedgingDelay(_ string) (time.Duration, bool) {
+	return 0, false
+}
+
+// RecordResponseTime won't do anything as this is a disabled component
+func (rh *RequestsHedger) RecordResponseTime(_ string, _ time.Duration) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *RequestsHedger) IsInterfaceNil() bool {
+	return rh == nil
+}
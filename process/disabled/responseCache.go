@@ -0,0 +1,26 @@
+package disabled
+
+import "context"
+
+// ResponseCache represents a disabled struct that implements the ResponseCacheHandler interface
+type ResponseCache struct {
+}
+
+// Get returns false as this is a disabled component
+func (rc *ResponseCache) Get(_ context.Context, _ string, _ interface{}) bool {
+	return false
+}
+
+// Put won't do anything as this is a disabled component
+func (rc *ResponseCache) Put(_ string, _ interface{}) {
+}
+
+// IsEnabled returns false as this is a disabled component
+func (rc *ResponseCache) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *ResponseCache) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// SessionAffinity represents a disabled struct that implements the SessionAffinityHandler interface
+type SessionAffinity struct {
+}
+
+// RecordObserver won't do anything as this is a disabled component
+func (sa *SessionAffinity) RecordObserver(_ context.Context, _ string, _ *data.NodeData) {
+}
+
+// SortObservers returns the observers in the same order as this is a disabled component
+func (sa *SessionAffinity) SortObservers(_ context.Context, _ string, observers []*data.NodeData) ([]*data.NodeData, bool) {
+	return observers, false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sa *SessionAffinity) IsInterfaceNil() bool {
+	return sa == nil
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"context"
+	"time"
+)
+
+// SharedStore represents a disabled struct that implements the SharedStoreHandler interface
+type SharedStore struct {
+}
+
+// Get returns false as this is a disabled component
+func (ss *SharedStore) Get(_ context.Context, _ string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+// Set won't do anything as this is a disabled component
+func (ss *SharedStore) Set(_ context.Context, _ string, _ []byte, _ time.Duration) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *SharedStore) IsInterfaceNil() bool {
+	return ss == nil
+}
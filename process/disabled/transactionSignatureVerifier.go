@@ -0,0 +1,17 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// TransactionSignatureVerifier represents a disabled struct that implements the TransactionSignatureVerifier interface
+type TransactionSignatureVerifier struct {
+}
+
+// VerifySignature returns nil as this is a disabled component
+func (tsv *TransactionSignatureVerifier) VerifySignature(_ *data.Transaction) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsv *TransactionSignatureVerifier) IsInterfaceNil() bool {
+	return tsv == nil
+}
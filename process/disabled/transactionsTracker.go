@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"context"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// TransactionsTracker represents a disabled struct that implements the TransactionsTrackerHandler interface
+type TransactionsTracker struct {
+}
+
+// WaitForCompletion returns an error as this is a disabled component
+func (tt *TransactionsTracker) WaitForCompletion(_ context.Context, _ string, _ common.TransactionWaitOptions) (*data.TransactionWaitResult, error) {
+	return nil, apiErrors.ErrTransactionsTrackerNotEnabled
+}
+
+// Close returns nil as this is a disabled component
+func (tt *TransactionsTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *TransactionsTracker) IsInterfaceNil() bool {
+	return tt == nil
+}
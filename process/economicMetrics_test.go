@@ -1,14 +1,16 @@
 package process_test
 
 import (
+	"context"
 	"encoding/json"
-	"sync/atomic"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,49 +24,41 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldReturnDataFromCacher(t
 		Error: "test error",
 	}
 
-	cacher := &mock.GenericApiResponseCacherMock{Data: respInCache}
-	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	cacher := &mock.TTLCacheStub{
+		GetCalled: func(_ context.Context, key string) (interface{}, error) {
+			if key == "economics-metrics" {
+				return respInCache, nil
+			}
+			return nil, errors.New("unexpected key")
+		},
+	}
+	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetEconomicsDataMetrics()
+	res, err := hp.GetEconomicsDataMetrics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res, respInCache)
 }
 
-func TestNodeStatusProcessor_CacheShouldUpdate(t *testing.T) {
+func TestNodeStatusProcessor_EconomicsDataMetricsShouldBeKeptWarm(t *testing.T) {
 	t.Parallel()
 
-	numOfTimesHttpWasCalled := int32(0)
-	cacher := &mock.GenericApiResponseCacherMock{}
-	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{
-		GetObserversCalled: func(_ uint32) ([]*data.NodeData, error) {
-			return []*data.NodeData{{Address: "obs1"}}, nil
+	var registeredConfig cache.KeyConfig
+	cacher := &mock.TTLCacheStub{
+		RegisterCalled: func(key string, config cache.KeyConfig, fetch cache.FetchHandler) error {
+			if key == "economics-metrics" {
+				registeredConfig = config
+			}
+			return nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
-			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
-			return 0, nil
-		},
-	},
-		cacher,
-		25*time.Millisecond)
-
+	}
+	_, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, 25*time.Millisecond, time.Second)
 	assert.Nil(t, err)
-	hp.StartCacheUpdate()
-
-	// cache will become invalid after 25 ms so check if it renews its data
 
-	// >25 => update
-	time.Sleep(30 * time.Millisecond)
-	assert.Equal(t, int32(2), atomic.LoadInt32(&numOfTimesHttpWasCalled))
-
-	// > 25 => update
-	time.Sleep(30 * time.Millisecond)
-	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
-
-	// < 25 => don't update
-	time.Sleep(5 * time.Millisecond)
-	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+	assert.Equal(t, 25*time.Millisecond, registeredConfig.TTL)
+	assert.Equal(t, 25*time.Millisecond, registeredConfig.RefreshInterval)
+	assert.Equal(t, 250*time.Millisecond, registeredConfig.TTL+registeredConfig.StaleDuration)
 }
 
 func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
@@ -88,19 +82,12 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 			return 200, json.Unmarshal(expectedResponseBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{
-			Data: &data.GenericAPIResponse{Data: "default response"},
-		},
+		&mock.TTLCacheStub{},
+		time.Millisecond,
 		time.Millisecond,
 	)
 
-	time.Sleep(2 * time.Millisecond)
-
-	nodeStatusProc.StartCacheUpdate()
-
-	time.Sleep(10 * time.Millisecond)
-
-	actualResponse, err := nodeStatusProc.GetEconomicsDataMetrics()
+	actualResponse, err := nodeStatusProc.GetEconomicsDataMetrics(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, *expectedResponse, *actualResponse)
 }
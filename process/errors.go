@@ -14,6 +14,9 @@ var ErrNilShardCoordinator = errors.New("nil shard coordinator")
 // ErrInvalidRequestTimeout signals that the provided number of seconds before timeout is invalid
 var ErrInvalidRequestTimeout = errors.New("invalid duration until timeout for requests")
 
+// ErrInvalidHttpClientConfig signals that an invalid http client configuration has been provided
+var ErrInvalidHttpClientConfig = errors.New("invalid http client config")
+
 // ErrNilCoreProcessor signals that a nil core processor has been provided
 var ErrNilCoreProcessor = errors.New("nil core processor")
 
@@ -103,3 +106,45 @@ var ErrInvalidOutputFormat = errors.New("the output format type is invalid")
 
 // ErrNilStatusMetricsProvider signals that a nil status metrics provider has been given
 var ErrNilStatusMetricsProvider = errors.New("nil status metrics provider")
+
+// ErrNilCircuitBreaker signals that a nil circuit breaker has been provided
+var ErrNilCircuitBreaker = errors.New("nil circuit breaker")
+
+// ErrNilRequestsHedger signals that a nil requests hedger has been provided
+var ErrNilRequestsHedger = errors.New("nil requests hedger")
+
+// ErrNodeCircuitOpen signals that the request was not sent because the circuit breaker of the node is open
+var ErrNodeCircuitOpen = errors.New("the circuit breaker of the node is open")
+
+// ErrNilResponseCache signals that a nil response cache has been provided
+var ErrNilResponseCache = errors.New("nil response cache")
+
+// ErrNilSessionAffinity signals that a nil session affinity component has been provided
+var ErrNilSessionAffinity = errors.New("nil session affinity")
+
+// ErrInvalidCachedValue signals that the value found in cache has an unexpected type
+var ErrInvalidCachedValue = errors.New("invalid cached value")
+
+// ErrNilRequestsCoalescer signals that a nil requests coalescer has been provided
+var ErrNilRequestsCoalescer = errors.New("nil requests coalescer")
+
+// ErrNilNodesSanityChecker signals that a nil nodes sanity checker has been provided
+var ErrNilNodesSanityChecker = errors.New("nil nodes sanity checker")
+
+// ErrAllNodesQuarantined signals that all the available nodes are quarantined
+var ErrAllNodesQuarantined = errors.New("all the available nodes are quarantined")
+
+// ErrNilNodesReloader signals that a nil nodes reloader has been provided
+var ErrNilNodesReloader = errors.New("nil nodes reloader")
+
+// ErrNilConfigReloader signals that a nil config reloader has been provided
+var ErrNilConfigReloader = errors.New("nil config reloader")
+
+// ErrNilTransactionSignatureVerifier signals that a nil transaction signature verifier has been provided
+var ErrNilTransactionSignatureVerifier = errors.New("nil transaction signature verifier")
+
+// ErrNilTransactionValidator signals that a nil transaction validator has been provided
+var ErrNilTransactionValidator = errors.New("nil transaction validator")
+
+// ErrTransactionNotAcceptedByObserver signals that the observer did not return a hash for a transaction it received
+var ErrTransactionNotAcceptedByObserver = errors.New("the transaction was not accepted by the observer")
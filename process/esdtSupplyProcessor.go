@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"math/big"
 	"strings"
 
@@ -37,8 +38,8 @@ func NewESDTSupplyProcessor(baseProc Processor, scQueryProc SCQueryService) (*es
 }
 
 // GetESDTSupply will return the total supply for the provided token
-func (esp *esdtSupplyProcessor) GetESDTSupply(tokenIdentifier string) (*data.ESDTSupplyResponse, error) {
-	totalSupply, err := esp.getSupplyFromShards(tokenIdentifier)
+func (esp *esdtSupplyProcessor) GetESDTSupply(ctx context.Context, tokenIdentifier string) (*data.ESDTSupplyResponse, error) {
+	totalSupply, err := esp.getSupplyFromShards(ctx, tokenIdentifier)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +53,7 @@ func (esp *esdtSupplyProcessor) GetESDTSupply(tokenIdentifier string) (*data.ESD
 		return res, nil
 	}
 
-	initialSupply, err := esp.getInitialSupplyFromMeta(tokenIdentifier)
+	initialSupply, err := esp.getInitialSupplyFromMeta(ctx, tokenIdentifier)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +73,7 @@ func makeInitialMintedNotEmpty(resp *data.ESDTSupplyResponse) {
 	}
 }
 
-func (esp *esdtSupplyProcessor) getSupplyFromShards(tokenIdentifier string) (*data.ESDTSupply, error) {
+func (esp *esdtSupplyProcessor) getSupplyFromShards(ctx context.Context, tokenIdentifier string) (*data.ESDTSupply, error) {
 	totalSupply := &data.ESDTSupply{}
 	shardIDs := esp.baseProc.GetShardIDs()
 	for _, shardID := range shardIDs {
@@ -80,7 +81,7 @@ func (esp *esdtSupplyProcessor) getSupplyFromShards(tokenIdentifier string) (*da
 			continue
 		}
 
-		supply, err := esp.getShardSupply(tokenIdentifier, shardID)
+		supply, err := esp.getShardSupply(ctx, tokenIdentifier, shardID)
 		if err != nil {
 			return nil, err
 		}
@@ -112,14 +113,14 @@ func sumStr(s1, s2 string) string {
 	return big.NewInt(0).Add(s1Big, s2Big).String()
 }
 
-func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(token string) (*big.Int, error) {
+func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(ctx context.Context, token string) (*big.Int, error) {
 	scQuery := &data.SCQuery{
 		ScAddress: esdtContractAddress,
 		FuncName:  initialESDTSupplyFunc,
 		Arguments: [][]byte{[]byte(token)},
 	}
 
-	res, err := esp.scQueryProc.ExecuteQuery(scQuery)
+	res, err := esp.scQueryProc.ExecuteQuery(ctx, scQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +138,7 @@ func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(token string) (*big.Int
 	return supplyBig, nil
 }
 
-func (esp *esdtSupplyProcessor) getShardSupply(token string, shardID uint32) (*data.ESDTSupply, error) {
+func (esp *esdtSupplyProcessor) getShardSupply(ctx context.Context, token string, shardID uint32) (*data.ESDTSupply, error) {
 	shardObservers, errObs := esp.baseProc.GetObservers(shardID)
 	if errObs != nil {
 		return nil, errObs
@@ -147,7 +148,7 @@ func (esp *esdtSupplyProcessor) getShardSupply(token string, shardID uint32) (*d
 	for _, observer := range shardObservers {
 		var responseEsdtSupply data.ESDTSupplyResponse
 
-		_, errGet := esp.baseProc.CallGetRestEndPoint(observer.Address, apiPath, &responseEsdtSupply)
+		_, errGet := esp.baseProc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &responseEsdtSupply)
 		if errGet != nil {
 			log.Error("esdt supply request", "shard ID", observer.ShardId, "observer", observer.Address, "error", errGet.Error())
 			continue
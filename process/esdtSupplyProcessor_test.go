@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -65,7 +66,7 @@ func TestEsdtSupplyProcessor_GetESDTSupplyFungible(t *testing.T) {
 	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
 	require.Nil(t, err)
 
-	supplyRes, err := esdtProc.GetESDTSupply("TOKEN-ABCD")
+	supplyRes, err := esdtProc.GetESDTSupply(context.Background(), "TOKEN-ABCD")
 	require.Nil(t, err)
 	require.Equal(t, "4500", supplyRes.Data.Supply)
 	require.Equal(t, "600", supplyRes.Data.Burned)
@@ -114,7 +115,7 @@ func TestEsdtSupplyProcessor_GetESDTSupplyNonFungible(t *testing.T) {
 	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
 	require.Nil(t, err)
 
-	supplyRes, err := esdtProc.GetESDTSupply("SEMI-ABCD-0A")
+	supplyRes, err := esdtProc.GetESDTSupply(context.Background(), "SEMI-ABCD-0A")
 	require.Nil(t, err)
 	require.Equal(t, "2000", supplyRes.Data.Supply)
 	require.Equal(t, "0", supplyRes.Data.InitialMinted)
@@ -1,14 +1,28 @@
 package process
 
 import (
+	"net/http"
 	"time"
 
 	proxyData "github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/syncstate"
 )
 
 // SetDelayForCheckingNodesSyncState -
 func (bp *BaseProcessor) SetDelayForCheckingNodesSyncState(delay time.Duration) {
-	bp.delayForCheckingNodesSyncState = delay
+	bp.syncStateChecker, _ = syncstate.NewNodesSyncStateChecker(syncstate.ArgsNodesSyncStateChecker{
+		ShardIDs: bp.shardIDs,
+		DefaultSettings: syncstate.ShardSettings{
+			CheckInterval:    delay,
+			ComparisonMethod: syncstate.NonceComparisonMethod,
+			LagThreshold:     10,
+		},
+	})
+}
+
+// UpdateNodesWithSync -
+func (bp *BaseProcessor) UpdateNodesWithSync(shardIDs []uint32) {
+	bp.updateNodesWithSync(shardIDs)
 }
 
 // SetNodeStatusFetcher -
@@ -20,3 +34,8 @@ func (bp *BaseProcessor) SetNodeStatusFetcher(fetcher func(url string) (*proxyDa
 func ComputeTokenStorageKey(tokenID string, nonce uint64) string {
 	return computeTokenStorageKey(tokenID, nonce)
 }
+
+// GetHttpClient -
+func (bp *BaseProcessor) GetHttpClient() *http.Client {
+	return bp.httpClient
+}
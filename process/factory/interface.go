@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -11,12 +12,13 @@ import (
 // Processor defines what a processor should be able to do
 type Processor interface {
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetObserversOnePerShard() ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	GetFullHistoryNodesOnePerShard() ([]*data.NodeData, error)
 	GetObservers(shardID uint32) ([]*data.NodeData, error)
+	GetObserversForAddress(shardID uint32, address []byte) ([]*data.NodeData, error)
 	GetAllObservers() ([]*data.NodeData, error)
 	GetFullHistoryNodes(shardID uint32) ([]*data.NodeData, error)
 	GetAllFullHistoryNodes() ([]*data.NodeData, error)
@@ -24,6 +26,9 @@ type Processor interface {
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodes() []*data.QuarantinedNode
+	GetNodesHealth() []*data.NodeHealthStatus
 	IsInterfaceNil() bool
 }
 
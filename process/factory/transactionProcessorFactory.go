@@ -6,8 +6,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-proxy-go/facade"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/logsevents"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/txcost"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/txsigning"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/txvalidation"
 )
 
 // CreateTransactionProcessor will return the transaction processor needed for current settings
@@ -17,6 +20,10 @@ func CreateTransactionProcessor(
 	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
 	allowEntireTxPoolFetch bool,
+	responseCache process.ResponseCacheHandler,
+	sessionAffinity process.SessionAffinityHandler,
+	verifyTransactionsSignatures bool,
+	networkConfigProvider txvalidation.NetworkConfigProvider,
 ) (facade.TransactionProcessor, error) {
 	newTxCostProcessor := func() (process.TransactionCostHandler, error) {
 		return txcost.NewTransactionCostProcessor(
@@ -30,6 +37,16 @@ func CreateTransactionProcessor(
 		return nil, err
 	}
 
+	signatureVerifier, err := createTransactionSignatureVerifier(pubKeyConverter, verifyTransactionsSignatures)
+	if err != nil {
+		return nil, err
+	}
+
+	txValidator, err := txvalidation.NewTransactionValidator(networkConfigProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	return process.NewTransactionProcessor(
 		proc,
 		pubKeyConverter,
@@ -38,5 +55,21 @@ func CreateTransactionProcessor(
 		newTxCostProcessor,
 		logsMerger,
 		allowEntireTxPoolFetch,
+		responseCache,
+		sessionAffinity,
+		signatureVerifier,
+		txValidator,
 	)
 }
+
+func createTransactionSignatureVerifier(
+	pubKeyConverter core.PubkeyConverter,
+	verifyTransactionsSignatures bool,
+) (process.TransactionSignatureVerifier, error) {
+	if !verifyTransactionsSignatures {
+		return &disabled.TransactionSignatureVerifier{}, nil
+	}
+
+	log.Info("the transactions' signatures will be verified before relaying them")
+	return txsigning.NewSignatureVerifier(pubKeyConverter)
+}
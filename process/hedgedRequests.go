@@ -0,0 +1,137 @@
+package process
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	// accountOperation identifies the account requests when computing the hedging delay
+	accountOperation = "account"
+
+	// scQueryOperation identifies the smart contract queries when computing the hedging delay
+	scQueryOperation = "sc-query"
+)
+
+// observerRequestHandler sends a request towards the provided observer. If the returned isFinal flag is false, the
+// request should be retried on the next observer
+type observerRequestHandler func(ctx context.Context, observer *data.NodeData) (response interface{}, isFinal bool, err error)
+
+type observerResponse struct {
+	response interface{}
+	isFinal  bool
+	err      error
+	duration time.Duration
+}
+
+// executeOnObservers sends the request towards the provided observers, one after the other, until one of them gives a
+// final response. If hedging is allowed for the request and the current observer did not answer within the hedging
+// delay, the request is also sent towards the next observer: the first final response wins and the other requests
+// are canceled. Hedging must only be used for idempotent requests
+func executeOnObservers(
+	ctx context.Context,
+	hedger RequestsHedgerHandler,
+	operation string,
+	observers []*data.NodeData,
+	handler observerRequestHandler,
+) (interface{}, error) {
+	delay, isHedgingEnabled := hedger.GetHedgingDelay(operation)
+	if isHedgingEnabled && common.IsHedgingAllowed(ctx) && len(observers) > 1 {
+		return executeHedgedOnObservers(ctx, hedger, operation, delay, observers, handler)
+	}
+
+	for _, observer := range observers {
+		startTime := time.Now()
+		response, isFinal, err := handler(ctx, observer)
+		if !isFinal {
+			continue
+		}
+
+		if err == nil {
+			hedger.RecordResponseTime(operation, time.Since(startTime))
+		}
+
+		return response, err
+	}
+
+	return nil, ErrSendingRequest
+}
+
+func executeHedgedOnObservers(
+	ctx context.Context,
+	hedger RequestsHedgerHandler,
+	operation string,
+	delay time.Duration,
+	observers []*data.NodeData,
+	handler observerRequestHandler,
+) (interface{}, error) {
+	hedgedCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan *observerResponse, len(observers))
+	numInProgress := 0
+	nextIndex := 0
+	sendToNextObserver := func() {
+		observer := observers[nextIndex]
+		nextIndex++
+		numInProgress++
+
+		go func() {
+			startTime := time.Now()
+			response, isFinal, err := handler(hedgedCtx, observer)
+			responses <- &observerResponse{
+				response: response,
+				isFinal:  isFinal,
+				err:      err,
+				duration: time.Since(startTime),
+			}
+		}()
+	}
+
+	sendToNextObserver()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for numInProgress > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			if nextIndex < len(observers) {
+				log.Debug("hedging request", "operation", operation, "delay", delay, "observer index", nextIndex)
+				sendToNextObserver()
+				timer.Reset(delay)
+			}
+		case resp := <-responses:
+			numInProgress--
+			if resp.isFinal {
+				if resp.err == nil {
+					hedger.RecordResponseTime(operation, resp.duration)
+				}
+
+				return resp.response, resp.err
+			}
+
+			if nextIndex < len(observers) {
+				sendToNextObserver()
+				resetTimer(timer, delay)
+			}
+		}
+	}
+
+	return nil, ErrSendingRequest
+}
+
+func resetTimer(timer *time.Timer, delay time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+
+	timer.Reset(delay)
+}
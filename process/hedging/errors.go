@@ -0,0 +1,12 @@
+package hedging
+
+import "errors"
+
+// ErrInvalidPercentile signals that an invalid percentile has been provided
+var ErrInvalidPercentile = errors.New("invalid percentile")
+
+// ErrInvalidDelay signals that an invalid hedging delay has been provided
+var ErrInvalidDelay = errors.New("invalid hedging delay")
+
+// ErrInvalidNumSamples signals that an invalid number of samples has been provided
+var ErrInvalidNumSamples = errors.New("invalid number of samples")
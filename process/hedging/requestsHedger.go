@@ -0,0 +1,143 @@
+package hedging
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// minSamplesForPercentile represents the minimum number of samples needed for computing a percentile. Until then,
+// the maximum delay is used
+const minSamplesForPercentile = 10
+
+type responseTimesWindow struct {
+	samples          []time.Duration
+	nextIndex        int
+	numFilled        int
+	numSinceCompute  int
+	computedDelay    time.Duration
+	hasComputedDelay bool
+}
+
+// ArgsRequestsHedger holds the arguments needed for creating a new requests hedger
+type ArgsRequestsHedger struct {
+	Percentile float64
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+	NumSamples int
+}
+
+// requestsHedger computes, for each operation, the delay after which a request towards an observer should be hedged.
+// The delay is the configured percentile of the recent response times, bounded by the minimum and maximum delays
+type requestsHedger struct {
+	percentile     float64
+	minDelay       time.Duration
+	maxDelay       time.Duration
+	numSamples     int
+	recomputeEvery int
+	mutWindows     sync.Mutex
+	windows        map[string]*responseTimesWindow
+}
+
+// NewRequestsHedger returns a new instance of requestsHedger
+func NewRequestsHedger(args ArgsRequestsHedger) (*requestsHedger, error) {
+	if args.Percentile <= 0 || args.Percentile > 100 {
+		return nil, ErrInvalidPercentile
+	}
+	if args.MinDelay <= 0 || args.MaxDelay < args.MinDelay {
+		return nil, ErrInvalidDelay
+	}
+	if args.NumSamples < minSamplesForPercentile {
+		return nil, ErrInvalidNumSamples
+	}
+
+	recomputeEvery := args.NumSamples / 20
+	if recomputeEvery == 0 {
+		recomputeEvery = 1
+	}
+
+	return &requestsHedger{
+		percentile:     args.Percentile,
+		minDelay:       args.MinDelay,
+		maxDelay:       args.MaxDelay,
+		numSamples:     args.NumSamples,
+		recomputeEvery: recomputeEvery,
+		windows:        make(map[string]*responseTimesWindow),
+	}, nil
+}
+
+// GetHedgingDelay returns the delay after which a request for the provided operation should be hedged
+func (rh *requestsHedger) GetHedgingDelay(operation string) (time.Duration, bool) {
+	rh.mutWindows.Lock()
+	defer rh.mutWindows.Unlock()
+
+	window, found := rh.windows[operation]
+	if !found || !window.hasComputedDelay {
+		return rh.maxDelay, true
+	}
+
+	return window.computedDelay, true
+}
+
+// RecordResponseTime records the response time of a successful request for the provided operation
+func (rh *requestsHedger) RecordResponseTime(operation string, duration time.Duration) {
+	rh.mutWindows.Lock()
+	defer rh.mutWindows.Unlock()
+
+	window, found := rh.windows[operation]
+	if !found {
+		window = &responseTimesWindow{
+			samples: make([]time.Duration, rh.numSamples),
+		}
+		rh.windows[operation] = window
+	}
+
+	window.samples[window.nextIndex] = duration
+	window.nextIndex = (window.nextIndex + 1) % len(window.samples)
+	if window.numFilled < len(window.samples) {
+		window.numFilled++
+	}
+	window.numSinceCompute++
+
+	if window.numFilled < minSamplesForPercentile {
+		return
+	}
+	if window.hasComputedDelay && window.numSinceCompute < rh.recomputeEvery {
+		return
+	}
+
+	window.computedDelay = rh.computeDelay(window.samples[:window.numFilled])
+	window.hasComputedDelay = true
+	window.numSinceCompute = 0
+}
+
+func (rh *requestsHedger) computeDelay(samples []time.Duration) time.Duration {
+	sortedSamples := make([]time.Duration, len(samples))
+	copy(sortedSamples, samples)
+	sort.Slice(sortedSamples, func(i, j int) bool {
+		return sortedSamples[i] < sortedSamples[j]
+	})
+
+	index := int(float64(len(sortedSamples))*rh.percentile/100+0.5) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(sortedSamples) {
+		index = len(sortedSamples) - 1
+	}
+
+	delay := sortedSamples[index]
+	if delay < rh.minDelay {
+		return rh.minDelay
+	}
+	if delay > rh.maxDelay {
+		return rh.maxDelay
+	}
+
+	return delay
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *requestsHedger) IsInterfaceNil() bool {
+	return rh == nil
+}
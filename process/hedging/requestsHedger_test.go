@@ -0,0 +1,140 @@
+package hedging_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/hedging"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsRequestsHedger() hedging.ArgsRequestsHedger {
+	return hedging.ArgsRequestsHedger{
+		Percentile: 90,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   500 * time.Millisecond,
+		NumSamples: 100,
+	}
+}
+
+func TestNewRequestsHedger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid percentile - should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsHedger()
+		args.Percentile = 0
+		rh, err := hedging.NewRequestsHedger(args)
+		assert.True(t, check.IfNil(rh))
+		assert.Equal(t, hedging.ErrInvalidPercentile, err)
+
+		args.Percentile = 101
+		rh, err = hedging.NewRequestsHedger(args)
+		assert.True(t, check.IfNil(rh))
+		assert.Equal(t, hedging.ErrInvalidPercentile, err)
+	})
+
+	t.Run("invalid delays - should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsHedger()
+		args.MinDelay = 0
+		rh, err := hedging.NewRequestsHedger(args)
+		assert.True(t, check.IfNil(rh))
+		assert.Equal(t, hedging.ErrInvalidDelay, err)
+
+		args = createMockArgsRequestsHedger()
+		args.MaxDelay = args.MinDelay - time.Millisecond
+		rh, err = hedging.NewRequestsHedger(args)
+		assert.True(t, check.IfNil(rh))
+		assert.Equal(t, hedging.ErrInvalidDelay, err)
+	})
+
+	t.Run("invalid number of samples - should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsHedger()
+		args.NumSamples = 1
+		rh, err := hedging.NewRequestsHedger(args)
+		assert.True(t, check.IfNil(rh))
+		assert.Equal(t, hedging.ErrInvalidNumSamples, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rh, err := hedging.NewRequestsHedger(createMockArgsRequestsHedger())
+		assert.False(t, check.IfNil(rh))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRequestsHedger_GetHedgingDelayWithoutEnoughSamplesShouldReturnMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := hedging.NewRequestsHedger(createMockArgsRequestsHedger())
+
+	delay, isEnabled := rh.GetHedgingDelay("operation")
+	assert.True(t, isEnabled)
+	assert.Equal(t, 500*time.Millisecond, delay)
+
+	rh.RecordResponseTime("operation", 50*time.Millisecond)
+	delay, _ = rh.GetHedgingDelay("operation")
+	assert.Equal(t, 500*time.Millisecond, delay)
+}
+
+func TestRequestsHedger_GetHedgingDelayShouldReturnPercentile(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := hedging.NewRequestsHedger(createMockArgsRequestsHedger())
+	for i := 1; i <= 100; i++ {
+		rh.RecordResponseTime("operation", time.Duration(i)*time.Millisecond)
+	}
+
+	delay, _ := rh.GetHedgingDelay("operation")
+	assert.Equal(t, 90*time.Millisecond, delay)
+
+	delay, _ = rh.GetHedgingDelay("other operation")
+	assert.Equal(t, 500*time.Millisecond, delay)
+}
+
+func TestRequestsHedger_GetHedgingDelayShouldBeBounded(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := hedging.NewRequestsHedger(createMockArgsRequestsHedger())
+	for i := 0; i < 100; i++ {
+		rh.RecordResponseTime("fast", time.Millisecond)
+		rh.RecordResponseTime("slow", time.Minute)
+	}
+
+	delay, _ := rh.GetHedgingDelay("fast")
+	assert.Equal(t, 10*time.Millisecond, delay)
+
+	delay, _ = rh.GetHedgingDelay("slow")
+	assert.Equal(t, 500*time.Millisecond, delay)
+}
+
+func TestRequestsHedger_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	rh, _ := hedging.NewRequestsHedger(createMockArgsRequestsHedger())
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			if idx%2 == 0 {
+				rh.RecordResponseTime("operation", time.Duration(idx)*time.Millisecond)
+			} else {
+				_, _ = rh.GetHedgingDelay("operation")
+			}
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
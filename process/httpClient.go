@@ -0,0 +1,90 @@
+package process
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+const (
+	defaultMaxIdleConns          = 100
+	defaultMaxIdleConnsPerHost   = 100
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultKeepAlivePeriod       = 30 * time.Second
+	defaultDialTimeout           = 30 * time.Second
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+	defaultExpectContinueTimeout = 1 * time.Second
+)
+
+// newHttpClient creates a new http client that owns its transport, so the connections towards the observers are
+// pooled and reused instead of being shared with (and reconfigured by) other components
+func newHttpClient(requestTimeoutSec int, cfg config.HttpClientConfig) (*http.Client, error) {
+	err := checkHttpClientConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   durationFromSeconds(cfg.DialTimeoutSec, defaultDialTimeout),
+		KeepAlive: durationFromSeconds(cfg.KeepAlivePeriodSec, defaultKeepAlivePeriod),
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     cfg.EnableHTTP2,
+		MaxIdleConns:          valueOrDefault(cfg.MaxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   valueOrDefault(cfg.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       cfg.MaxConnsPerHost,
+		IdleConnTimeout:       durationFromSeconds(cfg.IdleConnTimeoutSec, defaultIdleConnTimeout),
+		TLSHandshakeTimeout:   durationFromSeconds(cfg.TLSHandshakeTimeoutSec, defaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: time.Duration(cfg.ResponseHeaderTimeoutSec) * time.Second,
+		ExpectContinueTimeout: defaultExpectContinueTimeout,
+		DisableKeepAlives:     cfg.DisableKeepAlives,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(requestTimeoutSec) * time.Second,
+	}, nil
+}
+
+func checkHttpClientConfig(cfg config.HttpClientConfig) error {
+	values := map[string]int{
+		"MaxIdleConns":             cfg.MaxIdleConns,
+		"MaxIdleConnsPerHost":      cfg.MaxIdleConnsPerHost,
+		"MaxConnsPerHost":          cfg.MaxConnsPerHost,
+		"IdleConnTimeoutSec":       cfg.IdleConnTimeoutSec,
+		"KeepAlivePeriodSec":       cfg.KeepAlivePeriodSec,
+		"DialTimeoutSec":           cfg.DialTimeoutSec,
+		"TLSHandshakeTimeoutSec":   cfg.TLSHandshakeTimeoutSec,
+		"ResponseHeaderTimeoutSec": cfg.ResponseHeaderTimeoutSec,
+	}
+
+	for name, value := range values {
+		if value < 0 {
+			return fmt.Errorf("%w: %s is negative", ErrInvalidHttpClientConfig, name)
+		}
+	}
+
+	return nil
+}
+
+func valueOrDefault(value int, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+func durationFromSeconds(seconds int, defaultDuration time.Duration) time.Duration {
+	if seconds == 0 {
+		return defaultDuration
+	}
+
+	return time.Duration(seconds) * time.Second
+}
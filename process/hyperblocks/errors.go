@@ -0,0 +1,27 @@
+package hyperblocks
+
+import "errors"
+
+// ErrNilLatestHyperblockNonceProvider signals that a nil latest hyperblock nonce provider has been provided
+var ErrNilLatestHyperblockNonceProvider = errors.New("nil latest hyperblock nonce provider")
+
+// ErrNilHyperblocksProvider signals that a nil hyperblocks provider has been provided
+var ErrNilHyperblocksProvider = errors.New("nil hyperblocks provider")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidMaxResumeNonces signals that an invalid maximum number of resume nonces has been provided
+var ErrInvalidMaxResumeNonces = errors.New("invalid maximum number of resume nonces")
+
+// ErrInvalidSubscriberBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidSubscriberBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrSubscriberTooSlow signals that a subscriber fell too far behind the latest fully synchronized hyperblock
+var ErrSubscriberTooSlow = errors.New("the subscriber is too slow")
+
+// ErrNotifierClosed signals that the hyperblocks notifier has been closed
+var ErrNotifierClosed = errors.New("the hyperblocks notifier is closed")
@@ -0,0 +1,13 @@
+package hyperblocks
+
+import "context"
+
+// Poll -
+func (hn *hyperblocksNotifier) Poll() {
+	hn.poll(context.Background())
+}
+
+// NumSubscriptions -
+func (hn *hyperblocksNotifier) NumSubscriptions() int {
+	return hn.numSubscriptions()
+}
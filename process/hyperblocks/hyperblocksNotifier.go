@@ -0,0 +1,297 @@
+package hyperblocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/hyperblocks")
+
+const (
+	minPollInterval = 100 * time.Millisecond
+	requestTimeout  = 10 * time.Second
+)
+
+// ArgsHyperblocksNotifier holds the arguments needed for creating a new hyperblocks notifier
+type ArgsHyperblocksNotifier struct {
+	NonceProvider        LatestHyperblockNonceProvider
+	HyperblocksProvider  HyperblocksProvider
+	PollInterval         time.Duration
+	MaxSubscribers       int
+	MaxResumeNonces      uint64
+	SubscriberBufferSize int
+}
+
+type hyperblockKey struct {
+	nonce    uint64
+	withLogs bool
+}
+
+// hyperblocksNotifier fans out the new fully synchronized hyperblocks to all its subscribers. A single goroutine polls
+// the latest fully synchronized hyperblock nonce and fetches each hyperblock needed by the subscribers only once per
+// poll, regardless of the number of subscribers waiting for it. Each subscriber has its own next nonce, so the
+// subscribers resumed from older nonces catch up at their own pace, bounded by the free space of their buffers
+type hyperblocksNotifier struct {
+	nonceProvider       LatestHyperblockNonceProvider
+	hyperblocksProvider HyperblocksProvider
+	maxSubscribers      int
+	maxResumeNonces     uint64
+	bufferSize          int
+	cancelPollLoop      func()
+
+	mutSubscriptions   sync.Mutex
+	subscriptions      map[uint64]*subscription
+	lastSubscriptionID uint64
+	isClosed           bool
+}
+
+// NewHyperblocksNotifier returns a new instance of hyperblocksNotifier
+func NewHyperblocksNotifier(args ArgsHyperblocksNotifier) (*hyperblocksNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	hn := &hyperblocksNotifier{
+		nonceProvider:       args.NonceProvider,
+		hyperblocksProvider: args.HyperblocksProvider,
+		maxSubscribers:      args.MaxSubscribers,
+		maxResumeNonces:     args.MaxResumeNonces,
+		bufferSize:          args.SubscriberBufferSize,
+		subscriptions:       make(map[uint64]*subscription),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hn.cancelPollLoop = cancel
+	go hn.pollLoop(ctx, args.PollInterval)
+
+	return hn, nil
+}
+
+func checkArgs(args ArgsHyperblocksNotifier) error {
+	if check.IfNil(args.NonceProvider) {
+		return ErrNilLatestHyperblockNonceProvider
+	}
+	if check.IfNil(args.HyperblocksProvider) {
+		return ErrNilHyperblocksProvider
+	}
+	if args.PollInterval < minPollInterval {
+		return fmt.Errorf("%w: provided %v, minimum %v", ErrInvalidPollInterval, args.PollInterval, minPollInterval)
+	}
+	if args.MaxSubscribers <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxSubscribers, args.MaxSubscribers)
+	}
+	if args.MaxResumeNonces == 0 {
+		return ErrInvalidMaxResumeNonces
+	}
+	if args.SubscriberBufferSize <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidSubscriberBufferSize, args.SubscriberBufferSize)
+	}
+
+	return nil
+}
+
+// Subscribe creates a new subscription which receives the fully synchronized hyperblocks starting with the provided
+// nonce or, if none is provided, with the next one
+func (hn *hyperblocksNotifier) Subscribe(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error) {
+	latestNonce, err := hn.nonceProvider.GetLatestFullySynchronizedHyperblockNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextNonce := latestNonce + 1
+	if options.FromNonce.HasValue {
+		if options.FromNonce.Value+hn.maxResumeNonces <= latestNonce {
+			return nil, fmt.Errorf("%w: provided %d, latest %d, maximum %d nonces behind", apiErrors.ErrResumeNonceTooOld,
+				options.FromNonce.Value, latestNonce, hn.maxResumeNonces)
+		}
+
+		nextNonce = options.FromNonce.Value
+	}
+
+	hn.mutSubscriptions.Lock()
+	defer hn.mutSubscriptions.Unlock()
+
+	if hn.isClosed {
+		return nil, ErrNotifierClosed
+	}
+	if len(hn.subscriptions) >= hn.maxSubscribers {
+		return nil, apiErrors.ErrTooManyHyperblocksSubscribers
+	}
+
+	hn.lastSubscriptionID++
+	sub := &subscription{
+		id:          hn.lastSubscriptionID,
+		withLogs:    options.WithLogs,
+		nextNonce:   nextNonce,
+		hyperblocks: make(chan *data.Hyperblock, hn.bufferSize),
+		notifier:    hn,
+	}
+	hn.subscriptions[sub.id] = sub
+
+	log.Debug("new hyperblocks subscription", "id", sub.id, "next nonce", nextNonce, "with logs", options.WithLogs,
+		"num subscriptions", len(hn.subscriptions))
+
+	return sub, nil
+}
+
+func (hn *hyperblocksNotifier) pollLoop(ctx context.Context, pollInterval time.Duration) {
+	timer := time.NewTimer(pollInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			hn.poll(ctx)
+			timer.Reset(pollInterval)
+		case <-ctx.Done():
+			log.Debug("closing hyperblocks notifier poll loop")
+			return
+		}
+	}
+}
+
+func (hn *hyperblocksNotifier) poll(ctx context.Context) {
+	if hn.numSubscriptions() == 0 {
+		return
+	}
+
+	latestNonce, err := hn.getLatestNonce(ctx)
+	if err != nil {
+		log.Debug("cannot get the latest fully synchronized hyperblock nonce", "error", err)
+		return
+	}
+
+	keys := hn.computeNeededHyperblocks(latestNonce)
+	if len(keys) == 0 {
+		return
+	}
+
+	hyperblocks := hn.fetchHyperblocks(ctx, keys)
+	hn.deliverHyperblocks(hyperblocks)
+}
+
+func (hn *hyperblocksNotifier) getLatestNonce(ctx context.Context) (uint64, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	return hn.nonceProvider.GetLatestFullySynchronizedHyperblockNonce(requestCtx)
+}
+
+// computeNeededHyperblocks drops the subscribers which fell too far behind and returns, sorted by nonce, the
+// hyperblocks which can be delivered to the others
+func (hn *hyperblocksNotifier) computeNeededHyperblocks(latestNonce uint64) []hyperblockKey {
+	hn.mutSubscriptions.Lock()
+	defer hn.mutSubscriptions.Unlock()
+
+	neededKeys := make(map[hyperblockKey]struct{})
+	for _, sub := range hn.subscriptions {
+		if sub.nextNonce > latestNonce {
+			continue
+		}
+		if latestNonce-sub.nextNonce >= hn.maxResumeNonces {
+			log.Debug("dropping slow hyperblocks subscriber", "id", sub.id, "next nonce", sub.nextNonce,
+				"latest nonce", latestNonce)
+			sub.closeUnprotected(fmt.Errorf("%w: next nonce %d, latest nonce %d", ErrSubscriberTooSlow,
+				sub.nextNonce, latestNonce))
+			continue
+		}
+
+		freeSpace := uint64(cap(sub.hyperblocks) - len(sub.hyperblocks))
+		for nonce := sub.nextNonce; nonce <= latestNonce && nonce < sub.nextNonce+freeSpace; nonce++ {
+			neededKeys[hyperblockKey{nonce: nonce, withLogs: sub.withLogs}] = struct{}{}
+		}
+	}
+
+	keys := make([]hyperblockKey, 0, len(neededKeys))
+	for key := range neededKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].nonce < keys[j].nonce
+	})
+
+	return keys
+}
+
+// fetchHyperblocks fetches the provided hyperblocks in ascending nonce order. After a failure, the higher nonces are
+// not fetched anymore as they could not be delivered until the missing one is
+func (hn *hyperblocksNotifier) fetchHyperblocks(ctx context.Context, keys []hyperblockKey) map[hyperblockKey]*data.Hyperblock {
+	hyperblocks := make(map[hyperblockKey]*data.Hyperblock, len(keys))
+	hasFailed := make(map[bool]bool)
+	for _, key := range keys {
+		if hasFailed[key.withLogs] {
+			continue
+		}
+
+		hyperblock, err := hn.fetchHyperblock(ctx, key)
+		if err != nil {
+			log.Debug("cannot fetch hyperblock for subscribers", "nonce", key.nonce, "with logs", key.withLogs,
+				"error", err)
+			hasFailed[key.withLogs] = true
+			continue
+		}
+
+		hyperblocks[key] = hyperblock
+	}
+
+	return hyperblocks
+}
+
+func (hn *hyperblocksNotifier) fetchHyperblock(ctx context.Context, key hyperblockKey) (*data.Hyperblock, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	options := common.HyperblockQueryOptions{WithLogs: key.withLogs}
+	response, err := hn.hyperblocksProvider.GetHyperBlockByNonce(requestCtx, key.nonce, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data.Hyperblock, nil
+}
+
+func (hn *hyperblocksNotifier) deliverHyperblocks(hyperblocks map[hyperblockKey]*data.Hyperblock) {
+	hn.mutSubscriptions.Lock()
+	defer hn.mutSubscriptions.Unlock()
+
+	for _, sub := range hn.subscriptions {
+		sub.deliverUnprotected(hyperblocks)
+	}
+}
+
+func (hn *hyperblocksNotifier) numSubscriptions() int {
+	hn.mutSubscriptions.Lock()
+	defer hn.mutSubscriptions.Unlock()
+
+	return len(hn.subscriptions)
+}
+
+// Close stops polling and ends all the subscriptions
+func (hn *hyperblocksNotifier) Close() error {
+	hn.cancelPollLoop()
+
+	hn.mutSubscriptions.Lock()
+	defer hn.mutSubscriptions.Unlock()
+
+	hn.isClosed = true
+	for _, sub := range hn.subscriptions {
+		sub.closeUnprotected(ErrNotifierClosed)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hn *hyperblocksNotifier) IsInterfaceNil() bool {
+	return hn == nil
+}
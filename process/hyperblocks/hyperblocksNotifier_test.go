@@ -0,0 +1,364 @@
+package hyperblocks_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/hyperblocks"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type chainStub struct {
+	latestNonce uint64
+	mutFetches  sync.Mutex
+	fetches     map[common.HyperblockQueryOptions][]uint64
+	failedNonce uint64
+}
+
+func newChainStub(latestNonce uint64) *chainStub {
+	return &chainStub{
+		latestNonce: latestNonce,
+		fetches:     make(map[common.HyperblockQueryOptions][]uint64),
+	}
+}
+
+func (cs *chainStub) setLatestNonce(nonce uint64) {
+	atomic.StoreUint64(&cs.latestNonce, nonce)
+}
+
+func (cs *chainStub) getFetches(options common.HyperblockQueryOptions) []uint64 {
+	cs.mutFetches.Lock()
+	defer cs.mutFetches.Unlock()
+
+	return cs.fetches[options]
+}
+
+func createMockArgs(cs *chainStub) hyperblocks.ArgsHyperblocksNotifier {
+	return hyperblocks.ArgsHyperblocksNotifier{
+		NonceProvider: &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func(_ context.Context) (uint64, error) {
+				return atomic.LoadUint64(&cs.latestNonce), nil
+			},
+		},
+		HyperblocksProvider: &mock.HyperblocksProviderStub{
+			GetHyperBlockByNonceCalled: func(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				cs.mutFetches.Lock()
+				defer cs.mutFetches.Unlock()
+
+				if nonce == cs.failedNonce {
+					return nil, errors.New("hyperblock not found")
+				}
+
+				cs.fetches[options] = append(cs.fetches[options], nonce)
+				return data.NewHyperblockApiResponse(data.Hyperblock{Nonce: nonce}), nil
+			},
+		},
+		// the tests poll explicitly
+		PollInterval:         time.Hour,
+		MaxSubscribers:       10,
+		MaxResumeNonces:      100,
+		SubscriberBufferSize: 10,
+	}
+}
+
+type notifierForTests interface {
+	Subscribe(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error)
+	Poll()
+	NumSubscriptions() int
+	Close() error
+}
+
+func createNotifier(t *testing.T, args hyperblocks.ArgsHyperblocksNotifier) notifierForTests {
+	hn, err := hyperblocks.NewHyperblocksNotifier(args)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = hn.Close()
+	})
+
+	return hn
+}
+
+func readNonces(subscription data.HyperblocksSubscriptionHandler) []uint64 {
+	nonces := make([]uint64, 0)
+	for {
+		select {
+		case hyperblock, ok := <-subscription.Hyperblocks():
+			if !ok {
+				return nonces
+			}
+			nonces = append(nonces, hyperblock.Nonce)
+		default:
+			return nonces
+		}
+	}
+}
+
+func TestNewHyperblocksNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nonce provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newChainStub(0))
+		args.NonceProvider = nil
+		hn, err := hyperblocks.NewHyperblocksNotifier(args)
+		require.Equal(t, hyperblocks.ErrNilLatestHyperblockNonceProvider, err)
+		require.True(t, check.IfNil(hn))
+	})
+	t.Run("nil hyperblocks provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newChainStub(0))
+		args.HyperblocksProvider = nil
+		hn, err := hyperblocks.NewHyperblocksNotifier(args)
+		require.Equal(t, hyperblocks.ErrNilHyperblocksProvider, err)
+		require.True(t, check.IfNil(hn))
+	})
+	t.Run("invalid poll interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newChainStub(0))
+		args.PollInterval = time.Millisecond
+		hn, err := hyperblocks.NewHyperblocksNotifier(args)
+		require.True(t, errors.Is(err, hyperblocks.ErrInvalidPollInterval))
+		require.True(t, check.IfNil(hn))
+	})
+	t.Run("invalid max subscribers should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newChainStub(0))
+		args.MaxSubscribers = 0
+		hn, err := hyperblocks.NewHyperblocksNotifier(args)
+		require.True(t, errors.Is(err, hyperblocks.ErrInvalidMaxSubscribers))
+		require.True(t, check.IfNil(hn))
+	})
+	t.Run("invalid max resume nonces should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newChainStub(0))
+		args.MaxResumeNonces = 0
+		hn, err := hyperblocks.NewHyperblocksNotifier(args)
+		require.Equal(t, hyperblocks.ErrInvalidMaxResumeNonces, err)
+		require.True(t, check.IfNil(hn))
+	})
+	t.Run("invalid subscriber buffer size should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(newChainStub(0))
+		args.SubscriberBufferSize = 0
+		hn, err := hyperblocks.NewHyperblocksNotifier(args)
+		require.True(t, errors.Is(err, hyperblocks.ErrInvalidSubscriberBufferSize))
+		require.True(t, check.IfNil(hn))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hn, err := hyperblocks.NewHyperblocksNotifier(createMockArgs(newChainStub(0)))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(hn))
+		require.NoError(t, hn.Close())
+	})
+}
+
+func TestHyperblocksNotifier_SubscribeShouldStartWithTheNextHyperblock(t *testing.T) {
+	t.Parallel()
+
+	cs := newChainStub(10)
+	hn := createNotifier(t, createMockArgs(cs))
+
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+
+	hn.Poll()
+	require.Empty(t, readNonces(subscription))
+
+	cs.setLatestNonce(12)
+	hn.Poll()
+	require.Equal(t, []uint64{11, 12}, readNonces(subscription))
+}
+
+func TestHyperblocksNotifier_PollShouldFetchEachHyperblockOnceForAllTheSubscribers(t *testing.T) {
+	t.Parallel()
+
+	cs := newChainStub(10)
+	hn := createNotifier(t, createMockArgs(cs))
+
+	subscriptions := make([]data.HyperblocksSubscriptionHandler, 0)
+	for i := 0; i < 3; i++ {
+		subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+		require.NoError(t, err)
+		subscriptions = append(subscriptions, subscription)
+	}
+	subscriptionWithLogs, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{WithLogs: true})
+	require.NoError(t, err)
+
+	cs.setLatestNonce(11)
+	hn.Poll()
+
+	for _, subscription := range subscriptions {
+		require.Equal(t, []uint64{11}, readNonces(subscription))
+	}
+	require.Equal(t, []uint64{11}, readNonces(subscriptionWithLogs))
+	require.Equal(t, []uint64{11}, cs.getFetches(common.HyperblockQueryOptions{}))
+	require.Equal(t, []uint64{11}, cs.getFetches(common.HyperblockQueryOptions{WithLogs: true}))
+}
+
+func TestHyperblocksNotifier_ResumedSubscriptionShouldCatchUpWithinItsBuffer(t *testing.T) {
+	t.Parallel()
+
+	cs := newChainStub(10)
+	args := createMockArgs(cs)
+	args.SubscriberBufferSize = 3
+	hn := createNotifier(t, args)
+
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{
+		FromNonce: core.OptionalUint64{Value: 5, HasValue: true},
+	})
+	require.NoError(t, err)
+
+	hn.Poll()
+	require.Equal(t, []uint64{5, 6, 7}, readNonces(subscription))
+
+	hn.Poll()
+	require.Equal(t, []uint64{8, 9, 10}, readNonces(subscription))
+
+	hn.Poll()
+	require.Empty(t, readNonces(subscription))
+}
+
+func TestHyperblocksNotifier_SubscribeFromTooOldNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cs := newChainStub(200)
+	hn := createNotifier(t, createMockArgs(cs))
+
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{
+		FromNonce: core.OptionalUint64{Value: 100, HasValue: true},
+	})
+	require.True(t, errors.Is(err, apiErrors.ErrResumeNonceTooOld))
+	require.Nil(t, subscription)
+
+	subscription, err = hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{
+		FromNonce: core.OptionalUint64{Value: 101, HasValue: true},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, subscription)
+}
+
+func TestHyperblocksNotifier_SubscribeTooManySubscribersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(newChainStub(10))
+	args.MaxSubscribers = 2
+	hn := createNotifier(t, args)
+
+	first, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+	_, err = hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+
+	_, err = hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.Equal(t, apiErrors.ErrTooManyHyperblocksSubscribers, err)
+
+	first.Close()
+	require.Equal(t, 1, hn.NumSubscriptions())
+	_, err = hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+}
+
+func TestHyperblocksNotifier_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	cs := newChainStub(10)
+	args := createMockArgs(cs)
+	args.MaxResumeNonces = 5
+	args.SubscriberBufferSize = 2
+	hn := createNotifier(t, args)
+
+	slowSubscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+
+	for nonce := uint64(11); nonce <= 17; nonce++ {
+		cs.setLatestNonce(nonce)
+		hn.Poll()
+		require.Equal(t, []uint64{nonce}, readNonces(subscription))
+	}
+	require.Equal(t, 2, hn.NumSubscriptions())
+
+	cs.setLatestNonce(18)
+	hn.Poll()
+	require.Equal(t, []uint64{18}, readNonces(subscription))
+	require.Equal(t, 1, hn.NumSubscriptions())
+
+	// the buffered hyperblocks are still received before the channel is closed
+	require.Equal(t, []uint64{11, 12}, readNonces(slowSubscription))
+	require.True(t, errors.Is(slowSubscription.Err(), hyperblocks.ErrSubscriberTooSlow))
+}
+
+func TestHyperblocksNotifier_FetchFailureShouldBeRetriedOnTheNextPoll(t *testing.T) {
+	t.Parallel()
+
+	cs := newChainStub(10)
+	hn := createNotifier(t, createMockArgs(cs))
+
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+
+	cs.failedNonce = 12
+	cs.setLatestNonce(14)
+	hn.Poll()
+	require.Equal(t, []uint64{11}, readNonces(subscription))
+	require.Equal(t, []uint64{11}, cs.getFetches(common.HyperblockQueryOptions{}))
+
+	cs.mutFetches.Lock()
+	cs.failedNonce = 0
+	cs.mutFetches.Unlock()
+	hn.Poll()
+	require.Equal(t, []uint64{12, 13, 14}, readNonces(subscription))
+}
+
+func TestHyperblocksNotifier_CloseShouldEndTheSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	hn, err := hyperblocks.NewHyperblocksNotifier(createMockArgs(newChainStub(10)))
+	require.NoError(t, err)
+
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, hn.Close())
+	_, ok := <-subscription.Hyperblocks()
+	require.False(t, ok)
+	require.Equal(t, hyperblocks.ErrNotifierClosed, subscription.Err())
+
+	_, err = hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.Equal(t, hyperblocks.ErrNotifierClosed, err)
+}
+
+func TestSubscription_CloseShouldEndTheSubscriptionWithoutError(t *testing.T) {
+	t.Parallel()
+
+	hn := createNotifier(t, createMockArgs(newChainStub(10)))
+
+	subscription, err := hn.Subscribe(context.Background(), common.HyperblocksSubscriptionOptions{})
+	require.NoError(t, err)
+
+	subscription.Close()
+	subscription.Close()
+	_, ok := <-subscription.Hyperblocks()
+	require.False(t, ok)
+	require.Nil(t, subscription.Err())
+	require.Equal(t, 0, hn.NumSubscriptions())
+}
@@ -0,0 +1,21 @@
+package hyperblocks
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// LatestHyperblockNonceProvider defines what a component which provides the latest fully synchronized hyperblock
+// nonce should be able to do
+type LatestHyperblockNonceProvider interface {
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// HyperblocksProvider defines what a component which fetches the hyperblocks should be able to do
+type HyperblocksProvider interface {
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	IsInterfaceNil() bool
+}
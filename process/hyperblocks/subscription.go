@@ -0,0 +1,67 @@
+package hyperblocks
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// subscription holds the state of a subscriber. Its fields are protected by the notifier's mutex, which also makes
+// sure that no hyperblock is sent on the channel after it was closed
+type subscription struct {
+	id          uint64
+	withLogs    bool
+	nextNonce   uint64
+	hyperblocks chan *data.Hyperblock
+	err         error
+	isClosed    bool
+	notifier    *hyperblocksNotifier
+}
+
+// Hyperblocks returns the channel on which the hyperblocks are received, in ascending nonce order
+func (s *subscription) Hyperblocks() <-chan *data.Hyperblock {
+	return s.hyperblocks
+}
+
+// Err returns the reason for which the subscription was ended by the notifier, if any
+func (s *subscription) Err() error {
+	s.notifier.mutSubscriptions.Lock()
+	defer s.notifier.mutSubscriptions.Unlock()
+
+	return s.err
+}
+
+// Close ends the subscription
+func (s *subscription) Close() {
+	s.notifier.mutSubscriptions.Lock()
+	defer s.notifier.mutSubscriptions.Unlock()
+
+	s.closeUnprotected(nil)
+}
+
+func (s *subscription) deliverUnprotected(hyperblocks map[hyperblockKey]*data.Hyperblock) {
+	for {
+		hyperblock, found := hyperblocks[hyperblockKey{nonce: s.nextNonce, withLogs: s.withLogs}]
+		if !found {
+			return
+		}
+
+		select {
+		case s.hyperblocks <- hyperblock:
+			s.nextNonce++
+		default:
+			return
+		}
+	}
+}
+
+func (s *subscription) closeUnprotected(err error) {
+	if s.isClosed {
+		return
+	}
+
+	s.isClosed = true
+	s.err = err
+	close(s.hyperblocks)
+	delete(s.notifier.subscriptions, s.id)
+
+	log.Debug("hyperblocks subscription ended", "id", s.id, "error", err)
+}
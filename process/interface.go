@@ -1,18 +1,24 @@
 package process
 
 import (
+	"context"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 )
 
 // Processor defines what a processor should be able to do
 type Processor interface {
 	GetObservers(shardID uint32) ([]*data.NodeData, error)
+	GetObserversForAddress(shardID uint32, address []byte) ([]*data.NodeData, error)
 	GetAllObservers() ([]*data.NodeData, error)
 	GetObserversOnePerShard() ([]*data.NodeData, error)
 	GetFullHistoryNodesOnePerShard() ([]*data.NodeData, error)
@@ -20,12 +26,15 @@ type Processor interface {
 	GetAllFullHistoryNodes() ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinator() sharding.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodes() []*data.QuarantinedNode
+	GetNodesHealth() []*data.NodeHealthStatus
 	IsInterfaceNil() bool
 }
 
@@ -41,30 +50,18 @@ type PrivateKeysLoaderHandler interface {
 	PrivateKeysByShard() (map[uint32][]crypto.PrivateKey, error)
 }
 
-// HeartbeatCacheHandler will define what a real heartbeat cacher should do
-type HeartbeatCacheHandler interface {
-	LoadHeartbeats() (*data.HeartbeatResponse, error)
-	StoreHeartbeats(hbts *data.HeartbeatResponse) error
-	IsInterfaceNil() bool
-}
-
-// ValidatorStatisticsCacheHandler will define what a real validator statistics cacher should do
-type ValidatorStatisticsCacheHandler interface {
-	LoadValStats() (map[string]*data.ValidatorApiResponse, error)
-	StoreValStats(valStats map[string]*data.ValidatorApiResponse) error
-	IsInterfaceNil() bool
-}
-
-// GenericApiResponseCacheHandler will define what a real economic metrics cacher should do
-type GenericApiResponseCacheHandler interface {
-	Load() (*data.GenericAPIResponse, error)
-	Store(response *data.GenericAPIResponse)
+// TTLCacheHandler defines what a keyed cache with time-to-live entries should be able to do
+type TTLCacheHandler interface {
+	Register(key string, config cache.KeyConfig, fetch cache.FetchHandler) error
+	UpdateKeyConfig(key string, config cache.KeyConfig) error
+	Get(ctx context.Context, key string) (interface{}, error)
+	Load(key string) (interface{}, bool)
 	IsInterfaceNil() bool
 }
 
 // TransactionCostHandler will define what a real transaction cost handler should do
 type TransactionCostHandler interface {
-	ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	ResolveCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 }
 
 // LogsMergerHandler will define what a real merge logs handler should do
@@ -75,7 +72,7 @@ type LogsMergerHandler interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error)
 	IsInterfaceNil() bool
 }
 
@@ -83,5 +80,122 @@ type SCQueryService interface {
 type StatusMetricsProvider interface {
 	GetAll() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheus() string
+	GetCoalescedRequests() map[string]uint64
+	GetApiKeysUsage() map[string]*data.ApiKeyUsage
+	IsInterfaceNil() bool
+}
+
+// CircuitBreakerHandler defines what a per-node circuit breaker should be able to do
+type CircuitBreakerHandler interface {
+	IsRequestAllowed(address string) bool
+	RecordSuccess(address string)
+	RecordFailure(address string)
+	GetStatuses() []*data.NodeCircuitBreakerStatus
+	IsInterfaceNil() bool
+}
+
+// NodesSanityCheckerHandler defines what a component which quarantines the nodes whose reported values do not match
+// their configuration should be able to do
+type NodesSanityCheckerHandler interface {
+	CheckNodes(nodesWithMetrics []*data.NodeWithMetrics)
+	IsQuarantined(node *data.NodeData) bool
+	GetQuarantinedNodes() []*data.QuarantinedNode
+	IsInterfaceNil() bool
+}
+
+// NodesSyncStateCheckerHandler defines what a component which decides when the nodes of each shard should be checked
+// and whether they are synced should be able to do
+type NodesSyncStateCheckerHandler interface {
+	GetShardsToCheck() []uint32
+	ScheduleNextChecks(shardIDs []uint32)
+	GetDelayUntilNextCheck() time.Duration
+	ComputeSyncState(nodesWithMetrics []*data.NodeWithMetrics)
+	IsInterfaceNil() bool
+}
+
+// NodesHealthTrackerHandler defines what a component which keeps track of the health of the nodes should be able to do
+type NodesHealthTrackerHandler interface {
+	observer.NodesRequestsTracker
+	RecordNodesStatus(nodesWithMetrics []*data.NodeWithMetrics, knownNodes []*data.NodeData)
+	PopulateNodesHealth(statuses []*data.NodeHealthStatus)
+	IsInterfaceNil() bool
+}
+
+// RequestsHedgerHandler defines what a component which decides the delay after which a request towards an observer
+// should be hedged should be able to do
+type RequestsHedgerHandler interface {
+	GetHedgingDelay(operation string) (time.Duration, bool)
+	RecordResponseTime(operation string, duration time.Duration)
+	IsInterfaceNil() bool
+}
+
+// RequestsCoalescerHandler defines what a component which coalesces the identical requests towards the same node should
+// be able to do
+type RequestsCoalescerHandler interface {
+	CoalesceRequest(ctx context.Context, address string, path string, handler func() (interface{}, error)) (interface{}, bool, error)
+	IsInterfaceNil() bool
+}
+
+// ResponseCacheHandler defines what a cache for the immutable responses should be able to do
+type ResponseCacheHandler interface {
+	Get(ctx context.Context, key string, value interface{}) bool
+	Put(key string, value interface{})
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// SessionAffinityHandler defines what a component which routes the queries of a sender towards the observer which
+// accepted its last transaction should be able to do
+type SessionAffinityHandler interface {
+	RecordObserver(ctx context.Context, address string, observer *data.NodeData)
+	SortObservers(ctx context.Context, address string, observers []*data.NodeData) ([]*data.NodeData, bool)
+	IsInterfaceNil() bool
+}
+
+// TransactionSignatureVerifier defines what a component which verifies the transactions' signatures should be able to do
+type TransactionSignatureVerifier interface {
+	VerifySignature(tx *data.Transaction) error
+	IsInterfaceNil() bool
+}
+
+// TransactionValidator defines what a component which validates the transactions before relaying them should be able to do
+type TransactionValidator interface {
+	ValidateTransaction(ctx context.Context, tx *data.Transaction) error
+	IsInterfaceNil() bool
+}
+
+// HyperblocksNotifierHandler defines what a component which notifies the subscribers about the new fully synchronized
+// hyperblocks should be able to do
+type HyperblocksNotifierHandler interface {
+	Subscribe(ctx context.Context, options common.HyperblocksSubscriptionOptions) (data.HyperblocksSubscriptionHandler, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TransactionsTrackerHandler defines what a component which waits for the transactions to complete should be able to do
+type TransactionsTrackerHandler interface {
+	WaitForCompletion(ctx context.Context, txHash string, options common.TransactionWaitOptions) (*data.TransactionWaitResult, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// NonceManagerHandler defines what a component which hands out non-conflicting nonces to the senders should be able to do
+type NonceManagerHandler interface {
+	ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error)
+	RecordSentNonce(address string, nonce uint64)
+	IsInterfaceNil() bool
+}
+
+// NodesReloaderHandler defines what a component which reloads the observers from the config file should be able to do
+type NodesReloaderHandler interface {
+	ReloadObservers() data.NodesReloadResponse
+	ReloadFullHistoryObservers() data.NodesReloadResponse
+	IsInterfaceNil() bool
+}
+
+// ConfigReloaderHandler defines what a component which reloads the configs while the proxy is running should be able
+// to do
+type ConfigReloaderHandler interface {
+	ReloadConfig() data.NodesReloadResponse
 	IsInterfaceNil() bool
 }
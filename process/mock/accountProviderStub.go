@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// AccountProviderStub -
+type AccountProviderStub struct {
+	GetAccountCalled func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// GetAccount -
+func (stub *AccountProviderStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	if stub.GetAccountCalled != nil {
+		return stub.GetAccountCalled(ctx, address, options)
+	}
+
+	return &data.AccountModel{}, nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+// CacheMetricsHandlerStub -
+type CacheMetricsHandlerStub struct {
+	AddCacheHitCalled  func(cacheName string)
+	AddCacheMissCalled func(cacheName string)
+}
+
+// AddCacheHit -
+func (cmhs *CacheMetricsHandlerStub) AddCacheHit(cacheName string) {
+	if cmhs.AddCacheHitCalled != nil {
+		cmhs.AddCacheHitCalled(cacheName)
+	}
+}
+
+// AddCacheMiss -
+func (cmhs *CacheMetricsHandlerStub) AddCacheMiss(cacheName string) {
+	if cmhs.AddCacheMissCalled != nil {
+		cmhs.AddCacheMissCalled(cacheName)
+	}
+}
+
+// IsInterfaceNil -
+func (cmhs *CacheMetricsHandlerStub) IsInterfaceNil() bool {
+	return cmhs == nil
+}
@@ -0,0 +1,48 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// CircuitBreakerStub -
+type CircuitBreakerStub struct {
+	IsRequestAllowedCalled func(address string) bool
+	RecordSuccessCalled    func(address string)
+	RecordFailureCalled    func(address string)
+	GetStatusesCalled      func() []*data.NodeCircuitBreakerStatus
+}
+
+// IsRequestAllowed -
+func (cbs *CircuitBreakerStub) IsRequestAllowed(address string) bool {
+	if cbs.IsRequestAllowedCalled != nil {
+		return cbs.IsRequestAllowedCalled(address)
+	}
+
+	return true
+}
+
+// RecordSuccess -
+func (cbs *CircuitBreakerStub) RecordSuccess(address string) {
+	if cbs.RecordSuccessCalled != nil {
+		cbs.RecordSuccessCalled(address)
+	}
+}
+
+// RecordFailure -
+func (cbs *CircuitBreakerStub) RecordFailure(address string) {
+	if cbs.RecordFailureCalled != nil {
+		cbs.RecordFailureCalled(address)
+	}
+}
+
+// GetStatuses -
+func (cbs *CircuitBreakerStub) GetStatuses() []*data.NodeCircuitBreakerStatus {
+	if cbs.GetStatusesCalled != nil {
+		return cbs.GetStatusesCalled()
+	}
+
+	return make([]*data.NodeCircuitBreakerStatus, 0)
+}
+
+// IsInterfaceNil -
+func (cbs *CircuitBreakerStub) IsInterfaceNil() bool {
+	return cbs == nil
+}
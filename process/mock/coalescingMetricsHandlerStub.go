@@ -0,0 +1,18 @@
+package mock
+
+// CoalescingMetricsHandlerStub -
+type CoalescingMetricsHandlerStub struct {
+	AddCoalescedRequestCalled func(address string)
+}
+
+// AddCoalescedRequest -
+func (cmhs *CoalescingMetricsHandlerStub) AddCoalescedRequest(address string) {
+	if cmhs.AddCoalescedRequestCalled != nil {
+		cmhs.AddCoalescedRequestCalled(address)
+	}
+}
+
+// IsInterfaceNil -
+func (cmhs *CoalescingMetricsHandlerStub) IsInterfaceNil() bool {
+	return cmhs == nil
+}
@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// HyperblocksProviderStub -
+type HyperblocksProviderStub struct {
+	GetHyperBlockByNonceCalled func(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+// GetHyperBlockByNonce -
+func (stub *HyperblocksProviderStub) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.GetHyperBlockByNonceCalled != nil {
+		return stub.GetHyperBlockByNonceCalled(ctx, nonce, options)
+	}
+
+	return data.NewHyperblockApiResponse(data.Hyperblock{Nonce: nonce}), nil
+}
+
+// IsInterfaceNil -
+func (stub *HyperblocksProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
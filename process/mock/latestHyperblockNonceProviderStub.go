@@ -0,0 +1,22 @@
+package mock
+
+import "context"
+
+// LatestHyperblockNonceProviderStub -
+type LatestHyperblockNonceProviderStub struct {
+	GetLatestFullySynchronizedHyperblockNonceCalled func(ctx context.Context) (uint64, error)
+}
+
+// GetLatestFullySynchronizedHyperblockNonce -
+func (stub *LatestHyperblockNonceProviderStub) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
+	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
+		return stub.GetLatestFullySynchronizedHyperblockNonceCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *LatestHyperblockNonceProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
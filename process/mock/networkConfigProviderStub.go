@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// NetworkConfigProviderStub -
+type NetworkConfigProviderStub struct {
+	GetNetworkConfigMetricsCalled func(ctx context.Context) (*data.GenericAPIResponse, error)
+}
+
+// GetNetworkConfigMetrics -
+func (stub *NetworkConfigProviderStub) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	if stub.GetNetworkConfigMetricsCalled != nil {
+		return stub.GetNetworkConfigMetricsCalled(ctx)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *NetworkConfigProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,29 @@
+package mock
+
+import "time"
+
+// NodesRequestsTrackerProviderStub -
+type NodesRequestsTrackerProviderStub struct {
+	ObserversProviderStub
+	RequestStartedCalled  func(address string)
+	RequestFinishedCalled func(address string, duration time.Duration, withError bool)
+}
+
+// RequestStarted -
+func (stub *NodesRequestsTrackerProviderStub) RequestStarted(address string) {
+	if stub.RequestStartedCalled != nil {
+		stub.RequestStartedCalled(address)
+	}
+}
+
+// RequestFinished -
+func (stub *NodesRequestsTrackerProviderStub) RequestFinished(address string, duration time.Duration, withError bool) {
+	if stub.RequestFinishedCalled != nil {
+		stub.RequestFinishedCalled(address, duration, withError)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *NodesRequestsTrackerProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,40 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// NodesSanityCheckerStub -
+type NodesSanityCheckerStub struct {
+	CheckNodesCalled          func(nodesWithMetrics []*data.NodeWithMetrics)
+	IsQuarantinedCalled       func(node *data.NodeData) bool
+	GetQuarantinedNodesCalled func() []*data.QuarantinedNode
+}
+
+// CheckNodes -
+func (nscs *NodesSanityCheckerStub) CheckNodes(nodesWithMetrics []*data.NodeWithMetrics) {
+	if nscs.CheckNodesCalled != nil {
+		nscs.CheckNodesCalled(nodesWithMetrics)
+	}
+}
+
+// IsQuarantined -
+func (nscs *NodesSanityCheckerStub) IsQuarantined(node *data.NodeData) bool {
+	if nscs.IsQuarantinedCalled != nil {
+		return nscs.IsQuarantinedCalled(node)
+	}
+
+	return false
+}
+
+// GetQuarantinedNodes -
+func (nscs *NodesSanityCheckerStub) GetQuarantinedNodes() []*data.QuarantinedNode {
+	if nscs.GetQuarantinedNodesCalled != nil {
+		return nscs.GetQuarantinedNodesCalled()
+	}
+
+	return make([]*data.QuarantinedNode, 0)
+}
+
+// IsInterfaceNil -
+func (nscs *NodesSanityCheckerStub) IsInterfaceNil() bool {
+	return nscs == nil
+}
@@ -7,10 +7,13 @@ import (
 // ObserversProviderStub -
 type ObserversProviderStub struct {
 	GetNodesByShardIdCalled           func(shardId uint32) ([]*data.NodeData, error)
+	GetNodesByShardIdForAddressCalled func(shardId uint32, address []byte) ([]*data.NodeData, error)
 	GetAllNodesCalled                 func() ([]*data.NodeData, error)
 	ReloadNodesCalled                 func(nodesType data.NodeType) data.NodesReloadResponse
 	UpdateNodesBasedOnSyncStateCalled func(nodesWithSyncStatus []*data.NodeData)
 	GetAllNodesWithSyncStateCalled    func() []*data.NodeData
+	GetLastSyncedNodesCalled          func() map[uint32]*data.NodeData
+	UpdateNodesCalled                 func(nodes []*data.NodeData) error
 }
 
 // GetNodesByShardId -
@@ -27,6 +30,15 @@ func (ops *ObserversProviderStub) GetNodesByShardId(shardId uint32) ([]*data.Nod
 	}, nil
 }
 
+// GetNodesByShardIdForAddress -
+func (ops *ObserversProviderStub) GetNodesByShardIdForAddress(shardId uint32, address []byte) ([]*data.NodeData, error) {
+	if ops.GetNodesByShardIdForAddressCalled != nil {
+		return ops.GetNodesByShardIdForAddressCalled(shardId, address)
+	}
+
+	return ops.GetNodesByShardId(shardId)
+}
+
 // GetAllNodes -
 func (ops *ObserversProviderStub) GetAllNodes() ([]*data.NodeData, error) {
 	if ops.GetAllNodesCalled != nil {
@@ -57,6 +69,15 @@ func (ops *ObserversProviderStub) GetAllNodesWithSyncState() []*data.NodeData {
 	return make([]*data.NodeData, 0)
 }
 
+// GetLastSyncedNodes -
+func (ops *ObserversProviderStub) GetLastSyncedNodes() map[uint32]*data.NodeData {
+	if ops.GetLastSyncedNodesCalled != nil {
+		return ops.GetLastSyncedNodesCalled()
+	}
+
+	return make(map[uint32]*data.NodeData)
+}
+
 // ReloadNodes -
 func (ops *ObserversProviderStub) ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse {
 	if ops.ReloadNodesCalled != nil {
@@ -66,6 +87,15 @@ func (ops *ObserversProviderStub) ReloadNodes(nodesType data.NodeType) data.Node
 	return data.NodesReloadResponse{}
 }
 
+// UpdateNodes -
+func (ops *ObserversProviderStub) UpdateNodes(nodes []*data.NodeData) error {
+	if ops.UpdateNodesCalled != nil {
+		return ops.UpdateNodesCalled(nodes)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (ops *ObserversProviderStub) IsInterfaceNil() bool {
 	return ops == nil
@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// PoolNoncesProviderStub -
+type PoolNoncesProviderStub struct {
+	GetLastPoolNonceForSenderCalled             func(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled func(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTransactionsPoolForSenderCalled          func(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error)
+}
+
+// GetLastPoolNonceForSender -
+func (stub *PoolNoncesProviderStub) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	if stub.GetLastPoolNonceForSenderCalled != nil {
+		return stub.GetLastPoolNonceForSenderCalled(ctx, sender)
+	}
+
+	return 0, errors.New("no transactions in pool")
+}
+
+// GetTransactionsPoolNonceGapsForSender -
+func (stub *PoolNoncesProviderStub) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	if stub.GetTransactionsPoolNonceGapsForSenderCalled != nil {
+		return stub.GetTransactionsPoolNonceGapsForSenderCalled(ctx, sender)
+	}
+
+	return &data.TransactionsPoolNonceGaps{}, nil
+}
+
+// GetTransactionsPoolForSender -
+func (stub *PoolNoncesProviderStub) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	if stub.GetTransactionsPoolForSenderCalled != nil {
+		return stub.GetTransactionsPoolForSenderCalled(ctx, sender, fields)
+	}
+
+	return &data.TransactionsPoolForSender{}, nil
+}
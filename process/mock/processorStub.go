@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
@@ -14,6 +16,7 @@ var errNotImplemented = errors.New("not implemented")
 type ProcessorStub struct {
 	ApplyConfigCalled                    func(cfg *config.Config) error
 	GetObserversCalled                   func(shardId uint32) ([]*data.NodeData, error)
+	GetObserversForAddressCalled         func(shardID uint32, address []byte) ([]*data.NodeData, error)
 	GetAllObserversCalled                func() ([]*data.NodeData, error)
 	GetObserversOnePerShardCalled        func() ([]*data.NodeData, error)
 	GetFullHistoryNodesOnePerShardCalled func() ([]*data.NodeData, error)
@@ -27,6 +30,9 @@ type ProcessorStub struct {
 	GetPubKeyConverterCalled             func() core.PubkeyConverter
 	GetObserverProviderCalled            func() observer.NodesProviderHandler
 	GetFullHistoryNodesProviderCalled    func() observer.NodesProviderHandler
+	GetCircuitBreakersStatusesCalled     func() []*data.NodeCircuitBreakerStatus
+	GetQuarantinedNodesCalled            func() []*data.QuarantinedNode
+	GetNodesHealthCalled                 func() []*data.NodeHealthStatus
 }
 
 // GetShardCoordinator -
@@ -65,6 +71,33 @@ func (ps *ProcessorStub) GetFullHistoryNodesProvider() observer.NodesProviderHan
 	return &ObserversProviderStub{}
 }
 
+// GetCircuitBreakersStatuses -
+func (ps *ProcessorStub) GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus {
+	if ps.GetCircuitBreakersStatusesCalled != nil {
+		return ps.GetCircuitBreakersStatusesCalled()
+	}
+
+	return make([]*data.NodeCircuitBreakerStatus, 0)
+}
+
+// GetQuarantinedNodes -
+func (ps *ProcessorStub) GetQuarantinedNodes() []*data.QuarantinedNode {
+	if ps.GetQuarantinedNodesCalled != nil {
+		return ps.GetQuarantinedNodesCalled()
+	}
+
+	return make([]*data.QuarantinedNode, 0)
+}
+
+// GetNodesHealth -
+func (ps *ProcessorStub) GetNodesHealth() []*data.NodeHealthStatus {
+	if ps.GetNodesHealthCalled != nil {
+		return ps.GetNodesHealthCalled()
+	}
+
+	return make([]*data.NodeHealthStatus, 0)
+}
+
 // ApplyConfig will call the ApplyConfigCalled handler if not nil
 func (ps *ProcessorStub) ApplyConfig(cfg *config.Config) error {
 	if ps.ApplyConfigCalled != nil {
@@ -83,6 +116,16 @@ func (ps *ProcessorStub) GetObservers(shardID uint32) ([]*data.NodeData, error)
 	return nil, errNotImplemented
 }
 
+// GetObserversForAddress will call the GetObserversForAddressCalled handler if not nil, otherwise it will return the
+// same observers as GetObservers
+func (ps *ProcessorStub) GetObserversForAddress(shardID uint32, address []byte) ([]*data.NodeData, error) {
+	if ps.GetObserversForAddressCalled != nil {
+		return ps.GetObserversForAddressCalled(shardID, address)
+	}
+
+	return ps.GetObservers(shardID)
+}
+
 // ComputeShardId will call the ComputeShardIdCalled if not nil
 func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 	if ps.ComputeShardIdCalled != nil {
@@ -93,7 +136,7 @@ func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 }
 
 // CallGetRestEndPoint will call the CallGetRestEndPointCalled if not nil
-func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value interface{}) (int, error) {
+func (ps *ProcessorStub) CallGetRestEndPoint(_ context.Context, address string, path string, value interface{}) (int, error) {
 	if ps.CallGetRestEndPointCalled != nil {
 		return ps.CallGetRestEndPointCalled(address, path, value)
 	}
@@ -102,7 +145,7 @@ func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value
 }
 
 // CallPostRestEndPoint will call the CallPostRestEndPoint if not nil
-func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error) {
+func (ps *ProcessorStub) CallPostRestEndPoint(_ context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 	if ps.CallPostRestEndPointCalled != nil {
 		return ps.CallPostRestEndPointCalled(address, path, data, response)
 	}
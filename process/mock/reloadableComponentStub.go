@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/config"
+
+// ReloadableComponentStub -
+type ReloadableComponentStub struct {
+	PrepareReloadCalled func(configs *config.ReloadableConfigs) (func(), error)
+}
+
+// PrepareReload -
+func (rcs *ReloadableComponentStub) PrepareReload(configs *config.ReloadableConfigs) (func(), error) {
+	if rcs.PrepareReloadCalled != nil {
+		return rcs.PrepareReloadCalled(configs)
+	}
+
+	return func() {}, nil
+}
+
+// IsInterfaceNil -
+func (rcs *ReloadableComponentStub) IsInterfaceNil() bool {
+	return rcs == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import "time"
+
+// RequestsHedgerStub -
+type RequestsHedgerStub struct {
+	GetHedgingDelayCalled    func(operation string) (time.Duration, bool)
+	RecordResponseTimeCalled func(operation string, duration time.Duration)
+}
+
+// GetHedgingDelay -
+func (rhs *RequestsHedgerStub) GetHedgingDelay(operation string) (time.Duration, bool) {
+	if rhs.GetHedgingDelayCalled != nil {
+		return rhs.GetHedgingDelayCalled(operation)
+	}
+
+	return 0, false
+}
+
+// RecordResponseTime -
+func (rhs *RequestsHedgerStub) RecordResponseTime(operation string, duration time.Duration) {
+	if rhs.RecordResponseTimeCalled != nil {
+		rhs.RecordResponseTimeCalled(operation, duration)
+	}
+}
+
+// IsInterfaceNil -
+func (rhs *RequestsHedgerStub) IsInterfaceNil() bool {
+	return rhs == nil
+}
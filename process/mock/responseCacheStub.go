@@ -0,0 +1,40 @@
+package mock
+
+import "context"
+
+// ResponseCacheStub -
+type ResponseCacheStub struct {
+	GetCalled       func(key string, value interface{}) bool
+	PutCalled       func(key string, value interface{})
+	IsEnabledCalled func() bool
+}
+
+// Get -
+func (rcs *ResponseCacheStub) Get(_ context.Context, key string, value interface{}) bool {
+	if rcs.GetCalled != nil {
+		return rcs.GetCalled(key, value)
+	}
+
+	return false
+}
+
+// Put -
+func (rcs *ResponseCacheStub) Put(key string, value interface{}) {
+	if rcs.PutCalled != nil {
+		rcs.PutCalled(key, value)
+	}
+}
+
+// IsEnabled -
+func (rcs *ResponseCacheStub) IsEnabled() bool {
+	if rcs.IsEnabledCalled != nil {
+		return rcs.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (rcs *ResponseCacheStub) IsInterfaceNil() bool {
+	return rcs == nil
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// SessionAffinityStub -
+type SessionAffinityStub struct {
+	RecordObserverCalled func(ctx context.Context, address string, observer *data.NodeData)
+	SortObserversCalled  func(ctx context.Context, address string, observers []*data.NodeData) ([]*data.NodeData, bool)
+}
+
+// RecordObserver -
+func (sas *SessionAffinityStub) RecordObserver(ctx context.Context, address string, observer *data.NodeData) {
+	if sas.RecordObserverCalled != nil {
+		sas.RecordObserverCalled(ctx, address, observer)
+	}
+}
+
+// SortObservers -
+func (sas *SessionAffinityStub) SortObservers(ctx context.Context, address string, observers []*data.NodeData) ([]*data.NodeData, bool) {
+	if sas.SortObserversCalled != nil {
+		return sas.SortObserversCalled(ctx, address, observers)
+	}
+
+	return observers, false
+}
+
+// IsInterfaceNil -
+func (sas *SessionAffinityStub) IsInterfaceNil() bool {
+	return sas == nil
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"context"
+	"time"
+)
+
+// SharedStoreStub -
+type SharedStoreStub struct {
+	GetCalled func(ctx context.Context, key string) ([]byte, bool, error)
+	SetCalled func(ctx context.Context, key string, value []byte, expiration time.Duration) error
+}
+
+// Get -
+func (sss *SharedStoreStub) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	if sss.GetCalled != nil {
+		return sss.GetCalled(ctx, key)
+	}
+
+	return nil, false, nil
+}
+
+// Set -
+func (sss *SharedStoreStub) Set(ctx context.Context, key string, value []byte, expiration time.Duration) error {
+	if sss.SetCalled != nil {
+		return sss.SetCalled(ctx, key, value, expiration)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (sss *SharedStoreStub) IsInterfaceNil() bool {
+	return sss == nil
+}
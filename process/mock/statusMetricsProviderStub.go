@@ -8,6 +8,8 @@ import (
 type StatusMetricsProviderStub struct {
 	GetAllCalled                  func() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheusCalled func() string
+	GetCoalescedRequestsCalled    func() map[string]uint64
+	GetApiKeysUsageCalled         func() map[string]*data.ApiKeyUsage
 }
 
 // GetMetricsForPrometheus -
@@ -28,6 +30,24 @@ func (s *StatusMetricsProviderStub) GetAll() map[string]*data.EndpointMetrics {
 	return make(map[string]*data.EndpointMetrics)
 }
 
+// GetCoalescedRequests -
+func (s *StatusMetricsProviderStub) GetCoalescedRequests() map[string]uint64 {
+	if s.GetCoalescedRequestsCalled != nil {
+		return s.GetCoalescedRequestsCalled()
+	}
+
+	return make(map[string]uint64)
+}
+
+// GetApiKeysUsage -
+func (s *StatusMetricsProviderStub) GetApiKeysUsage() map[string]*data.ApiKeyUsage {
+	if s.GetApiKeysUsageCalled != nil {
+		return s.GetApiKeysUsageCalled()
+	}
+
+	return make(map[string]*data.ApiKeyUsage)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *StatusMetricsProviderStub) IsInterfaceNil() bool {
 	return s == nil
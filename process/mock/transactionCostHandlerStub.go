@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // TransactionCostHandlerStub -
 type TransactionCostHandlerStub struct {
@@ -8,7 +11,7 @@ type TransactionCostHandlerStub struct {
 }
 
 // ResolveCostRequest -
-func (tchs *TransactionCostHandlerStub) ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tchs *TransactionCostHandlerStub) ResolveCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	if tchs.RezolveCostRequestCalled != nil {
 		return tchs.RezolveCostRequestCalled(tx)
 	}
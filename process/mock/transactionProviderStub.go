@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+// TransactionProviderStub -
+type TransactionProviderStub struct {
+	GetTransactionCalled func(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+}
+
+// GetTransaction -
+func (stub *TransactionProviderStub) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if stub.GetTransactionCalled != nil {
+		return stub.GetTransactionCalled(ctx, txHash, withResults)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// TransactionSignatureVerifierStub -
+type TransactionSignatureVerifierStub struct {
+	VerifySignatureCalled func(tx *data.Transaction) error
+}
+
+// VerifySignature -
+func (stub *TransactionSignatureVerifierStub) VerifySignature(tx *data.Transaction) error {
+	if stub.VerifySignatureCalled != nil {
+		return stub.VerifySignatureCalled(tx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionSignatureVerifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// TransactionValidatorStub -
+type TransactionValidatorStub struct {
+	ValidateTransactionCalled func(ctx context.Context, tx *data.Transaction) error
+}
+
+// ValidateTransaction -
+func (stub *TransactionValidatorStub) ValidateTransaction(ctx context.Context, tx *data.Transaction) error {
+	if stub.ValidateTransactionCalled != nil {
+		return stub.ValidateTransactionCalled(ctx, tx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionValidatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,75 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+)
+
+// TTLCacheStub -
+type TTLCacheStub struct {
+	RegisterCalled        func(key string, config cache.KeyConfig, fetch cache.FetchHandler) error
+	UpdateKeyConfigCalled func(key string, config cache.KeyConfig) error
+	GetCalled             func(ctx context.Context, key string) (interface{}, error)
+	LoadCalled            func(key string) (interface{}, bool)
+
+	mutFetchers sync.RWMutex
+	fetchers    map[string]cache.FetchHandler
+}
+
+// Register -
+func (tcs *TTLCacheStub) Register(key string, config cache.KeyConfig, fetch cache.FetchHandler) error {
+	tcs.mutFetchers.Lock()
+	if tcs.fetchers == nil {
+		tcs.fetchers = make(map[string]cache.FetchHandler)
+	}
+	tcs.fetchers[key] = fetch
+	tcs.mutFetchers.Unlock()
+
+	if tcs.RegisterCalled != nil {
+		return tcs.RegisterCalled(key, config, fetch)
+	}
+
+	return nil
+}
+
+// UpdateKeyConfig -
+func (tcs *TTLCacheStub) UpdateKeyConfig(key string, config cache.KeyConfig) error {
+	if tcs.UpdateKeyConfigCalled != nil {
+		return tcs.UpdateKeyConfigCalled(key, config)
+	}
+
+	return nil
+}
+
+// Get -
+func (tcs *TTLCacheStub) Get(ctx context.Context, key string) (interface{}, error) {
+	if tcs.GetCalled != nil {
+		return tcs.GetCalled(ctx, key)
+	}
+
+	tcs.mutFetchers.RLock()
+	fetch, found := tcs.fetchers[key]
+	tcs.mutFetchers.RUnlock()
+	if !found {
+		return nil, errors.New("key not registered")
+	}
+
+	return fetch(ctx)
+}
+
+// Load -
+func (tcs *TTLCacheStub) Load(key string) (interface{}, bool) {
+	if tcs.LoadCalled != nil {
+		return tcs.LoadCalled(key)
+	}
+
+	return nil, false
+}
+
+// IsInterfaceNil -
+func (tcs *TTLCacheStub) IsInterfaceNil() bool {
+	return tcs == nil
+}
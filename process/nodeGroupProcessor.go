@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"net/http"
 	"sort"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
@@ -21,16 +23,14 @@ const systemAccountAddress = "erd1llllllllllllllllllllllllllllllllllllllllllllll
 
 // NodeGroupProcessor is able to process transaction requests
 type NodeGroupProcessor struct {
-	proc                  Processor
-	cacher                HeartbeatCacheHandler
-	cacheValidityDuration time.Duration
-	cancelFunc            func()
+	proc   Processor
+	cacher TTLCacheHandler
 }
 
 // NewNodeGroupProcessor creates a new instance of NodeGroupProcessor
 func NewNodeGroupProcessor(
 	proc Processor,
-	cacher HeartbeatCacheHandler,
+	cacher TTLCacheHandler,
 	cacheValidityDuration time.Duration,
 ) (*NodeGroupProcessor, error) {
 	if check.IfNil(proc) {
@@ -43,16 +43,34 @@ func NewNodeGroupProcessor(
 		return nil, ErrInvalidCacheValidityDuration
 	}
 	hbp := &NodeGroupProcessor{
-		proc:                  proc,
-		cacher:                cacher,
-		cacheValidityDuration: cacheValidityDuration,
+		proc:   proc,
+		cacher: cacher,
+	}
+
+	err := cacher.Register(heartbeatsCacheKey, refreshedKeyConfig(cacheValidityDuration, newHeartbeatResponse), hbp.fetchHeartbeats)
+	if err != nil {
+		return nil, err
 	}
 
 	return hbp, nil
 }
 
+// PrepareReload checks the heartbeats cache validity duration from the provided configs and returns the function
+// which applies it
+func (hbp *NodeGroupProcessor) PrepareReload(configs *config.ReloadableConfigs) (func(), error) {
+	cacheValidityDuration := time.Duration(configs.GeneralConfig.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
+	if cacheValidityDuration <= 0 {
+		return nil, fmt.Errorf("%w for heartbeats", ErrInvalidCacheValidityDuration)
+	}
+
+	return func() {
+		err := hbp.cacher.UpdateKeyConfig(heartbeatsCacheKey, refreshedKeyConfig(cacheValidityDuration, newHeartbeatResponse))
+		log.LogIfError(err)
+	}, nil
+}
+
 // IsOldStorageForToken returns true if the token is stored in the old fashion
-func (hbp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (hbp *NodeGroupProcessor) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
 	observers, err := hbp.proc.GetAllObservers()
 	if err != nil {
 		return false, err
@@ -67,7 +85,7 @@ func (hbp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64
 
 		apiResponse := data.AccountKeyValueResponse{}
 		apiPath := AddressPath + systemAccountAddress + "/key/" + tokenStorageKey
-		respCode, err := hbp.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := hbp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account value for key request",
 				"address", systemAccountAddress,
@@ -103,19 +121,32 @@ func computeTokenStorageKey(tokenID string, nonce uint64) string {
 	return hex.EncodeToString(key)
 }
 
-// GetHeartbeatData will simply forward the heartbeat status from an observer
-func (hbp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	heartbeatsToReturn, err := hbp.cacher.LoadHeartbeats()
-	if err == nil {
-		return heartbeatsToReturn, nil
+// GetHeartbeatData will return the heartbeat status of the nodes, as cached from the observers
+func (hbp *NodeGroupProcessor) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	value, err := hbp.cacher.Get(ctx, heartbeatsCacheKey)
+	if err != nil {
+		return nil, err
 	}
 
-	log.Info("heartbeat: cannot get from cache. Will fetch from API", "error", err.Error())
+	heartbeats, ok := value.(*data.HeartbeatResponse)
+	if !ok {
+		return nil, ErrInvalidCachedValue
+	}
 
-	return hbp.getHeartbeatsFromApi()
+	return heartbeats, nil
 }
 
-func (hbp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
+func (hbp *NodeGroupProcessor) fetchHeartbeats(ctx context.Context) (interface{}, error) {
+	heartbeats, err := hbp.getHeartbeatsFromApi(ctx)
+	if err != nil {
+		log.Warn("heartbeat: get from API", "error", err.Error())
+		return nil, err
+	}
+
+	return heartbeats, nil
+}
+
+func (hbp *NodeGroupProcessor) getHeartbeatsFromApi(ctx context.Context) (*data.HeartbeatResponse, error) {
 	shardIDs := hbp.proc.GetShardIDs()
 
 	responseMap := make(map[string]data.PubKeyHeartbeat)
@@ -129,7 +160,7 @@ func (hbp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse,
 		errorsCount := 0
 		var response data.HeartbeatApiResponse
 		for _, observer := range observers {
-			_, err = hbp.proc.CallGetRestEndPoint(observer.Address, HeartBeatPath, &response)
+			_, err = hbp.proc.CallGetRestEndPoint(ctx, observer.Address, HeartBeatPath, &response)
 			heartbeats := response.Data.Heartbeats
 			if err == nil && len(heartbeats) > 0 {
 				hbp.addMessagesToMap(responseMap, heartbeats, shard)
@@ -194,55 +225,7 @@ func (hbp *NodeGroupProcessor) mapToResponse(responseMap map[string]data.PubKeyH
 	}
 }
 
-// StartCacheUpdate will start the updating of the cache from the API at a given period
-func (hbp *NodeGroupProcessor) StartCacheUpdate() {
-	if hbp.cancelFunc != nil {
-		log.Error("NodeGroupProcessor - cache update already started")
-		return
-	}
-
-	var ctx context.Context
-	ctx, hbp.cancelFunc = context.WithCancel(context.Background())
-
-	go func(ctx context.Context) {
-		timer := time.NewTimer(hbp.cacheValidityDuration)
-		defer timer.Stop()
-
-		hbp.handleHeartbeatCacheUpdate()
-
-		for {
-			timer.Reset(hbp.cacheValidityDuration)
-
-			select {
-			case <-timer.C:
-				hbp.handleHeartbeatCacheUpdate()
-			case <-ctx.Done():
-				log.Debug("finishing NodeGroupProcessor cache update...")
-				return
-			}
-		}
-	}(ctx)
-}
-
-func (hbp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
-	hbts, err := hbp.getHeartbeatsFromApi()
-	if err != nil {
-		log.Warn("heartbeat: get from API", "error", err.Error())
-	}
-
-	if hbts != nil {
-		err = hbp.cacher.StoreHeartbeats(hbts)
-		if err != nil {
-			log.Warn("heartbeat: store in cache", "error", err.Error())
-		}
-	}
-}
-
-// Close will handle the closing of the cache update go routine
-func (hbp *NodeGroupProcessor) Close() error {
-	if hbp.cancelFunc != nil {
-		hbp.cancelFunc()
-	}
-
-	return nil
+// IsInterfaceNil returns true if there is no value under the interface
+func (hbp *NodeGroupProcessor) IsInterfaceNil() bool {
+	return hbp == nil
 }
@@ -1,16 +1,17 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
-	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,7 +20,7 @@ import (
 func TestNewNodeGroupProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(nil, &mock.HeartbeatCacherMock{}, time.Second)
+	hp, err := process.NewNodeGroupProcessor(nil, &mock.TTLCacheStub{}, time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -37,7 +38,7 @@ func TestNewNodeGroupProcessor_NilCacherShouldErr(t *testing.T) {
 func TestNewNodeGroupProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, -time.Second)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, -time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
@@ -46,7 +47,7 @@ func TestNewNodeGroupProcessor_InvalidCacheValidityDurationShouldErr(t *testing.
 func TestNewNodeGroupProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
+	hbp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, time.Second)
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -55,10 +56,10 @@ func TestNewNodeGroupProcessor_WithOkProcessorShouldErr(t *testing.T) {
 func TestNodeGroupProcessor_GetHeartbeatDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, time.Second)
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -145,13 +146,13 @@ func TestNodeGroupProcessor_GetHeartbeatDataOkValuesShouldPass(t *testing.T) {
 			return 0, nil
 		},
 	},
-		&mock.HeartbeatCacherMock{},
+		&mock.TTLCacheStub{},
 		time.Second,
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 
@@ -205,7 +206,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 
 	httpWasCalled := false
 	// set nil hbts response in cache
-	cacher := &mock.HeartbeatCacherMock{Data: nil}
+	cacher := &mock.TTLCacheStub{}
 	hp, err := process.NewNodeGroupProcessor(
 		&mock.ProcessorStub{
 			GetShardIDsCalled: func() []uint32 {
@@ -233,7 +234,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetHeartbeatData()
+	_, err = hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -320,7 +321,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 
 	httpWasCalled := false
 	// set nil hbts response in cache
-	cacher := &mock.HeartbeatCacherMock{Data: nil}
+	cacher := &mock.TTLCacheStub{}
 	counter := 0
 	hp, err := process.NewNodeGroupProcessor(
 		&mock.ProcessorStub{
@@ -366,7 +367,7 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 	)
 	assert.Nil(t, err)
 
-	heartbeats, err := hp.GetHeartbeatData()
+	heartbeats, err := hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 	assert.Equal(t, expectedHeartbeats, heartbeats)
@@ -385,62 +386,39 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromCacher(t *testin
 			},
 		},
 	}
-	cacher := &mock.HeartbeatCacherMock{Data: &hbtsResp}
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	cacher := &mock.TTLCacheStub{
+		GetCalled: func(_ context.Context, key string) (interface{}, error) {
+			return &hbtsResp, nil
+		},
+	}
+	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}, cacher, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, *res, hbtsResp)
 }
-
-func TestNodeGroupProcessor_CacheShouldUpdate(t *testing.T) {
+func TestNodeGroupProcessor_ShouldRegisterRefreshedCacheKey(t *testing.T) {
 	t.Parallel()
 
-	providedShardID := uint32(0)
-	providedAddress := "addr"
-	numOfTimesHttpWasCalled := int32(0)
-	cacher := &mock.HeartbeatCacherMock{}
-	hp, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{
-		GetShardIDsCalled: func() []uint32 {
-			return []uint32{providedShardID}
-		},
-		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			assert.Equal(t, providedShardID, shardId)
-			var obs []*data.NodeData
-			obs = append(obs, &data.NodeData{
-				ShardId: providedShardID,
-				Address: providedAddress,
-			})
-			return obs, nil
+	var registeredConfig cache.KeyConfig
+	cacher := &mock.TTLCacheStub{
+		RegisterCalled: func(key string, config cache.KeyConfig, fetch cache.FetchHandler) error {
+			registeredConfig = config
+			return nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
-			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
-			return 0, nil
-		},
-	},
-		cacher,
-		25*time.Millisecond)
-
+	}
+	_, err := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, cacher, 25*time.Millisecond)
 	assert.Nil(t, err)
-	hp.StartCacheUpdate()
-
-	// cache will become invalid after 25 ms so check if it renews its data
-
-	// >25 => update
-	time.Sleep(30 * time.Millisecond)
-	assert.Equal(t, int32(2), atomic.LoadInt32(&numOfTimesHttpWasCalled))
-
-	// > 25 => update
-	time.Sleep(30 * time.Millisecond)
-	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
-
-	// < 25 => don't update
-	time.Sleep(5 * time.Millisecond)
-	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+	assert.Equal(t, 25*time.Millisecond, registeredConfig.TTL)
+	assert.Equal(t, 25*time.Millisecond, registeredConfig.RefreshInterval)
 }
-
 func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 	t.Parallel()
 
@@ -464,7 +442,7 @@ func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	// set nil hbts response in cache
-	cacher := &mock.HeartbeatCacherMock{Data: nil}
+	cacher := &mock.TTLCacheStub{}
 	hp, err := process.NewNodeGroupProcessor(
 		&mock.ProcessorStub{
 			GetShardIDsCalled: func() []uint32 {
@@ -511,7 +489,7 @@ func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 	)
 	assert.Nil(t, err)
 
-	messages, err := hp.GetHeartbeatData()
+	messages, err := hp.GetHeartbeatData(context.Background())
 	assert.Equal(t, process.ErrHeartbeatNotAvailable, err)
 	assert.Nil(t, messages)
 }
@@ -534,11 +512,11 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 					return 0, errors.New("error")
 				},
 			},
-			&mock.HeartbeatCacherMock{},
+			&mock.TTLCacheStub{},
 			10,
 		)
 
-		_, err := proc.IsOldStorageForToken("token", 37)
+		_, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.Equal(t, process.ErrSendingRequest, err)
 	})
 
@@ -560,11 +538,11 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 					return 0, errors.New("error")
 				},
 			},
-			&mock.HeartbeatCacherMock{},
+			&mock.TTLCacheStub{},
 			10,
 		)
 
-		_, err := proc.IsOldStorageForToken("token", 37)
+		_, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.Equal(t, process.ErrSendingRequest, err)
 	})
 
@@ -585,11 +563,11 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 					return 0, nil
 				},
 			},
-			&mock.HeartbeatCacherMock{},
+			&mock.TTLCacheStub{},
 			10,
 		)
 
-		isOldStorage, err := proc.IsOldStorageForToken("token", 37)
+		isOldStorage, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.False(t, isOldStorage)
 		require.NoError(t, err)
 	})
@@ -611,11 +589,11 @@ func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 					return 0, nil
 				},
 			},
-			&mock.HeartbeatCacherMock{},
+			&mock.TTLCacheStub{},
 			10,
 		)
 
-		isOldStorage, err := proc.IsOldStorageForToken("token", 37)
+		isOldStorage, err := proc.IsOldStorageForToken(context.Background(), "token", 37)
 		require.True(t, isOldStorage)
 		require.NoError(t, err)
 	})
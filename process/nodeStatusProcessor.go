@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -11,7 +12,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 )
 
 const (
@@ -51,37 +54,97 @@ const (
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
 type NodeStatusProcessor struct {
-	proc                  Processor
-	economicMetricsCacher GenericApiResponseCacheHandler
-	cacheValidityDuration time.Duration
-	cancelFunc            func()
+	proc   Processor
+	cacher TTLCacheHandler
 }
 
-// NewNodeStatusProcessor creates a new instance of NodeStatusProcessor
+// NewNodeStatusProcessor creates a new instance of NodeStatusProcessor. The economics metrics are kept warm in cache,
+// while the network configs are cached once requested
 func NewNodeStatusProcessor(
 	processor Processor,
-	economicMetricsCacher GenericApiResponseCacheHandler,
+	cacher TTLCacheHandler,
 	cacheValidityDuration time.Duration,
+	configsCacheValidityDuration time.Duration,
 ) (*NodeStatusProcessor, error) {
 	if check.IfNil(processor) {
 		return nil, ErrNilCoreProcessor
 	}
-	if check.IfNil(economicMetricsCacher) {
+	if check.IfNil(cacher) {
 		return nil, ErrNilEconomicMetricsCacher
 	}
-	if cacheValidityDuration <= 0 {
+	if cacheValidityDuration <= 0 || configsCacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
 
-	return &NodeStatusProcessor{
-		proc:                  processor,
-		economicMetricsCacher: economicMetricsCacher,
-		cacheValidityDuration: cacheValidityDuration,
+	nsp := &NodeStatusProcessor{
+		proc:   processor,
+		cacher: cacher,
+	}
+
+	err := nsp.registerCacheKeys(cacheValidityDuration, configsCacheValidityDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return nsp, nil
+}
+
+func (nsp *NodeStatusProcessor) registerCacheKeys(cacheValidityDuration time.Duration, configsCacheValidityDuration time.Duration) error {
+	err := nsp.cacher.Register(economicsMetricsCacheKey, refreshedKeyConfig(cacheValidityDuration, newGenericApiResponse), nsp.fetchEconomicsDataMetrics)
+	if err != nil {
+		return err
+	}
+
+	configsFetchers := map[string]func(ctx context.Context) (*data.GenericAPIResponse, error){
+		networkConfigCacheKey: nsp.getNetworkConfigMetricsFromApi,
+		enableEpochsCacheKey:  nsp.getEnableEpochsMetricsFromApi,
+		ratingsConfigCacheKey: nsp.getRatingsConfigFromApi,
+		gasConfigsCacheKey:    nsp.getGasConfigsFromApi,
+	}
+	for key, fetcher := range configsFetchers {
+		err = nsp.cacher.Register(key, onDemandKeyConfig(configsCacheValidityDuration, newGenericApiResponse), toFetchHandler(fetcher))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareReload checks the economics metrics and network configs cache validity durations from the provided configs
+// and returns the function which applies them
+func (nsp *NodeStatusProcessor) PrepareReload(configs *config.ReloadableConfigs) (func(), error) {
+	generalSettings := configs.GeneralConfig.GeneralSettings
+	cacheValidityDuration := time.Duration(generalSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+	configsCacheValidityDuration := time.Duration(generalSettings.ConfigsCacheValidityDurationSec) * time.Second
+	if cacheValidityDuration <= 0 || configsCacheValidityDuration <= 0 {
+		return nil, fmt.Errorf("%w for economics metrics or network configs", ErrInvalidCacheValidityDuration)
+	}
+
+	return func() {
+		err := nsp.cacher.UpdateKeyConfig(economicsMetricsCacheKey, refreshedKeyConfig(cacheValidityDuration, newGenericApiResponse))
+		log.LogIfError(err)
+
+		for _, key := range []string{networkConfigCacheKey, enableEpochsCacheKey, ratingsConfigCacheKey, gasConfigsCacheKey} {
+			err = nsp.cacher.UpdateKeyConfig(key, onDemandKeyConfig(configsCacheValidityDuration, newGenericApiResponse))
+			log.LogIfError(err)
+		}
 	}, nil
 }
 
+func toFetchHandler(fetcher func(ctx context.Context) (*data.GenericAPIResponse, error)) cache.FetchHandler {
+	return func(ctx context.Context) (interface{}, error) {
+		response, err := fetcher(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return response, nil
+	}
+}
+
 // GetNetworkStatusMetrics will simply forward the network status metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID)
 	if err != nil {
 		return nil, err
@@ -90,7 +153,7 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -104,8 +167,12 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	return nil, ErrSendingRequest
 }
 
-// GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+// GetNetworkConfigMetrics will return the network config metrics, as cached from the observers
+func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return getGenericApiResponseFromCache(ctx, nsp.cacher, networkConfigCacheKey)
+}
+
+func (nsp *NodeStatusProcessor) getNetworkConfigMetricsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers()
 	if err != nil {
 		return nil, err
@@ -114,7 +181,7 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkConfigPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -128,8 +195,12 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	return nil, ErrSendingRequest
 }
 
-// GetEnableEpochsMetrics will simply forward the activation epochs config metrics from an observer
-func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+// GetEnableEpochsMetrics will return the activation epochs config metrics, as cached from the observers
+func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return getGenericApiResponseFromCache(ctx, nsp.cacher, enableEpochsCacheKey)
+}
+
+func (nsp *NodeStatusProcessor) getEnableEpochsMetricsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers()
 	if err != nil {
 		return nil, err
@@ -138,7 +209,7 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 	for _, observer := range observers {
 		var responseEnableEpochsMetrics *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
 		if err != nil {
 			log.Error("enable epochs metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -152,7 +223,7 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 }
 
 // GetAllIssuedESDTs will forward the issued ESDTs based on the provided type
-func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if !data.IsValidEsdtPath(tokenType) && tokenType != "" {
 		return nil, ErrInvalidTokenType
 	}
@@ -169,7 +240,7 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 		if tokenType != "" {
 			path = fmt.Sprintf("%s/%s", NetworkEsdtTokensPrefix, tokenType)
 		}
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseAllIssuedESDTs)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseAllIssuedESDTs)
 		if err != nil {
 			log.Error("all issued esdts request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -184,7 +255,7 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 }
 
 // GetDelegatedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -193,7 +264,7 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 	for _, observer := range observers {
 		var delegatedInfoResponse *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
 		if err != nil {
 			log.Error("network delegated info request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -208,7 +279,7 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 }
 
 // GetDirectStakedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -217,7 +288,7 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 	for _, observer := range observers {
 		var directStakedResponse *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DirectStakedPath, &directStakedResponse)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, DirectStakedPath, &directStakedResponse)
 		if err != nil {
 			log.Error("network direct staked request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -231,8 +302,12 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 	return nil, ErrSendingRequest
 }
 
-// GetRatingsConfig will simply forward the ratings configuration from an observer
-func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+// GetRatingsConfig will return the ratings configuration, as cached from the observers
+func (nsp *NodeStatusProcessor) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return getGenericApiResponseFromCache(ctx, nsp.cacher, ratingsConfigCacheKey)
+}
+
+func (nsp *NodeStatusProcessor) getRatingsConfigFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers()
 	if err != nil {
 		return nil, err
@@ -241,7 +316,7 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	for _, observer := range observers {
 		var responseRatingsConfig *data.GenericAPIResponse
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, RatingsConfigPath, &responseRatingsConfig)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, RatingsConfigPath, &responseRatingsConfig)
 		if err != nil {
 			log.Error("ratings metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -255,7 +330,7 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	return nil, ErrSendingRequest
 }
 
-func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getNodeStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID)
 	if err != nil {
 		return nil, err
@@ -264,7 +339,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NodeStatusPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("node status metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -279,7 +354,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 }
 
 // GetLatestFullySynchronizedHyperblockNonce will compute nonce of the latest hyperblock that can be returned
-func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
 	shardsIDs, err := nsp.getShardsIDs()
 	if err != nil {
 		return 0, err
@@ -287,7 +362,7 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 
 	nonces := make([]uint64, 0)
 	for shardID := range shardsIDs {
-		nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+		nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 		if err != nil {
 			return 0, err
 		}
@@ -361,6 +436,15 @@ func getNonceFromMetachainStatus(nodeStatusData interface{}) (uint64, bool) {
 	return getUint(metric), true
 }
 
+func getHighestFinalNonceFromStatus(nodeStatusData interface{}) (uint64, bool) {
+	metric, ok := getMetric(nodeStatusData, common.MetricHighestFinalBlock)
+	if !ok {
+		return 0, false
+	}
+
+	return getUint(metric), true
+}
+
 func getMetric(nodeStatusData interface{}, metric string) (interface{}, bool) {
 	metricsMapI, ok := nodeStatusData.(map[string]interface{})
 	if !ok {
@@ -416,7 +500,7 @@ func getUint(value interface{}) uint64 {
 }
 
 // GetGenesisNodesPubKeys will return genesis nodes public keys
-func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers()
 	if err != nil {
 		return nil, err
@@ -425,7 +509,7 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 	for _, observer := range observers {
 		var responseGenesisNodesConfig *data.GenericAPIResponse
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, GenesisNodesConfigPath, &responseGenesisNodesConfig)
+		_, err = nsp.proc.CallGetRestEndPoint(ctx, observer.Address, GenesisNodesConfigPath, &responseGenesisNodesConfig)
 		if err != nil {
 			log.Error("genesis nodes request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -439,8 +523,12 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 	return nil, ErrSendingRequest
 }
 
-// GetGasConfigs will return gas configs
-func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error) {
+// GetGasConfigs will return gas configs, as cached from the observers
+func (nsp *NodeStatusProcessor) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return getGenericApiResponseFromCache(ctx, nsp.cacher, gasConfigsCacheKey)
+}
+
+func (nsp *NodeStatusProcessor) getGasConfigsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers()
 	if err != nil {
 		return nil, err
@@ -449,7 +537,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 	for _, observer := range observers {
 		var responseGenesisNodesConfig *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
 		if err != nil {
 			log.Error("gas configs request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -464,7 +552,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 }
 
 // GetEpochStartData will return the epoch-start data for the given epoch and shard
-func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID)
 	if err != nil {
 		return nil, err
@@ -474,7 +562,7 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 	for _, observer := range observers {
 		var responseEpochStartData *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseEpochStartData)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, path, &responseEpochStartData)
 		if err != nil {
 			log.Error("epoch start data request", "observer", observer.Address, "shard ID", observer.ShardId, "error", err)
 			continue
@@ -486,3 +574,8 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 
 	return nil, ErrSendingRequest
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsp *NodeStatusProcessor) IsInterfaceNil() bool {
+	return nsp == nil
+}
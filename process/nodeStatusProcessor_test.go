@@ -1,13 +1,16 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +18,7 @@ import (
 func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.TTLCacheStub{}, time.Second, time.Second)
 
 	require.Equal(t, ErrNilCoreProcessor, err)
 	require.Nil(t, nodeStatusProc)
@@ -24,7 +27,7 @@ func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second, time.Second)
 
 	require.Equal(t, ErrNilEconomicMetricsCacher, err)
 	require.Nil(t, nodeStatusProc)
@@ -33,12 +36,78 @@ func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 func TestNewNodeStatusProcessor_InvalidCacheValidityDuration(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, -1*time.Second, time.Second)
 
 	require.Equal(t, ErrInvalidCacheValidityDuration, err)
 	require.Nil(t, nodeStatusProc)
 }
 
+func TestNodeStatusProcessor_ConfigsShouldBeCachedOnDemand(t *testing.T) {
+	t.Parallel()
+
+	registeredConfigs := make(map[string]cache.KeyConfig)
+	requestedKeys := make([]string, 0)
+	cacher := &mock.TTLCacheStub{
+		RegisterCalled: func(key string, config cache.KeyConfig, fetch cache.FetchHandler) error {
+			registeredConfigs[key] = config
+			return nil
+		},
+		GetCalled: func(_ context.Context, key string) (interface{}, error) {
+			requestedKeys = append(requestedKeys, key)
+			return &data.GenericAPIResponse{Data: key}, nil
+		},
+	}
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Second, time.Minute)
+	require.Nil(t, err)
+
+	expectedKeys := []string{networkConfigCacheKey, enableEpochsCacheKey, ratingsConfigCacheKey, gasConfigsCacheKey}
+	expectedConfig := onDemandKeyConfig(time.Minute, newGenericApiResponse)
+	for _, key := range expectedKeys {
+		registeredConfig := registeredConfigs[key]
+		require.Equal(t, expectedConfig.TTL, registeredConfig.TTL)
+		require.Equal(t, expectedConfig.StaleDuration, registeredConfig.StaleDuration)
+		require.Equal(t, expectedConfig.RefreshInterval, registeredConfig.RefreshInterval)
+		require.IsType(t, &data.GenericAPIResponse{}, registeredConfig.NewValue())
+	}
+
+	networkConfig, _ := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
+	require.Equal(t, networkConfigCacheKey, networkConfig.Data)
+	_, _ = nodeStatusProc.GetEnableEpochsMetrics(context.Background())
+	_, _ = nodeStatusProc.GetRatingsConfig(context.Background())
+	_, _ = nodeStatusProc.GetGasConfigs(context.Background())
+	require.Equal(t, expectedKeys, requestedKeys)
+}
+
+func TestNodeStatusProcessor_PrepareReload(t *testing.T) {
+	t.Parallel()
+
+	updatedConfigs := make(map[string]cache.KeyConfig)
+	cacher := &mock.TTLCacheStub{
+		UpdateKeyConfigCalled: func(key string, config cache.KeyConfig) error {
+			updatedConfigs[key] = config
+			return nil
+		},
+	}
+	nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Second, time.Second)
+
+	configs := &config.ReloadableConfigs{GeneralConfig: &config.Config{}}
+	configs.GeneralConfig.GeneralSettings.EconomicsMetricsCacheValidityDurationSec = 6
+	apply, err := nodeStatusProc.PrepareReload(configs)
+	require.True(t, errors.Is(err, ErrInvalidCacheValidityDuration))
+	require.Nil(t, apply)
+
+	configs.GeneralConfig.GeneralSettings.ConfigsCacheValidityDurationSec = 60
+	apply, err = nodeStatusProc.PrepareReload(configs)
+	require.Nil(t, err)
+	require.Empty(t, updatedConfigs)
+
+	apply()
+	require.Equal(t, 5, len(updatedConfigs))
+	require.Equal(t, 6*time.Second, updatedConfigs[economicsMetricsCacheKey].RefreshInterval)
+	require.Equal(t, time.Minute, updatedConfigs[networkConfigCacheKey].TTL)
+	require.Equal(t, time.Duration(0), updatedConfigs[networkConfigCacheKey].RefreshInterval)
+}
+
 func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T) {
 	t.Parallel()
 
@@ -53,11 +122,12 @@ func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T)
 			return 0, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetNetworkConfigMetrics()
+	status, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -81,11 +151,12 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics()
+	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -107,11 +178,12 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *tes
 			return nil, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -130,11 +202,12 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetRestEndPointError(t *testing.T)
 			return 0, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -158,11 +231,12 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -219,11 +293,12 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, uint64(122), nonce)
 }
@@ -237,11 +312,12 @@ func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *test
 			return nil, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -260,11 +336,12 @@ func TestNodeStatusProcessor_GetAllIssuedESDTsGetRestEndPointError(t *testing.T)
 			return 0, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -286,11 +363,12 @@ func TestNodeStatusProcessor_GetAllIssuedESDTs(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs("")
+	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -323,11 +401,12 @@ func TestNodeStatusProcessor_ApiPathIsCorrect(t *testing.T) {
 			return 0, nil
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	_, err := nodeStatusProc.GetAllIssuedESDTs(data.SemiFungibleTokens)
+	_, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), data.SemiFungibleTokens)
 	require.Nil(t, err)
 }
 
@@ -340,11 +419,12 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetObserversFailedShouldErr(t *test
 			return nil, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -363,11 +443,12 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetRestEndPointError(t *testing.T)
 			return 0, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -388,11 +469,12 @@ func TestNodeStatusProcessor_GetDelegatedInfo(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetDelegatedInfo()
+	actualResponse, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -406,11 +488,12 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetObserversFailedShouldErr(t *t
 			return nil, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -429,11 +512,12 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetRestEndPointError(t *testing.
 			return 0, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -454,11 +538,12 @@ func TestNodeStatusProcessor_GetDirectStakedInfo(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetDirectStakedInfo()
+	actualResponse, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -477,11 +562,12 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetEndpointErr(t *testing.T)
 			return 0, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodesStatusProc.GetEnableEpochsMetrics()
+	status, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -507,11 +593,12 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldWork(t *testing.T) {
 			return 0, json.Unmarshal(genericRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics()
+	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -532,11 +619,12 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetObserversShouldErr(t *test
 			return nil, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetEnableEpochsMetrics()
+	status, err := nodeStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -550,11 +638,12 @@ func TestNodeStatusProcessor_GetRatingsConfigGetAllObserversShouldFail(t *testin
 			return nil, localErr
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetRatingsConfig()
+	status, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -575,11 +664,12 @@ func TestNodeStatusProcessor_GetRatingsConfig(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetRatingsConfig()
+	actualResponse, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -600,11 +690,12 @@ func TestNodeStatusProcessor_GetGenesisNodesPubKeys(t *testing.T) {
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
 	},
-		&mock.GenericApiResponseCacherMock{},
+		&mock.TTLCacheStub{},
+		time.Nanosecond,
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys()
+	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -625,11 +716,12 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 				return 0, errors.New("endpoint error")
 			},
 		},
-			&mock.GenericApiResponseCacherMock{},
+			&mock.TTLCacheStub{},
+			time.Nanosecond,
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, actualResponse)
 		require.Equal(t, ErrSendingRequest, err)
 	})
@@ -650,11 +742,12 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 				return 0, json.Unmarshal(genRespBytes, value)
 			},
 		},
-			&mock.GenericApiResponseCacherMock{},
+			&mock.TTLCacheStub{},
+			time.Nanosecond,
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
@@ -676,11 +769,12 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 				return 0, errors.New("endpoint error")
 			},
 		},
-			&mock.GenericApiResponseCacherMock{},
+			&mock.TTLCacheStub{},
+			time.Nanosecond,
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, actualResponse)
 		require.Equal(t, ErrSendingRequest, err)
 	})
@@ -701,11 +795,12 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 				return 0, json.Unmarshal(genRespBytes, value)
 			},
 		},
-			&mock.GenericApiResponseCacherMock{},
+			&mock.TTLCacheStub{},
+			time.Nanosecond,
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
@@ -0,0 +1,6 @@
+package nodeshealth
+
+import "errors"
+
+// ErrInvalidNumLatencySamples signals that an invalid number of latency samples has been provided
+var ErrInvalidNumLatencySamples = errors.New("invalid number of latency samples")
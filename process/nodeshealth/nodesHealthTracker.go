@@ -0,0 +1,202 @@
+package nodeshealth
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+type nodeHealth struct {
+	latencies       []time.Duration
+	nextIndex       int
+	numFilled       int
+	numRequests     uint64
+	numErrors       uint64
+	isSynced        bool
+	hasMetrics      bool
+	nonce           uint64
+	probableHighest uint64
+	lastCheck       time.Time
+	lastCheckError  string
+	numFailedChecks uint64
+}
+
+// nodesHealthTracker keeps, for each node, the outcome of the latest status check together with the recent latencies
+// and the number of failed requests, so the health of the nodes can be reported without asking them again
+type nodesHealthTracker struct {
+	numLatencySamples int
+	getTimeFunc       func() time.Time
+	mutNodes          sync.RWMutex
+	nodes             map[string]*nodeHealth
+}
+
+// NewNodesHealthTracker returns a new instance of nodesHealthTracker which computes the latency percentiles over the
+// last numLatencySamples requests sent towards each node
+func NewNodesHealthTracker(numLatencySamples int) (*nodesHealthTracker, error) {
+	if numLatencySamples < 1 {
+		return nil, ErrInvalidNumLatencySamples
+	}
+
+	return &nodesHealthTracker{
+		numLatencySamples: numLatencySamples,
+		getTimeFunc:       time.Now,
+		nodes:             make(map[string]*nodeHealth),
+	}, nil
+}
+
+// RequestStarted does nothing as only the finished requests are tracked
+func (nht *nodesHealthTracker) RequestStarted(_ string) {
+}
+
+// RequestFinished records the duration and the outcome of a request sent towards the provided node
+func (nht *nodesHealthTracker) RequestFinished(address string, duration time.Duration, withError bool) {
+	nht.mutNodes.Lock()
+	defer nht.mutNodes.Unlock()
+
+	health := nht.getOrCreateHealthUnprotected(address)
+	health.numRequests++
+	if withError {
+		health.numErrors++
+		return
+	}
+
+	health.latencies[health.nextIndex] = duration
+	health.nextIndex = (health.nextIndex + 1) % len(health.latencies)
+	if health.numFilled < len(health.latencies) {
+		health.numFilled++
+	}
+}
+
+// RecordNodesStatus records the outcome of a status check of the provided nodes. The sync state of the nodes must have
+// already been computed. The health of the nodes which are no longer among the known nodes is dropped
+func (nht *nodesHealthTracker) RecordNodesStatus(nodesWithMetrics []*data.NodeWithMetrics, knownNodes []*data.NodeData) {
+	nht.mutNodes.Lock()
+	defer nht.mutNodes.Unlock()
+
+	nht.removeUnknownNodesUnprotected(nodesWithMetrics, knownNodes)
+
+	checkTime := nht.getTimeFunc()
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		health := nht.getOrCreateHealthUnprotected(nodeWithMetrics.Node.Address)
+		health.isSynced = nodeWithMetrics.Node.IsSynced
+		health.lastCheck = checkTime
+		health.lastCheckError = ""
+
+		if nodeWithMetrics.Metrics == nil {
+			health.numFailedChecks++
+			if nodeWithMetrics.Error != nil {
+				health.lastCheckError = nodeWithMetrics.Error.Error()
+			}
+			continue
+		}
+
+		health.hasMetrics = true
+		health.nonce = nodeWithMetrics.Metrics.Nonce
+		health.probableHighest = nodeWithMetrics.Metrics.ProbableHighestNonce
+	}
+}
+
+// PopulateNodesHealth fills the provided statuses with the tracked details. The nonce lag of a node is computed
+// against the highest nonce reported by the nodes of the same shard
+func (nht *nodesHealthTracker) PopulateNodesHealth(statuses []*data.NodeHealthStatus) {
+	nht.mutNodes.RLock()
+	defer nht.mutNodes.RUnlock()
+
+	highestNonces := make(map[uint32]uint64)
+	for _, status := range statuses {
+		health, found := nht.nodes[status.Address]
+		if !found {
+			continue
+		}
+
+		status.IsSynced = health.isSynced
+		status.LastCheck = health.lastCheck
+		status.LastCheckError = health.lastCheckError
+		status.NumFailedChecks = health.numFailedChecks
+		status.NumRequests = health.numRequests
+		status.NumErrors = health.numErrors
+		status.LatencyP50Ms, status.LatencyP90Ms, status.LatencyP99Ms = computeLatencyPercentiles(health.latencies[:health.numFilled])
+		if !health.hasMetrics {
+			continue
+		}
+
+		status.Nonce = health.nonce
+		status.ProbableHighestNonce = health.probableHighest
+		if status.Nonce > highestNonces[status.ShardId] {
+			highestNonces[status.ShardId] = status.Nonce
+		}
+	}
+
+	for _, status := range statuses {
+		health, found := nht.nodes[status.Address]
+		if !found || !health.hasMetrics {
+			continue
+		}
+
+		status.NonceLag = highestNonces[status.ShardId] - status.Nonce
+	}
+}
+
+func (nht *nodesHealthTracker) removeUnknownNodesUnprotected(nodesWithMetrics []*data.NodeWithMetrics, knownNodes []*data.NodeData) {
+	addresses := make(map[string]struct{}, len(nodesWithMetrics)+len(knownNodes))
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		addresses[nodeWithMetrics.Node.Address] = struct{}{}
+	}
+	for _, node := range knownNodes {
+		addresses[node.Address] = struct{}{}
+	}
+
+	for address := range nht.nodes {
+		_, isKnown := addresses[address]
+		if !isKnown {
+			delete(nht.nodes, address)
+		}
+	}
+}
+
+func (nht *nodesHealthTracker) getOrCreateHealthUnprotected(address string) *nodeHealth {
+	health, found := nht.nodes[address]
+	if !found {
+		health = &nodeHealth{
+			latencies: make([]time.Duration, nht.numLatencySamples),
+		}
+		nht.nodes[address] = health
+	}
+
+	return health
+}
+
+// computeLatencyPercentiles returns the 50th, the 90th and the 99th percentiles of the provided latencies, in
+// milliseconds
+func computeLatencyPercentiles(latencies []time.Duration) (float64, float64, float64) {
+	if len(latencies) == 0 {
+		return 0, 0, 0
+	}
+
+	sortedLatencies := make([]time.Duration, len(latencies))
+	copy(sortedLatencies, latencies)
+	sort.Slice(sortedLatencies, func(i, j int) bool {
+		return sortedLatencies[i] < sortedLatencies[j]
+	})
+
+	return getPercentileInMs(sortedLatencies, 50), getPercentileInMs(sortedLatencies, 90), getPercentileInMs(sortedLatencies, 99)
+}
+
+func getPercentileInMs(sortedLatencies []time.Duration, percentile float64) float64 {
+	index := int(float64(len(sortedLatencies))*percentile/100+0.5) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(sortedLatencies) {
+		index = len(sortedLatencies) - 1
+	}
+
+	return float64(sortedLatencies[index]) / float64(time.Millisecond)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nht *nodesHealthTracker) IsInterfaceNil() bool {
+	return nht == nil
+}
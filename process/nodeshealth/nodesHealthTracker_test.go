@@ -0,0 +1,140 @@
+package nodeshealth_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/nodeshealth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodesHealthTracker(t *testing.T) {
+	t.Parallel()
+
+	nht, err := nodeshealth.NewNodesHealthTracker(0)
+	require.Equal(t, nodeshealth.ErrInvalidNumLatencySamples, err)
+	require.True(t, check.IfNil(nht))
+
+	nht, err = nodeshealth.NewNodesHealthTracker(10)
+	require.NoError(t, err)
+	require.False(t, check.IfNil(nht))
+}
+
+func TestNodesHealthTracker_RequestFinishedShouldComputeLatencyPercentiles(t *testing.T) {
+	t.Parallel()
+
+	nht, _ := nodeshealth.NewNodesHealthTracker(100)
+	nht.RequestStarted("address0")
+	for i := 1; i <= 200; i++ {
+		nht.RequestFinished("address0", time.Duration(i)*time.Millisecond, false)
+	}
+	nht.RequestFinished("address0", time.Hour, true)
+
+	statuses := []*data.NodeHealthStatus{{Address: "address0"}, {Address: "address1"}}
+	nht.PopulateNodesHealth(statuses)
+
+	// only the last 100 successful requests are taken into account for the latency percentiles
+	require.Equal(t, uint64(201), statuses[0].NumRequests)
+	require.Equal(t, uint64(1), statuses[0].NumErrors)
+	require.Equal(t, float64(150), statuses[0].LatencyP50Ms)
+	require.Equal(t, float64(190), statuses[0].LatencyP90Ms)
+	require.Equal(t, float64(199), statuses[0].LatencyP99Ms)
+
+	require.Equal(t, &data.NodeHealthStatus{Address: "address1"}, statuses[1])
+}
+
+func TestNodesHealthTracker_RecordNodesStatusShouldComputeNonceLagPerShard(t *testing.T) {
+	t.Parallel()
+
+	nht, _ := nodeshealth.NewNodesHealthTracker(10)
+	nht.RecordNodesStatus([]*data.NodeWithMetrics{
+		{
+			Node:    &data.NodeData{Address: "address0", ShardId: 0, IsSynced: true},
+			Metrics: &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100},
+		},
+		{
+			Node:    &data.NodeData{Address: "address1", ShardId: 0},
+			Metrics: &data.NodeStatusResponse{Nonce: 80, ProbableHighestNonce: 100},
+		},
+		{
+			Node:    &data.NodeData{Address: "address2", ShardId: 1, IsSynced: true},
+			Metrics: &data.NodeStatusResponse{Nonce: 50, ProbableHighestNonce: 50},
+		},
+		{
+			Node:  &data.NodeData{Address: "address3", ShardId: 1},
+			Error: errors.New("connection refused"),
+		},
+	}, nil)
+
+	statuses := []*data.NodeHealthStatus{
+		{Address: "address0", ShardId: 0},
+		{Address: "address1", ShardId: 0},
+		{Address: "address2", ShardId: 1},
+		{Address: "address3", ShardId: 1},
+	}
+	nht.PopulateNodesHealth(statuses)
+
+	require.True(t, statuses[0].IsSynced)
+	require.Equal(t, uint64(100), statuses[0].Nonce)
+	require.Equal(t, uint64(0), statuses[0].NonceLag)
+	require.False(t, statuses[0].LastCheck.IsZero())
+
+	require.False(t, statuses[1].IsSynced)
+	require.Equal(t, uint64(100), statuses[1].ProbableHighestNonce)
+	require.Equal(t, uint64(20), statuses[1].NonceLag)
+
+	require.Equal(t, uint64(0), statuses[2].NonceLag)
+
+	require.False(t, statuses[3].IsSynced)
+	require.Equal(t, uint64(0), statuses[3].NonceLag)
+	require.Equal(t, uint64(1), statuses[3].NumFailedChecks)
+	require.Equal(t, "connection refused", statuses[3].LastCheckError)
+
+	// a successful check clears the last error, but keeps the number of failed checks
+	nht.RecordNodesStatus([]*data.NodeWithMetrics{
+		{
+			Node:    &data.NodeData{Address: "address3", ShardId: 1, IsSynced: true},
+			Metrics: &data.NodeStatusResponse{Nonce: 50, ProbableHighestNonce: 50},
+		},
+	}, []*data.NodeData{
+		{Address: "address0", ShardId: 0},
+		{Address: "address1", ShardId: 0},
+		{Address: "address2", ShardId: 1},
+		{Address: "address3", ShardId: 1},
+	})
+	nht.PopulateNodesHealth(statuses)
+	require.Equal(t, uint64(100), statuses[0].Nonce)
+	require.True(t, statuses[3].IsSynced)
+	require.Empty(t, statuses[3].LastCheckError)
+	require.Equal(t, uint64(1), statuses[3].NumFailedChecks)
+}
+
+func TestNodesHealthTracker_RecordNodesStatusShouldDropTheUnknownNodes(t *testing.T) {
+	t.Parallel()
+
+	nht, _ := nodeshealth.NewNodesHealthTracker(10)
+	nht.RequestFinished("address0", time.Millisecond, false)
+	nht.RequestFinished("address1", time.Millisecond, false)
+	nht.RequestFinished("address2", time.Millisecond, false)
+
+	// address0 is checked, address1 is known but not checked this time, while address2 was removed
+	nht.RecordNodesStatus([]*data.NodeWithMetrics{
+		{
+			Node:    &data.NodeData{Address: "address0", ShardId: 0, IsSynced: true},
+			Metrics: &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100},
+		},
+	}, []*data.NodeData{
+		{Address: "address0", ShardId: 0},
+		{Address: "address1", ShardId: 1},
+	})
+
+	statuses := []*data.NodeHealthStatus{{Address: "address0"}, {Address: "address1"}, {Address: "address2"}}
+	nht.PopulateNodesHealth(statuses)
+
+	require.Equal(t, uint64(100), statuses[0].Nonce)
+	require.Equal(t, uint64(1), statuses[1].NumRequests)
+	require.Equal(t, &data.NodeHealthStatus{Address: "address2"}, statuses[2])
+}
@@ -0,0 +1,18 @@
+package noncemanager
+
+import "errors"
+
+// ErrNilAccountProvider signals that a nil account provider has been provided
+var ErrNilAccountProvider = errors.New("nil account provider")
+
+// ErrNilPoolNoncesProvider signals that a nil pool nonces provider has been provided
+var ErrNilPoolNoncesProvider = errors.New("nil pool nonces provider")
+
+// ErrInvalidReservationTTL signals that an invalid reservation TTL has been provided
+var ErrInvalidReservationTTL = errors.New("invalid reservation TTL")
+
+// ErrInvalidMaxReservationsPerSender signals that an invalid maximum number of reservations per sender has been provided
+var ErrInvalidMaxReservationsPerSender = errors.New("invalid maximum number of reservations per sender")
+
+// ErrInvalidMaxSenders signals that an invalid maximum number of senders has been provided
+var ErrInvalidMaxSenders = errors.New("invalid maximum number of senders")
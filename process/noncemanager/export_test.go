@@ -0,0 +1,16 @@
+package noncemanager
+
+import "time"
+
+// SetGetTimeFunc -
+func (nm *nonceManager) SetGetTimeFunc(getTimeFunc func() time.Time) {
+	nm.getTimeFunc = getTimeFunc
+}
+
+// NumSenders -
+func (nm *nonceManager) NumSenders() int {
+	nm.mutSenders.Lock()
+	defer nm.mutSenders.Unlock()
+
+	return len(nm.senders)
+}
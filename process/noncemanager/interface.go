@@ -0,0 +1,21 @@
+package noncemanager
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// AccountProvider defines what a component which fetches the accounts should be able to do
+type AccountProvider interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// PoolNoncesProvider defines what a component which fetches the senders' nonces from the transactions pool should be
+// able to do
+type PoolNoncesProvider interface {
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error)
+}
@@ -0,0 +1,267 @@
+package noncemanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/noncemanager")
+
+const nonceField = "nonce"
+
+// ArgsNonceManager holds the arguments needed for creating a new nonce manager
+type ArgsNonceManager struct {
+	AccountProvider          AccountProvider
+	PoolNoncesProvider       PoolNoncesProvider
+	ReservationTTL           time.Duration
+	MaxReservationsPerSender int
+	MaxSenders               int
+}
+
+// heldNonces holds the expiry times of the nonces reserved for a sender or used by the transactions of the sender
+// relayed by the proxy
+type heldNonces map[uint64]time.Time
+
+// nonceManager hands out non-conflicting nonces to the concurrent clients sending transactions of the same sender. The
+// next nonce is computed from the account nonce and the last nonce in the transactions pool, skipping the nonces held
+// locally. The nonces missing from the pool are handed out first, so the gaps which block the sender's transactions are
+// filled
+type nonceManager struct {
+	accountProvider    AccountProvider
+	poolNoncesProvider PoolNoncesProvider
+	reservationTTL     time.Duration
+	maxPerSender       int
+	maxSenders         int
+	getTimeFunc        func() time.Time
+
+	mutSenders      sync.Mutex
+	senders         map[string]heldNonces
+	lastCleanupTime time.Time
+}
+
+// NewNonceManager returns a new instance of nonceManager
+func NewNonceManager(args ArgsNonceManager) (*nonceManager, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nonceManager{
+		accountProvider:    args.AccountProvider,
+		poolNoncesProvider: args.PoolNoncesProvider,
+		reservationTTL:     args.ReservationTTL,
+		maxPerSender:       args.MaxReservationsPerSender,
+		maxSenders:         args.MaxSenders,
+		getTimeFunc:        time.Now,
+		senders:            make(map[string]heldNonces),
+	}, nil
+}
+
+func checkArgs(args ArgsNonceManager) error {
+	if args.AccountProvider == nil {
+		return ErrNilAccountProvider
+	}
+	if args.PoolNoncesProvider == nil {
+		return ErrNilPoolNoncesProvider
+	}
+	if args.ReservationTTL < time.Second {
+		return fmt.Errorf("%w: provided %v, minimum %v", ErrInvalidReservationTTL, args.ReservationTTL, time.Second)
+	}
+	if args.MaxReservationsPerSender <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxReservationsPerSender, args.MaxReservationsPerSender)
+	}
+	if args.MaxSenders <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxSenders, args.MaxSenders)
+	}
+
+	return nil
+}
+
+// ReserveNonce reserves the next nonce of the sender, which is not handed out again until the reservation expires
+func (nm *nonceManager) ReserveNonce(ctx context.Context, address string) (*data.NonceReservation, error) {
+	accountModel, err := nm.accountProvider.GetAccount(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	accountNonce := accountModel.Account.Nonce
+	nextNonce, nonceGaps := nm.getPoolNonces(ctx, address, accountNonce)
+
+	now := nm.getTimeFunc()
+
+	nm.mutSenders.Lock()
+	defer nm.mutSenders.Unlock()
+
+	nm.removeExpiredSenders(now)
+	held, err := nm.getOrCreateSender(address)
+	if err != nil {
+		return nil, err
+	}
+
+	held.removeStale(accountNonce, now)
+	if len(held) >= nm.maxPerSender {
+		return nil, apiErrors.ErrTooManyNonceReservations
+	}
+
+	nonce, fillsGap := held.pickNonce(accountNonce, nextNonce, nonceGaps)
+	expiryTime := now.Add(nm.reservationTTL)
+	held[nonce] = expiryTime
+
+	log.Trace("reserved nonce", "address", address, "nonce", nonce, "fills gap", fillsGap,
+		"account nonce", accountNonce, "num held", len(held))
+
+	return &data.NonceReservation{
+		Nonce:        nonce,
+		FillsGap:     fillsGap,
+		AccountNonce: accountNonce,
+		NonceGaps:    nonceGaps,
+		ExpiresAt:    expiryTime.Unix(),
+	}, nil
+}
+
+// getPoolNonces returns the nonce following the sender's transactions from the pool and the gaps between the account
+// nonce and the last nonce in the pool. When the pool holds no transaction of the sender, the next nonce is the account
+// nonce
+func (nm *nonceManager) getPoolNonces(ctx context.Context, address string, accountNonce uint64) (uint64, []data.NonceGap) {
+	poolLastNonce, err := nm.poolNoncesProvider.GetLastPoolNonceForSender(ctx, address)
+	if err != nil {
+		log.Trace("cannot get the last pool nonce", "address", address, "error", err)
+		return accountNonce, nil
+	}
+	if poolLastNonce < accountNonce {
+		return accountNonce, nil
+	}
+	if poolLastNonce == accountNonce {
+		return nm.getNonceAfterAccountNonce(ctx, address, accountNonce), nil
+	}
+
+	nonceGaps, err := nm.poolNoncesProvider.GetTransactionsPoolNonceGapsForSender(ctx, address)
+	if err != nil {
+		log.Debug("cannot get the pool nonce gaps", "address", address, "error", err)
+		return poolLastNonce + 1, nil
+	}
+
+	return poolLastNonce + 1, nonceGaps.Gaps
+}
+
+// getNonceAfterAccountNonce returns the nonce following the account nonce if the pool holds a transaction of the sender,
+// as the last pool nonce equals the account nonce, or the account nonce otherwise. An empty pool can report a last nonce
+// equal to the account nonce, for example 0 for a new account, and handing out the following nonce would open a gap
+func (nm *nonceManager) getNonceAfterAccountNonce(ctx context.Context, address string, accountNonce uint64) uint64 {
+	txPool, err := nm.poolNoncesProvider.GetTransactionsPoolForSender(ctx, address, nonceField)
+	if err != nil {
+		// handing out the account nonce again can only make the transaction collide with the pooled one, while the
+		// following nonce would block the sender's transactions if the pool is empty
+		log.Debug("cannot get the pool transactions", "address", address, "error", err)
+		return accountNonce
+	}
+	if len(txPool.Transactions) == 0 {
+		return accountNonce
+	}
+
+	return accountNonce + 1
+}
+
+// RecordSentNonce holds the nonce of a transaction relayed by the proxy, so it is not handed out again while the
+// transaction propagates to the pools of the other observers. Only the nonces of the senders which reserved nonces are
+// held, so the senders which never reserve do not take the slots of the ones which do
+func (nm *nonceManager) RecordSentNonce(address string, nonce uint64) {
+	now := nm.getTimeFunc()
+
+	nm.mutSenders.Lock()
+	defer nm.mutSenders.Unlock()
+
+	nm.removeExpiredSenders(now)
+	held, found := nm.senders[address]
+	if !found {
+		return
+	}
+
+	held[nonce] = now.Add(nm.reservationTTL)
+}
+
+func (nm *nonceManager) getOrCreateSender(address string) (heldNonces, error) {
+	held, found := nm.senders[address]
+	if found {
+		return held, nil
+	}
+	if len(nm.senders) >= nm.maxSenders {
+		return nil, apiErrors.ErrTooManyNonceReservationSenders
+	}
+
+	held = make(heldNonces)
+	nm.senders[address] = held
+
+	return held, nil
+}
+
+// removeExpiredSenders removes the senders whose nonces all expired, at most once per reservation TTL
+func (nm *nonceManager) removeExpiredSenders(now time.Time) {
+	if now.Sub(nm.lastCleanupTime) < nm.reservationTTL {
+		return
+	}
+
+	for address, held := range nm.senders {
+		held.removeExpired(now)
+		if len(held) == 0 {
+			delete(nm.senders, address)
+		}
+	}
+	nm.lastCleanupTime = now
+}
+
+func (held heldNonces) removeExpired(now time.Time) {
+	for nonce, expiryTime := range held {
+		if !now.Before(expiryTime) {
+			delete(held, nonce)
+		}
+	}
+}
+
+// removeStale removes the expired nonces and the ones already executed
+func (held heldNonces) removeStale(accountNonce uint64, now time.Time) {
+	for nonce, expiryTime := range held {
+		if nonce < accountNonce || !now.Before(expiryTime) {
+			delete(held, nonce)
+		}
+	}
+}
+
+// pickNonce returns the lowest nonce which is not held, looking first into the gaps of the pool and then from the next
+// nonce onwards, and whether that nonce fills a gap. As the search skips only held nonces, it is bounded by their number
+func (held heldNonces) pickNonce(accountNonce uint64, nextNonce uint64, nonceGaps []data.NonceGap) (uint64, bool) {
+	for _, gap := range nonceGaps {
+		from := gap.From
+		if from < accountNonce {
+			from = accountNonce
+		}
+
+		for nonce := from; nonce <= gap.To && nonce < nextNonce; nonce++ {
+			_, isHeld := held[nonce]
+			if !isHeld {
+				return nonce, true
+			}
+		}
+	}
+
+	nonce := nextNonce
+	for {
+		_, isHeld := held[nonce]
+		if !isHeld {
+			return nonce, false
+		}
+		nonce++
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nm *nonceManager) IsInterfaceNil() bool {
+	return nm == nil
+}
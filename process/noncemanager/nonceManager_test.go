@@ -0,0 +1,383 @@
+package noncemanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/noncemanager"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "erd1alice"
+
+func createMockArgs() noncemanager.ArgsNonceManager {
+	return noncemanager.ArgsNonceManager{
+		AccountProvider:          &mock.AccountProviderStub{},
+		PoolNoncesProvider:       &mock.PoolNoncesProviderStub{},
+		ReservationTTL:           30 * time.Second,
+		MaxReservationsPerSender: 100,
+		MaxSenders:               100,
+	}
+}
+
+func createAccountProvider(accountNonce uint64) *mock.AccountProviderStub {
+	return &mock.AccountProviderStub{
+		GetAccountCalled: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account: data.Account{Address: address, Nonce: accountNonce},
+			}, nil
+		},
+	}
+}
+
+func createPoolNoncesProvider(lastNonce uint64, gaps []data.NonceGap) *mock.PoolNoncesProviderStub {
+	return &mock.PoolNoncesProviderStub{
+		GetLastPoolNonceForSenderCalled: func(_ context.Context, _ string) (uint64, error) {
+			return lastNonce, nil
+		},
+		GetTransactionsPoolNonceGapsForSenderCalled: func(_ context.Context, _ string) (*data.TransactionsPoolNonceGaps, error) {
+			return &data.TransactionsPoolNonceGaps{Gaps: gaps}, nil
+		},
+		GetTransactionsPoolForSenderCalled: func(_ context.Context, _ string, _ string) (*data.TransactionsPoolForSender, error) {
+			return &data.TransactionsPoolForSender{
+				Transactions: []data.WrappedTransaction{
+					{TxFields: map[string]interface{}{"nonce": lastNonce}},
+				},
+			}, nil
+		},
+	}
+}
+
+func reserveNonces(t *testing.T, nm process.NonceManagerHandler, address string, numNonces int) []uint64 {
+	nonces := make([]uint64, 0, numNonces)
+	for i := 0; i < numNonces; i++ {
+		reservation, err := nm.ReserveNonce(context.Background(), address)
+		require.NoError(t, err)
+		nonces = append(nonces, reservation.Nonce)
+	}
+
+	return nonces
+}
+
+func TestNewNonceManager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = nil
+		nm, err := noncemanager.NewNonceManager(args)
+		require.Equal(t, noncemanager.ErrNilAccountProvider, err)
+		require.True(t, check.IfNil(nm))
+	})
+	t.Run("nil pool nonces provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PoolNoncesProvider = nil
+		nm, err := noncemanager.NewNonceManager(args)
+		require.Equal(t, noncemanager.ErrNilPoolNoncesProvider, err)
+		require.True(t, check.IfNil(nm))
+	})
+	t.Run("invalid reservation TTL should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ReservationTTL = time.Millisecond
+		nm, err := noncemanager.NewNonceManager(args)
+		require.True(t, errors.Is(err, noncemanager.ErrInvalidReservationTTL))
+		require.True(t, check.IfNil(nm))
+	})
+	t.Run("invalid max reservations per sender should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxReservationsPerSender = 0
+		nm, err := noncemanager.NewNonceManager(args)
+		require.True(t, errors.Is(err, noncemanager.ErrInvalidMaxReservationsPerSender))
+		require.True(t, check.IfNil(nm))
+	})
+	t.Run("invalid max senders should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxSenders = 0
+		nm, err := noncemanager.NewNonceManager(args)
+		require.True(t, errors.Is(err, noncemanager.ErrInvalidMaxSenders))
+		require.True(t, check.IfNil(nm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nm, err := noncemanager.NewNonceManager(createMockArgs())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nm))
+	})
+}
+
+func TestNonceManager_ReserveNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("account error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs()
+		args.AccountProvider = &mock.AccountProviderStub{
+			GetAccountCalled: func(_ context.Context, _ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return nil, expectedErr
+			},
+		}
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		reservation, err := nm.ReserveNonce(context.Background(), testAddress)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, reservation)
+		require.Equal(t, 0, nm.NumSenders())
+	})
+	t.Run("no transactions in pool should start from the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{5, 6, 7}, reserveNonces(t, nm, testAddress, 3))
+		require.Equal(t, []uint64{5}, reserveNonces(t, nm, "erd1bob", 1))
+	})
+	t.Run("should continue after the last nonce in pool", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		args.PoolNoncesProvider = createPoolNoncesProvider(5, nil)
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{6, 7}, reserveNonces(t, nm, testAddress, 2))
+	})
+	t.Run("empty pool reporting the account nonce should start from the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(0)
+		poolNoncesProvider := createPoolNoncesProvider(0, nil)
+		poolNoncesProvider.GetTransactionsPoolForSenderCalled = func(_ context.Context, _ string, _ string) (*data.TransactionsPoolForSender, error) {
+			return &data.TransactionsPoolForSender{}, nil
+		}
+		args.PoolNoncesProvider = poolNoncesProvider
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{0, 1, 2}, reserveNonces(t, nm, testAddress, 3))
+	})
+	t.Run("pool transactions error should start from the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		poolNoncesProvider := createPoolNoncesProvider(5, nil)
+		poolNoncesProvider.GetTransactionsPoolForSenderCalled = func(_ context.Context, _ string, _ string) (*data.TransactionsPoolForSender, error) {
+			return nil, errors.New("expected error")
+		}
+		args.PoolNoncesProvider = poolNoncesProvider
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{5}, reserveNonces(t, nm, testAddress, 1))
+	})
+	t.Run("stale last nonce in pool should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		args.PoolNoncesProvider = createPoolNoncesProvider(3, nil)
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{5}, reserveNonces(t, nm, testAddress, 1))
+	})
+	t.Run("should fill the nonce gaps first", func(t *testing.T) {
+		t.Parallel()
+
+		gaps := []data.NonceGap{{From: 6, To: 7}, {From: 9, To: 9}}
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		args.PoolNoncesProvider = createPoolNoncesProvider(10, gaps)
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		reservation, err := nm.ReserveNonce(context.Background(), testAddress)
+		require.NoError(t, err)
+		require.Equal(t, &data.NonceReservation{
+			Nonce:        6,
+			FillsGap:     true,
+			AccountNonce: 5,
+			NonceGaps:    gaps,
+			ExpiresAt:    reservation.ExpiresAt,
+		}, reservation)
+
+		require.Equal(t, []uint64{7, 9, 11, 12}, reserveNonces(t, nm, testAddress, 4))
+
+		reservation, err = nm.ReserveNonce(context.Background(), testAddress)
+		require.NoError(t, err)
+		require.False(t, reservation.FillsGap)
+	})
+	t.Run("nonce gaps error should continue after the last nonce in pool", func(t *testing.T) {
+		t.Parallel()
+
+		poolNoncesProvider := createPoolNoncesProvider(10, nil)
+		poolNoncesProvider.GetTransactionsPoolNonceGapsForSenderCalled = func(_ context.Context, _ string) (*data.TransactionsPoolNonceGaps, error) {
+			return nil, errors.New("expected error")
+		}
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		args.PoolNoncesProvider = poolNoncesProvider
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{11}, reserveNonces(t, nm, testAddress, 1))
+	})
+	t.Run("sent nonces should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{5}, reserveNonces(t, nm, testAddress, 1))
+		nm.RecordSentNonce(testAddress, 6)
+		nm.RecordSentNonce(testAddress, 8)
+		require.Equal(t, []uint64{7, 9}, reserveNonces(t, nm, testAddress, 2))
+	})
+	t.Run("sent nonces of the senders which did not reserve should not block the reservations", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		args.MaxSenders = 2
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		for i := 0; i < 100; i++ {
+			nm.RecordSentNonce(fmt.Sprintf("erd1sender%d", i), uint64(i))
+		}
+		require.Equal(t, 0, nm.NumSenders())
+
+		require.Equal(t, []uint64{5}, reserveNonces(t, nm, testAddress, 1))
+		nm.RecordSentNonce(testAddress, 6)
+		require.Equal(t, []uint64{7}, reserveNonces(t, nm, testAddress, 1))
+		require.Equal(t, 1, nm.NumSenders())
+	})
+	t.Run("executed nonces should be released", func(t *testing.T) {
+		t.Parallel()
+
+		accountNonce := uint64(5)
+		args := createMockArgs()
+		args.AccountProvider = &mock.AccountProviderStub{
+			GetAccountCalled: func(_ context.Context, _ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Nonce: accountNonce}}, nil
+			},
+		}
+		args.MaxReservationsPerSender = 2
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		require.Equal(t, []uint64{5, 6}, reserveNonces(t, nm, testAddress, 2))
+
+		accountNonce = 7
+		require.Equal(t, []uint64{7, 8}, reserveNonces(t, nm, testAddress, 2))
+	})
+	t.Run("expired reservations should be handed out again", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		nm, _ := noncemanager.NewNonceManager(args)
+		nm.SetGetTimeFunc(func() time.Time {
+			return currentTime
+		})
+
+		reservation, err := nm.ReserveNonce(context.Background(), testAddress)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), reservation.Nonce)
+		require.Equal(t, currentTime.Add(args.ReservationTTL).Unix(), reservation.ExpiresAt)
+
+		currentTime = currentTime.Add(args.ReservationTTL - time.Second)
+		require.Equal(t, []uint64{6}, reserveNonces(t, nm, testAddress, 1))
+
+		currentTime = currentTime.Add(time.Second)
+		require.Equal(t, []uint64{5, 7}, reserveNonces(t, nm, testAddress, 2))
+	})
+	t.Run("too many reservations for the sender should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxReservationsPerSender = 2
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		reserveNonces(t, nm, testAddress, 2)
+		reservation, err := nm.ReserveNonce(context.Background(), testAddress)
+		require.Equal(t, apiErrors.ErrTooManyNonceReservations, err)
+		require.Nil(t, reservation)
+	})
+	t.Run("too many senders should err until the reservations expire", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		args := createMockArgs()
+		args.MaxSenders = 1
+		nm, _ := noncemanager.NewNonceManager(args)
+		nm.SetGetTimeFunc(func() time.Time {
+			return currentTime
+		})
+
+		reserveNonces(t, nm, testAddress, 1)
+		reservation, err := nm.ReserveNonce(context.Background(), "erd1bob")
+		require.Equal(t, apiErrors.ErrTooManyNonceReservationSenders, err)
+		require.Nil(t, reservation)
+
+		nm.RecordSentNonce("erd1bob", 0)
+		require.Equal(t, 1, nm.NumSenders())
+
+		currentTime = currentTime.Add(args.ReservationTTL)
+		require.Equal(t, []uint64{0}, reserveNonces(t, nm, "erd1bob", 1))
+		require.Equal(t, 1, nm.NumSenders())
+	})
+	t.Run("concurrent reservations should get different nonces", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AccountProvider = createAccountProvider(5)
+		args.PoolNoncesProvider = createPoolNoncesProvider(10, []data.NonceGap{{From: 7, To: 8}})
+		nm, _ := noncemanager.NewNonceManager(args)
+
+		numReservations := 50
+		nonces := make(chan uint64, numReservations)
+		wg := sync.WaitGroup{}
+		wg.Add(numReservations)
+		for i := 0; i < numReservations; i++ {
+			go func() {
+				defer wg.Done()
+
+				reservation, err := nm.ReserveNonce(context.Background(), testAddress)
+				require.NoError(t, err)
+				nonces <- reservation.Nonce
+			}()
+		}
+		wg.Wait()
+		close(nonces)
+
+		reservedNonces := make(map[uint64]struct{})
+		for nonce := range nonces {
+			reservedNonces[nonce] = struct{}{}
+		}
+		require.Equal(t, numReservations, len(reservedNonces))
+		require.Contains(t, reservedNonces, uint64(7))
+		require.Contains(t, reservedNonces, uint64(8))
+	})
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -29,7 +30,7 @@ func NewProofProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*P
 }
 
 // GetProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -39,7 +40,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 	for _, observer := range observers {
 		responseGetProof := &data.GenericAPIResponse{}
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofEndpoint, responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
@@ -72,7 +73,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 }
 
 // GetProofCurrentRootHash sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -82,7 +83,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 	for _, observer := range observers {
 		responseGetProof := &data.GenericAPIResponse{}
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPoint(ctx, observer.Address, getProofEndpoint, responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
@@ -114,7 +115,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 }
 
 // VerifyProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -129,7 +130,7 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 	for _, observer := range observers {
 		responseVerifyProof := &data.GenericAPIResponse{}
 
-		respCode, err := pp.proc.CallPostRestEndPoint(observer.Address, verifyProofEndpoint, requestParams, responseVerifyProof)
+		respCode, err := pp.proc.CallPostRestEndPoint(ctx, observer.Address, verifyProofEndpoint, requestParams, responseVerifyProof)
 
 		if responseVerifyProof.Error != "" {
 			return nil, errors.New(responseVerifyProof.Error)
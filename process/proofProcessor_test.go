@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -42,7 +43,7 @@ func TestProofProcessor_GetProofInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
 	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	proof, err := pp.GetProof("rootHash", "invalid hex number")
+	proof, err := pp.GetProof(context.Background(), "rootHash", "invalid hex number")
 
 	assert.Nil(t, proof)
 	assert.NotNil(t, err)
@@ -80,7 +81,7 @@ func TestProofProcessor_GetProofSendingFailsOnFirstObserverShouldStillSend(t *te
 		&mock.PubKeyConverterMock{},
 	)
 
-	response, err := pp.GetProof("rootHash", "deadbeef")
+	response, err := pp.GetProof(context.Background(), "rootHash", "deadbeef")
 	assert.Nil(t, err)
 
 	proofs, ok := response.Data.([]string)
@@ -94,7 +95,7 @@ func TestProofProcessor_VerifyProofInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
 	pp, _ := process.NewProofProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	resp, err := pp.VerifyProof("rootHash", "invalid hex number", []string{})
+	resp, err := pp.VerifyProof(context.Background(), "rootHash", "invalid hex number", []string{})
 
 	assert.Nil(t, resp)
 	assert.NotNil(t, err)
@@ -132,7 +133,7 @@ func TestProofProcessor_VerifyProofSendingFailsOnFirstObserverShouldStillSend(t
 		&mock.PubKeyConverterMock{},
 	)
 
-	resp, err := pp.VerifyProof("rootHash", "deadbeef", proof)
+	resp, err := pp.VerifyProof(context.Background(), "rootHash", "deadbeef", proof)
 	assert.Nil(t, err)
 
 	isValid, ok := resp.Data.(bool)
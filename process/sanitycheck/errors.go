@@ -0,0 +1,6 @@
+package sanitycheck
+
+import "errors"
+
+// ErrInvalidNumberOfShards signals that an invalid number of shards has been provided
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
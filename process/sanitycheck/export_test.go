@@ -0,0 +1,21 @@
+package sanitycheck
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+func (nsc *nodesSanityChecker) SetGetTimeFunc(getTimeFunc func() time.Time) {
+	nsc.mutQuarantine.Lock()
+	nsc.getTimeFunc = getTimeFunc
+	nsc.mutQuarantine.Unlock()
+}
+
+// NodesSanityCheckerForTests -
+type NodesSanityCheckerForTests interface {
+	CheckNodes(nodesWithMetrics []*data.NodeWithMetrics)
+	IsQuarantined(node *data.NodeData) bool
+	GetQuarantinedNodes() []*data.QuarantinedNode
+	SetGetTimeFunc(getTimeFunc func() time.Time)
+}
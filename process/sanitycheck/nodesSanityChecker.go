@@ -0,0 +1,226 @@
+package sanitycheck
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/sanitycheck")
+
+// ArgsNodesSanityChecker holds the arguments needed for creating a new nodes sanity checker. If ExpectedChainID is
+// empty, the chain ID reported by most of the nodes is expected
+type ArgsNodesSanityChecker struct {
+	ExpectedChainID string
+	NumShards       uint32
+}
+
+// nodesSanityChecker compares the values reported by each node with its configuration and with the values reported
+// by the other nodes: the shard ID must be the configured one, the number of shards must be the one resulted from the
+// configured observers, while the chain ID and the round duration must be the ones reported by most of the nodes.
+// The mismatching nodes are quarantined until a following check finds them matching again. The nodes which cannot
+// be asked for their status keep their quarantine state
+type nodesSanityChecker struct {
+	expectedChainID string
+	numShards       uint32
+	getTimeFunc     func() time.Time
+
+	mutQuarantine    sync.RWMutex
+	quarantinedNodes map[string]*data.QuarantinedNode
+}
+
+// NewNodesSanityChecker returns a new instance of nodesSanityChecker
+func NewNodesSanityChecker(args ArgsNodesSanityChecker) (*nodesSanityChecker, error) {
+	if args.NumShards == 0 {
+		return nil, ErrInvalidNumberOfShards
+	}
+
+	return &nodesSanityChecker{
+		expectedChainID:  args.ExpectedChainID,
+		numShards:        args.NumShards,
+		getTimeFunc:      time.Now,
+		quarantinedNodes: make(map[string]*data.QuarantinedNode),
+	}, nil
+}
+
+// CheckNodes checks the metrics reported by the provided nodes and updates the quarantined nodes
+func (nsc *nodesSanityChecker) CheckNodes(nodesWithMetrics []*data.NodeWithMetrics) {
+	expectedChainID := nsc.expectedChainID
+	if len(expectedChainID) == 0 {
+		expectedChainID = getMostReportedValue(nodesWithMetrics, func(metrics *data.NodeStatusResponse) string {
+			return metrics.ChainID
+		})
+	}
+	expectedRoundDuration := getMostReportedValue(nodesWithMetrics, func(metrics *data.NodeStatusResponse) string {
+		return formatUint(metrics.RoundDuration)
+	})
+
+	nsc.mutQuarantine.Lock()
+	defer nsc.mutQuarantine.Unlock()
+
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		if nodeWithMetrics.Metrics == nil {
+			continue
+		}
+
+		mismatches := nsc.getMismatches(nodeWithMetrics, expectedChainID, expectedRoundDuration)
+		nsc.updateQuarantineUnprotected(nodeWithMetrics.Node, mismatches)
+	}
+}
+
+func (nsc *nodesSanityChecker) getMismatches(
+	nodeWithMetrics *data.NodeWithMetrics,
+	expectedChainID string,
+	expectedRoundDuration string,
+) []string {
+	node := nodeWithMetrics.Node
+	metrics := nodeWithMetrics.Metrics
+	mismatches := make([]string, 0)
+
+	if metrics.ShardId != nil && *metrics.ShardId != node.ShardId {
+		mismatches = append(mismatches, fmt.Sprintf("reports shard %d, configured shard %d", *metrics.ShardId, node.ShardId))
+	}
+	if len(metrics.ChainID) > 0 && len(expectedChainID) > 0 && metrics.ChainID != expectedChainID {
+		mismatches = append(mismatches, fmt.Sprintf("reports chain ID %s, expected chain ID %s", metrics.ChainID, expectedChainID))
+	}
+	if metrics.NumShardsWithoutMeta != 0 && metrics.NumShardsWithoutMeta != nsc.numShards {
+		mismatches = append(mismatches, fmt.Sprintf("reports %d shards, the configured observers are spread on %d shards",
+			metrics.NumShardsWithoutMeta, nsc.numShards))
+	}
+	roundDuration := formatUint(metrics.RoundDuration)
+	if len(roundDuration) > 0 && len(expectedRoundDuration) > 0 && roundDuration != expectedRoundDuration {
+		mismatches = append(mismatches, fmt.Sprintf("reports round duration %s ms, the other nodes report %s ms",
+			roundDuration, expectedRoundDuration))
+	}
+
+	return mismatches
+}
+
+func (nsc *nodesSanityChecker) updateQuarantineUnprotected(node *data.NodeData, mismatches []string) {
+	key := computeNodeKey(node)
+	quarantinedNode, isQuarantined := nsc.quarantinedNodes[key]
+	if len(mismatches) == 0 {
+		if isQuarantined {
+			log.Info("node released from quarantine", "address", node.Address, "shard", node.ShardId)
+			delete(nsc.quarantinedNodes, key)
+		}
+		return
+	}
+
+	reason := strings.Join(mismatches, ", ")
+	if isQuarantined && quarantinedNode.Reason == reason {
+		return
+	}
+
+	log.Error("node quarantined as it does not match its configuration or the other nodes",
+		"address", node.Address,
+		"shard", node.ShardId,
+		"is fallback", node.IsFallback,
+		"reason", reason)
+
+	quarantinedAt := nsc.getTimeFunc()
+	if isQuarantined {
+		quarantinedAt = quarantinedNode.QuarantinedAt
+	}
+	nsc.quarantinedNodes[key] = &data.QuarantinedNode{
+		Address:         node.Address,
+		ConfiguredShard: node.ShardId,
+		IsFallback:      node.IsFallback,
+		Reason:          reason,
+		QuarantinedAt:   quarantinedAt,
+	}
+}
+
+// IsQuarantined returns true if the provided node is quarantined
+func (nsc *nodesSanityChecker) IsQuarantined(node *data.NodeData) bool {
+	nsc.mutQuarantine.RLock()
+	defer nsc.mutQuarantine.RUnlock()
+
+	_, isQuarantined := nsc.quarantinedNodes[computeNodeKey(node)]
+
+	return isQuarantined
+}
+
+// GetQuarantinedNodes returns the quarantined nodes, sorted by address
+func (nsc *nodesSanityChecker) GetQuarantinedNodes() []*data.QuarantinedNode {
+	nsc.mutQuarantine.RLock()
+	defer nsc.mutQuarantine.RUnlock()
+
+	quarantinedNodes := make([]*data.QuarantinedNode, 0, len(nsc.quarantinedNodes))
+	for _, quarantinedNode := range nsc.quarantinedNodes {
+		nodeCopy := *quarantinedNode
+		quarantinedNodes = append(quarantinedNodes, &nodeCopy)
+	}
+
+	sort.Slice(quarantinedNodes, func(i, j int) bool {
+		if quarantinedNodes[i].Address == quarantinedNodes[j].Address {
+			return quarantinedNodes[i].ConfiguredShard < quarantinedNodes[j].ConfiguredShard
+		}
+
+		return quarantinedNodes[i].Address < quarantinedNodes[j].Address
+	})
+
+	return quarantinedNodes
+}
+
+// getMostReportedValue returns the value reported by most of the nodes. If several values are reported by the same
+// number of nodes, none of them is expected, so an empty string is returned
+func getMostReportedValue(
+	nodesWithMetrics []*data.NodeWithMetrics,
+	valueGetter func(metrics *data.NodeStatusResponse) string,
+) string {
+	numReports := make(map[string]int)
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		if nodeWithMetrics.Metrics == nil {
+			continue
+		}
+
+		value := valueGetter(nodeWithMetrics.Metrics)
+		if len(value) > 0 {
+			numReports[value]++
+		}
+	}
+
+	mostReportedValue := ""
+	maxNumReports := 0
+	isTie := false
+	for value, num := range numReports {
+		switch {
+		case num > maxNumReports:
+			mostReportedValue = value
+			maxNumReports = num
+			isTie = false
+		case num == maxNumReports:
+			isTie = true
+		}
+	}
+	if isTie {
+		return ""
+	}
+
+	return mostReportedValue
+}
+
+func formatUint(value uint64) string {
+	if value == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(value, 10)
+}
+
+// the same address can be configured for several shards, for example as observer and as full history node
+func computeNodeKey(node *data.NodeData) string {
+	return fmt.Sprintf("%s/%d", node.Address, node.ShardId)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsc *nodesSanityChecker) IsInterfaceNil() bool {
+	return nsc == nil
+}
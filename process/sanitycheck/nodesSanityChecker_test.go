@@ -0,0 +1,160 @@
+package sanitycheck_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/sanitycheck"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithMetrics(address string, configuredShard uint32, reportedShard uint32, chainID string) *data.NodeWithMetrics {
+	return &data.NodeWithMetrics{
+		Node: &data.NodeData{Address: address, ShardId: configuredShard},
+		Metrics: &data.NodeStatusResponse{
+			ShardId:              &reportedShard,
+			ChainID:              chainID,
+			NumShardsWithoutMeta: 2,
+			RoundDuration:        6000,
+		},
+	}
+}
+
+func createNodesSanityChecker(t *testing.T, expectedChainID string) sanitycheck.NodesSanityCheckerForTests {
+	nsc, err := sanitycheck.NewNodesSanityChecker(sanitycheck.ArgsNodesSanityChecker{
+		ExpectedChainID: expectedChainID,
+		NumShards:       2,
+	})
+	require.NoError(t, err)
+
+	return nsc
+}
+
+func TestNewNodesSanityChecker(t *testing.T) {
+	t.Parallel()
+
+	nsc, err := sanitycheck.NewNodesSanityChecker(sanitycheck.ArgsNodesSanityChecker{})
+	require.Equal(t, sanitycheck.ErrInvalidNumberOfShards, err)
+	require.True(t, check.IfNil(nsc))
+
+	nsc, err = sanitycheck.NewNodesSanityChecker(sanitycheck.ArgsNodesSanityChecker{NumShards: 2})
+	require.NoError(t, err)
+	require.False(t, check.IfNil(nsc))
+	require.Empty(t, nsc.GetQuarantinedNodes())
+}
+
+func TestNodesSanityChecker_CheckNodesShouldQuarantineMismatchingNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong shard", func(t *testing.T) {
+		t.Parallel()
+
+		nsc := createNodesSanityChecker(t, "")
+		wrongShardNode := createNodeWithMetrics("address1", 1, 0, "1")
+		nsc.CheckNodes([]*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, 0, "1"),
+			wrongShardNode,
+			createNodeWithMetrics("address2", core.MetachainShardId, core.MetachainShardId, "1"),
+		})
+
+		require.False(t, nsc.IsQuarantined(&data.NodeData{Address: "address0", ShardId: 0}))
+		require.True(t, nsc.IsQuarantined(wrongShardNode.Node))
+		require.False(t, nsc.IsQuarantined(&data.NodeData{Address: "address1", ShardId: 0}))
+
+		quarantinedNodes := nsc.GetQuarantinedNodes()
+		require.Equal(t, 1, len(quarantinedNodes))
+		require.Equal(t, "address1", quarantinedNodes[0].Address)
+		require.Equal(t, uint32(1), quarantinedNodes[0].ConfiguredShard)
+		require.Equal(t, "reports shard 0, configured shard 1", quarantinedNodes[0].Reason)
+	})
+	t.Run("chain ID of the minority", func(t *testing.T) {
+		t.Parallel()
+
+		nsc := createNodesSanityChecker(t, "")
+		nsc.CheckNodes([]*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, 0, "1"),
+			createNodeWithMetrics("address1", 1, 1, "D"),
+			createNodeWithMetrics("address2", 1, 1, "1"),
+		})
+
+		quarantinedNodes := nsc.GetQuarantinedNodes()
+		require.Equal(t, 1, len(quarantinedNodes))
+		require.Equal(t, "address1", quarantinedNodes[0].Address)
+		require.Equal(t, "reports chain ID D, expected chain ID 1", quarantinedNodes[0].Reason)
+	})
+	t.Run("chain ID other than the expected one", func(t *testing.T) {
+		t.Parallel()
+
+		nsc := createNodesSanityChecker(t, "D")
+		nsc.CheckNodes([]*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, 0, "1"),
+			createNodeWithMetrics("address1", 1, 1, "D"),
+			createNodeWithMetrics("address2", 1, 1, "1"),
+		})
+
+		quarantinedNodes := nsc.GetQuarantinedNodes()
+		require.Equal(t, 2, len(quarantinedNodes))
+		require.Equal(t, "address0", quarantinedNodes[0].Address)
+		require.Equal(t, "address2", quarantinedNodes[1].Address)
+	})
+	t.Run("network config", func(t *testing.T) {
+		t.Parallel()
+
+		nsc := createNodesSanityChecker(t, "")
+		wrongNetworkNode := createNodeWithMetrics("address1", 1, 1, "1")
+		wrongNetworkNode.Metrics.NumShardsWithoutMeta = 3
+		wrongNetworkNode.Metrics.RoundDuration = 4000
+		nsc.CheckNodes([]*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, 0, "1"),
+			wrongNetworkNode,
+			createNodeWithMetrics("address2", 1, 1, "1"),
+		})
+
+		quarantinedNodes := nsc.GetQuarantinedNodes()
+		require.Equal(t, 1, len(quarantinedNodes))
+		require.Equal(t, "reports 3 shards, the configured observers are spread on 2 shards, "+
+			"reports round duration 4000 ms, the other nodes report 6000 ms", quarantinedNodes[0].Reason)
+	})
+}
+
+func TestNodesSanityChecker_CheckNodesWithoutMajorityShouldNotQuarantine(t *testing.T) {
+	t.Parallel()
+
+	nsc := createNodesSanityChecker(t, "")
+	nsc.CheckNodes([]*data.NodeWithMetrics{
+		createNodeWithMetrics("address0", 0, 0, "1"),
+		createNodeWithMetrics("address1", 1, 1, "D"),
+	})
+
+	require.Empty(t, nsc.GetQuarantinedNodes())
+}
+
+func TestNodesSanityChecker_CheckNodesShouldReleaseMatchingNodes(t *testing.T) {
+	t.Parallel()
+
+	nsc := createNodesSanityChecker(t, "1")
+	quarantineTime := time.Unix(1000, 0)
+	nsc.SetGetTimeFunc(func() time.Time {
+		return quarantineTime
+	})
+
+	wrongShardNode := createNodeWithMetrics("address0", 1, 0, "1")
+	nsc.CheckNodes([]*data.NodeWithMetrics{wrongShardNode})
+	require.True(t, nsc.IsQuarantined(wrongShardNode.Node))
+
+	// a node which cannot be asked for its status keeps its quarantine state
+	quarantineTime = time.Unix(2000, 0)
+	wrongShardNode.Metrics.ChainID = "D"
+	nsc.CheckNodes([]*data.NodeWithMetrics{wrongShardNode, {Node: wrongShardNode.Node}})
+	quarantinedNodes := nsc.GetQuarantinedNodes()
+	require.Equal(t, 1, len(quarantinedNodes))
+	require.Equal(t, "reports shard 0, configured shard 1, reports chain ID D, expected chain ID 1", quarantinedNodes[0].Reason)
+	require.Equal(t, time.Unix(1000, 0), quarantinedNodes[0].QuarantinedAt)
+
+	nsc.CheckNodes([]*data.NodeWithMetrics{createNodeWithMetrics("address0", 1, 1, "1")})
+	require.False(t, nsc.IsQuarantined(wrongShardNode.Node))
+	require.Empty(t, nsc.GetQuarantinedNodes())
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
@@ -18,25 +19,30 @@ const SCQueryServicePath = "/vm-values/query"
 type SCQueryProcessor struct {
 	proc            Processor
 	pubKeyConverter core.PubkeyConverter
+	hedger          RequestsHedgerHandler
 }
 
 // NewSCQueryProcessor creates a new instance of SCQueryProcessor
-func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*SCQueryProcessor, error) {
+func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, hedger RequestsHedgerHandler) (*SCQueryProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(hedger) {
+		return nil, ErrNilRequestsHedger
+	}
 
 	return &SCQueryProcessor{
 		proc:            proc,
 		pubKeyConverter: pubKeyConverter,
+		hedger:          hedger,
 	}, nil
 }
 
 // ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
 	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
 		return nil, err
@@ -47,38 +53,44 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 		return nil, err
 	}
 
-	observers, err := scQueryProcessor.proc.GetObservers(shardID)
+	observers, err := scQueryProcessor.proc.GetObserversForAddress(shardID, addressBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, observer := range observers {
-		request := scQueryProcessor.createRequestFromQuery(query)
-		response := &data.ResponseVmValue{}
+	request := scQueryProcessor.createRequestFromQuery(query)
+	vmOutput, err := executeOnObservers(ctx, scQueryProcessor.hedger, scQueryOperation, observers,
+		func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			response := &data.ResponseVmValue{}
 
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, SCQueryServicePath, request, response)
-		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
-		isOk := httpStatus == http.StatusOK
-		responseHasExplicitError := len(response.Error) > 0
+			httpStatus, errPost := scQueryProcessor.proc.CallPostRestEndPoint(ctx, observer.Address, SCQueryServicePath, request, response)
+			isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout || httpStatus == http.StatusServiceUnavailable
+			isOk := httpStatus == http.StatusOK
+			responseHasExplicitError := len(response.Error) > 0
 
-		if isObserverDown {
-			log.LogIfError(err)
-			continue
-		}
+			if isObserverDown {
+				log.LogIfError(errPost)
+				return nil, false, errPost
+			}
 
-		if isOk {
-			log.Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
-			return response.Data.Data, nil
-		}
+			if isOk {
+				log.Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
+				return response.Data.Data, true, nil
+			}
 
-		if responseHasExplicitError {
-			return nil, fmt.Errorf(response.Error)
-		}
+			if responseHasExplicitError {
+				return nil, true, fmt.Errorf(response.Error)
+			}
 
+			return nil, true, errPost
+		})
+	if err != nil {
 		return nil, err
 	}
 
-	return nil, ErrSendingRequest
+	output, _ := vmOutput.(*vm.VMOutputApi)
+
+	return output, nil
 }
 
 func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQuery) data.VmValueRequest {
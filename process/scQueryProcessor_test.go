@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -18,7 +19,7 @@ var dummyScAddress = "erd1l453hd0gt5gzdp7czpuall8ggt2dcv5zwmfdf3sd3lguxseux2fsms
 func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter, &mock.RequestsHedgerStub{})
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilCoreProcessor, err)
 }
@@ -26,15 +27,23 @@ func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil, &mock.RequestsHedgerStub{})
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
 
+func TestNewSCQueryProcessor_NilRequestsHedgerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, nil)
+	require.Nil(t, processor)
+	require.Equal(t, ErrNilRequestsHedger, err)
+}
+
 func TestNewSCQueryProcessor_WithCoreProcessor(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 	require.NotNil(t, processor)
 	require.Nil(t, err)
 }
@@ -47,9 +56,9 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
 			return 0, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -65,9 +74,9 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
 			return nil, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -89,9 +98,9 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusNotFound, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, ErrSendingRequest, err)
 }
@@ -115,9 +124,9 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -144,9 +153,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusInternalServerError, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -169,9 +178,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 			response.(*data.ResponseVmValue).Error = errExpected.Error()
 			return http.StatusBadRequest, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, &mock.RequestsHedgerStub{})
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -1,6 +1,10 @@
 package process
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
@@ -31,7 +35,88 @@ func (sp *StatusProcessor) GetMetrics() map[string]*data.EndpointMetrics {
 	return sp.statusMetricsProvider.GetAll()
 }
 
+// GetCoalescedRequestsMetrics returns, for each node, the number of requests which were coalesced with an identical
+// request in progress
+func (sp *StatusProcessor) GetCoalescedRequestsMetrics() map[string]uint64 {
+	return sp.statusMetricsProvider.GetCoalescedRequests()
+}
+
+// GetApiKeysUsage returns the usage of each API key
+func (sp *StatusProcessor) GetApiKeysUsage() map[string]*data.ApiKeyUsage {
+	return sp.statusMetricsProvider.GetApiKeysUsage()
+}
+
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sp *StatusProcessor) GetMetricsForPrometheus() string {
-	return sp.statusMetricsProvider.GetMetricsForPrometheus()
+	stringBuilder := strings.Builder{}
+	stringBuilder.WriteString(sp.statusMetricsProvider.GetMetricsForPrometheus())
+
+	for _, status := range sp.proc.GetCircuitBreakersStatuses() {
+		stringBuilder.WriteString(fmt.Sprintf("circuit_breaker_state{node=\"%s\"} %d\n", status.Address, circuitBreakerStateToMetricValue(status.State)))
+		stringBuilder.WriteString(fmt.Sprintf("circuit_breaker_consecutive_failures{node=\"%s\"} %d\n", status.Address, status.ConsecutiveFailures))
+	}
+
+	for _, health := range sp.proc.GetNodesHealth() {
+		labels := fmt.Sprintf("node=\"%s\",shard=\"%d\",type=\"%s\"", health.Address, health.ShardId, health.Type)
+		stringBuilder.WriteString(fmt.Sprintf("node_is_synced{%s} %d\n", labels, boolToMetricValue(health.IsSynced)))
+		stringBuilder.WriteString(fmt.Sprintf("node_is_fallback{%s} %d\n", labels, boolToMetricValue(health.IsFallback)))
+		stringBuilder.WriteString(fmt.Sprintf("node_is_last_synced_backup{%s} %d\n", labels, boolToMetricValue(health.IsLastSyncedBackup)))
+		stringBuilder.WriteString(fmt.Sprintf("node_is_quarantined{%s} %d\n", labels, boolToMetricValue(health.IsQuarantined)))
+		stringBuilder.WriteString(fmt.Sprintf("node_nonce{%s} %d\n", labels, health.Nonce))
+		stringBuilder.WriteString(fmt.Sprintf("node_nonce_lag{%s} %d\n", labels, health.NonceLag))
+		stringBuilder.WriteString(fmt.Sprintf("node_last_check_timestamp{%s} %d\n", labels, timeToMetricValue(health.LastCheck)))
+		stringBuilder.WriteString(fmt.Sprintf("node_num_failed_checks{%s} %d\n", labels, health.NumFailedChecks))
+		stringBuilder.WriteString(fmt.Sprintf("node_num_requests{%s} %d\n", labels, health.NumRequests))
+		stringBuilder.WriteString(fmt.Sprintf("node_num_errors{%s} %d\n", labels, health.NumErrors))
+		stringBuilder.WriteString(fmt.Sprintf("node_latency_p50_ms{%s} %g\n", labels, health.LatencyP50Ms))
+		stringBuilder.WriteString(fmt.Sprintf("node_latency_p90_ms{%s} %g\n", labels, health.LatencyP90Ms))
+		stringBuilder.WriteString(fmt.Sprintf("node_latency_p99_ms{%s} %g\n", labels, health.LatencyP99Ms))
+	}
+
+	return stringBuilder.String()
+}
+
+// GetCircuitBreakersStatuses returns the statuses of the observers' circuit breakers
+func (sp *StatusProcessor) GetCircuitBreakersStatuses() []*data.NodeCircuitBreakerStatus {
+	return sp.proc.GetCircuitBreakersStatuses()
+}
+
+// GetQuarantinedNodes returns the nodes which are not used because they do not match their configuration
+func (sp *StatusProcessor) GetQuarantinedNodes() []*data.QuarantinedNode {
+	return sp.proc.GetQuarantinedNodes()
+}
+
+// GetNodesHealth returns the health of all the observers and of all the full history nodes
+func (sp *StatusProcessor) GetNodesHealth() []*data.NodeHealthStatus {
+	return sp.proc.GetNodesHealth()
+}
+
+func boolToMetricValue(value bool) int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+// timeToMetricValue returns the unix timestamp of the provided time or 0 if it is not set
+func timeToMetricValue(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+// circuitBreakerStateToMetricValue converts the state of a circuit breaker to a numeric value, as follows:
+// 0 - closed, 1 - half-open, 2 - open
+func circuitBreakerStateToMetricValue(state data.CircuitBreakerState) int {
+	switch state {
+	case data.CircuitBreakerOpen:
+		return 2
+	case data.CircuitBreakerHalfOpen:
+		return 1
+	default:
+		return 0
+	}
 }
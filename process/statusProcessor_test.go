@@ -2,6 +2,7 @@ package process
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
@@ -74,3 +75,91 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, metrics)
 }
+
+func TestStatusProcessor_GetMetricsForPrometheusShouldIncludeCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	statusProvider := &mock.StatusMetricsProviderStub{
+		GetMetricsForPrometheusCalled: func() string {
+			return "metrics\n"
+		},
+	}
+	proc := &mock.ProcessorStub{
+		GetCircuitBreakersStatusesCalled: func() []*data.NodeCircuitBreakerStatus {
+			return []*data.NodeCircuitBreakerStatus{
+				{Address: "addr0", State: data.CircuitBreakerClosed, ConsecutiveFailures: 1},
+				{Address: "addr1", State: data.CircuitBreakerOpen, ConsecutiveFailures: 5},
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(proc, statusProvider)
+	require.NoError(t, err)
+
+	expectedOutput := `metrics
+circuit_breaker_state{node="addr0"} 0
+circuit_breaker_consecutive_failures{node="addr0"} 1
+circuit_breaker_state{node="addr1"} 2
+circuit_breaker_consecutive_failures{node="addr1"} 5
+`
+	require.Equal(t, expectedOutput, sp.GetMetricsForPrometheus())
+}
+
+func TestStatusProcessor_GetMetricsForPrometheusShouldIncludeNodesHealth(t *testing.T) {
+	t.Parallel()
+
+	proc := &mock.ProcessorStub{
+		GetNodesHealthCalled: func() []*data.NodeHealthStatus {
+			return []*data.NodeHealthStatus{
+				{
+					Address:      "addr0",
+					Type:         data.Observer,
+					ShardId:      1,
+					IsSynced:     true,
+					Nonce:        100,
+					NonceLag:     2,
+					LastCheck:    time.Unix(1000, 0),
+					NumRequests:  10,
+					NumErrors:    1,
+					LatencyP50Ms: 1.5,
+					LatencyP90Ms: 20,
+					LatencyP99Ms: 35.25,
+				},
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(proc, &mock.StatusMetricsProviderStub{})
+	require.NoError(t, err)
+
+	expectedOutput := `node_is_synced{node="addr0",shard="1",type="observer"} 1
+node_is_fallback{node="addr0",shard="1",type="observer"} 0
+node_is_last_synced_backup{node="addr0",shard="1",type="observer"} 0
+node_is_quarantined{node="addr0",shard="1",type="observer"} 0
+node_nonce{node="addr0",shard="1",type="observer"} 100
+node_nonce_lag{node="addr0",shard="1",type="observer"} 2
+node_last_check_timestamp{node="addr0",shard="1",type="observer"} 1000
+node_num_failed_checks{node="addr0",shard="1",type="observer"} 0
+node_num_requests{node="addr0",shard="1",type="observer"} 10
+node_num_errors{node="addr0",shard="1",type="observer"} 1
+node_latency_p50_ms{node="addr0",shard="1",type="observer"} 1.5
+node_latency_p90_ms{node="addr0",shard="1",type="observer"} 20
+node_latency_p99_ms{node="addr0",shard="1",type="observer"} 35.25
+`
+	require.Equal(t, expectedOutput, sp.GetMetricsForPrometheus())
+}
+
+func TestStatusProcessor_GetCircuitBreakersStatuses(t *testing.T) {
+	t.Parallel()
+
+	expectedStatuses := []*data.NodeCircuitBreakerStatus{
+		{Address: "addr0", State: data.CircuitBreakerHalfOpen},
+	}
+	proc := &mock.ProcessorStub{
+		GetCircuitBreakersStatusesCalled: func() []*data.NodeCircuitBreakerStatus {
+			return expectedStatuses
+		},
+	}
+	sp, err := NewStatusProcessor(proc, &mock.StatusMetricsProviderStub{})
+	require.NoError(t, err)
+
+	require.Equal(t, expectedStatuses, sp.GetCircuitBreakersStatuses())
+}
@@ -0,0 +1,24 @@
+package syncstate
+
+import "errors"
+
+// ErrEmptyShardIDs signals that no shard ID has been provided
+var ErrEmptyShardIDs = errors.New("empty shard IDs")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrInvalidComparisonMethod signals that an invalid comparison method has been provided
+var ErrInvalidComparisonMethod = errors.New("invalid comparison method")
+
+// ErrInvalidLagThreshold signals that an invalid lag threshold has been provided
+var ErrInvalidLagThreshold = errors.New("invalid lag threshold")
+
+// ErrInvalidJitterPercent signals that an invalid jitter percent has been provided
+var ErrInvalidJitterPercent = errors.New("invalid jitter percent")
+
+// ErrUnknownShard signals that settings have been provided for a shard which does not exist
+var ErrUnknownShard = errors.New("unknown shard")
+
+// ErrDuplicatedShardSettings signals that a shard has more than one settings entry
+var ErrDuplicatedShardSettings = errors.New("duplicated shard settings")
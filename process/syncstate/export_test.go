@@ -0,0 +1,18 @@
+package syncstate
+
+import "time"
+
+// SetGetTimeFunc -
+func (nssc *nodesSyncStateChecker) SetGetTimeFunc(getTimeFunc func() time.Time) {
+	nssc.getTimeFunc = getTimeFunc
+}
+
+// SetRandomFunc -
+func (nssc *nodesSyncStateChecker) SetRandomFunc(randomFunc func() float64) {
+	nssc.randomFunc = randomFunc
+}
+
+// GetShardSettings -
+func (nssc *nodesSyncStateChecker) GetShardSettings(shardID uint32) ShardSettings {
+	return nssc.getShardSettings(shardID)
+}
@@ -0,0 +1,64 @@
+package syncstate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+const (
+	defaultCheckInterval    = time.Minute
+	defaultComparisonMethod = NonceComparisonMethod
+	defaultLagThreshold     = 10
+)
+
+// CreateNodesSyncStateChecker creates the nodes sync state checker defined by the provided config. The zero values of
+// the default settings are replaced by defaults, while the zero values of a shard's settings are taken from the default
+// settings
+func CreateNodesSyncStateChecker(cfg config.NodesSyncCheckConfig, shardIDs []uint32) (*nodesSyncStateChecker, error) {
+	defaultSettings := createShardSettings(cfg.Default, ShardSettings{
+		CheckInterval:    defaultCheckInterval,
+		ComparisonMethod: defaultComparisonMethod,
+		LagThreshold:     defaultLagThreshold,
+	})
+
+	shardsSettings := make(map[uint32]ShardSettings, len(cfg.Shards))
+	for _, shardConfig := range cfg.Shards {
+		_, exists := shardsSettings[shardConfig.ShardId]
+		if exists {
+			return nil, fmt.Errorf("%w for shard %d", ErrDuplicatedShardSettings, shardConfig.ShardId)
+		}
+
+		shardsSettings[shardConfig.ShardId] = createShardSettings(shardConfig, defaultSettings)
+	}
+
+	return NewNodesSyncStateChecker(ArgsNodesSyncStateChecker{
+		ShardIDs:        shardIDs,
+		DefaultSettings: defaultSettings,
+		ShardsSettings:  shardsSettings,
+		JitterPercent:   cfg.JitterPercent,
+	})
+}
+
+// createShardSettings converts the provided config, replacing its zero values with the ones from the fallback settings.
+// The relative to best node flag is never replaced
+func createShardSettings(cfg config.ShardSyncCheckConfig, fallbackSettings ShardSettings) ShardSettings {
+	settings := ShardSettings{
+		CheckInterval:      time.Duration(cfg.CheckIntervalSec) * time.Second,
+		ComparisonMethod:   cfg.ComparisonMethod,
+		LagThreshold:       cfg.LagThreshold,
+		RelativeToBestNode: cfg.RelativeToBestNode,
+	}
+	if cfg.CheckIntervalSec == 0 {
+		settings.CheckInterval = fallbackSettings.CheckInterval
+	}
+	if len(cfg.ComparisonMethod) == 0 {
+		settings.ComparisonMethod = fallbackSettings.ComparisonMethod
+	}
+	if cfg.LagThreshold == 0 {
+		settings.LagThreshold = fallbackSettings.LagThreshold
+	}
+
+	return settings
+}
@@ -0,0 +1,430 @@
+package syncstate
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/syncstate")
+
+const (
+	// NonceComparisonMethod computes the lag of a node as the number of blocks it is behind
+	NonceComparisonMethod = "nonce"
+
+	// RoundComparisonMethod computes the lag of a node as the number of rounds it is behind
+	RoundComparisonMethod = "round"
+
+	// TimeComparisonMethod computes the lag of a node as the number of seconds since its shard produced the first
+	// block the node does not have
+	TimeComparisonMethod = "time"
+
+	maxJitterPercent = 50
+)
+
+// ShardSettings holds the settings used for checking the sync state of the nodes of a shard
+type ShardSettings struct {
+	CheckInterval      time.Duration
+	ComparisonMethod   string
+	LagThreshold       uint64
+	RelativeToBestNode bool
+}
+
+// ArgsNodesSyncStateChecker holds the arguments needed for creating a new nodes sync state checker. The shards
+// without their own settings use the default ones
+type ArgsNodesSyncStateChecker struct {
+	ShardIDs        []uint32
+	DefaultSettings ShardSettings
+	ShardsSettings  map[uint32]ShardSettings
+	JitterPercent   uint32
+}
+
+type shardBestNode struct {
+	nonce             uint64
+	synchronizedRound uint64
+}
+
+// shardNonceRecord holds the moment the proxy first saw a shard reaching a nonce
+type shardNonceRecord struct {
+	nonce  uint64
+	seenAt time.Time
+}
+
+type nodeTimeLag struct {
+	metrics *data.NodeStatusResponse
+	lag     uint64
+}
+
+// nodesSyncStateChecker decides, for each shard, when its nodes should be checked next and whether they are synced,
+// based on the lag computed from the metrics they report. The checks are scheduled at the configured interval, shifted
+// by a random jitter, so the checks of the shards do not happen all at once
+type nodesSyncStateChecker struct {
+	shardIDs        []uint32
+	defaultSettings ShardSettings
+	shardsSettings  map[uint32]ShardSettings
+	jitterPercent   uint32
+	getTimeFunc     func() time.Time
+	randomFunc      func() float64
+
+	mutSchedule sync.RWMutex
+	nextChecks  map[uint32]time.Time
+
+	mutTimeLags   sync.Mutex
+	shardsNonces  map[uint32][]*shardNonceRecord
+	nodesTimeLags map[string]*nodeTimeLag
+}
+
+// NewNodesSyncStateChecker returns a new instance of nodesSyncStateChecker. All the shards are due for checking
+// right after the creation
+func NewNodesSyncStateChecker(args ArgsNodesSyncStateChecker) (*nodesSyncStateChecker, error) {
+	if len(args.ShardIDs) == 0 {
+		return nil, ErrEmptyShardIDs
+	}
+	if args.JitterPercent > maxJitterPercent {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", ErrInvalidJitterPercent, args.JitterPercent, maxJitterPercent)
+	}
+	err := checkShardSettings(args.DefaultSettings)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the default settings", err)
+	}
+
+	shardsSettings := make(map[uint32]ShardSettings, len(args.ShardIDs))
+	nextChecks := make(map[uint32]time.Time, len(args.ShardIDs))
+	for _, shardID := range args.ShardIDs {
+		shardsSettings[shardID] = args.DefaultSettings
+		nextChecks[shardID] = time.Time{}
+	}
+	for shardID, settings := range args.ShardsSettings {
+		_, exists := shardsSettings[shardID]
+		if !exists {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownShard, shardID)
+		}
+		err = checkShardSettings(settings)
+		if err != nil {
+			return nil, fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		shardsSettings[shardID] = settings
+	}
+
+	shardIDs := make([]uint32, len(args.ShardIDs))
+	copy(shardIDs, args.ShardIDs)
+
+	return &nodesSyncStateChecker{
+		shardIDs:        shardIDs,
+		defaultSettings: args.DefaultSettings,
+		shardsSettings:  shardsSettings,
+		jitterPercent:   args.JitterPercent,
+		getTimeFunc:     time.Now,
+		randomFunc:      rand.Float64,
+		nextChecks:      nextChecks,
+		shardsNonces:    make(map[uint32][]*shardNonceRecord),
+		nodesTimeLags:   make(map[string]*nodeTimeLag),
+	}, nil
+}
+
+func checkShardSettings(settings ShardSettings) error {
+	if settings.CheckInterval <= 0 {
+		return ErrInvalidCheckInterval
+	}
+	if settings.LagThreshold == 0 {
+		return ErrInvalidLagThreshold
+	}
+
+	switch settings.ComparisonMethod {
+	case NonceComparisonMethod, RoundComparisonMethod, TimeComparisonMethod:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidComparisonMethod, settings.ComparisonMethod)
+	}
+}
+
+// GetShardsToCheck returns the shards whose nodes are due for checking
+func (nssc *nodesSyncStateChecker) GetShardsToCheck() []uint32 {
+	nssc.mutSchedule.RLock()
+	defer nssc.mutSchedule.RUnlock()
+
+	now := nssc.getTimeFunc()
+	shardIDs := make([]uint32, 0)
+	for _, shardID := range nssc.shardIDs {
+		if !nssc.nextChecks[shardID].After(now) {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+
+	return shardIDs
+}
+
+// ScheduleNextChecks schedules the next checks of the provided shards, after their check interval shifted by a
+// random jitter
+func (nssc *nodesSyncStateChecker) ScheduleNextChecks(shardIDs []uint32) {
+	nssc.mutSchedule.Lock()
+	defer nssc.mutSchedule.Unlock()
+
+	now := nssc.getTimeFunc()
+	for _, shardID := range shardIDs {
+		settings, exists := nssc.shardsSettings[shardID]
+		if !exists {
+			continue
+		}
+
+		nssc.nextChecks[shardID] = now.Add(nssc.applyJitter(settings.CheckInterval))
+	}
+}
+
+// applyJitter returns the provided interval shifted randomly by at most jitterPercent of it, in either direction
+func (nssc *nodesSyncStateChecker) applyJitter(interval time.Duration) time.Duration {
+	maxJitter := float64(interval) * float64(nssc.jitterPercent) / 100
+	jitter := (nssc.randomFunc()*2 - 1) * maxJitter
+
+	return interval + time.Duration(jitter)
+}
+
+// GetDelayUntilNextCheck returns the time left until the earliest scheduled check
+func (nssc *nodesSyncStateChecker) GetDelayUntilNextCheck() time.Duration {
+	nssc.mutSchedule.RLock()
+	defer nssc.mutSchedule.RUnlock()
+
+	earliestCheck := nssc.nextChecks[nssc.shardIDs[0]]
+	for _, nextCheck := range nssc.nextChecks {
+		if nextCheck.Before(earliestCheck) {
+			earliestCheck = nextCheck
+		}
+	}
+
+	delay := earliestCheck.Sub(nssc.getTimeFunc())
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
+
+// ComputeSyncState marks each of the provided nodes as synced or not, based on the settings of its shard. A node which
+// could not be asked for its status or which is not ready for VM queries is never synced. When the lag is computed
+// relative to the best node, it is computed against the most advanced of the provided nodes of the same shard
+func (nssc *nodesSyncStateChecker) ComputeSyncState(nodesWithMetrics []*data.NodeWithMetrics) {
+	bestNodes := computeBestNodes(nodesWithMetrics)
+
+	nssc.mutTimeLags.Lock()
+	defer nssc.mutTimeLags.Unlock()
+
+	now := nssc.getTimeFunc()
+	nssc.recordShardsNoncesUnprotected(nodesWithMetrics, now)
+	nodesTimeLags := make(map[string]*nodeTimeLag)
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		node := nodeWithMetrics.Node
+		node.IsSynced = false
+		if nodeWithMetrics.Metrics == nil {
+			continue
+		}
+
+		settings := nssc.getShardSettings(node.ShardId)
+		var lag uint64
+		if settings.ComparisonMethod == TimeComparisonMethod {
+			timeLag := nssc.computeTimeLagUnprotected(nodeWithMetrics, bestNodes[node.ShardId], settings, now)
+			nodesTimeLags[computeNodeKey(node)] = timeLag
+			lag = timeLag.lag
+		} else {
+			lag = computeLag(nodeWithMetrics.Metrics, bestNodes[node.ShardId], settings)
+		}
+		threshold := settings.LagThreshold
+		if settings.ComparisonMethod == TimeComparisonMethod {
+			// the time lag is computed in milliseconds
+			threshold *= 1000
+		}
+		isReadyForVMQueries := nodeWithMetrics.Metrics.AreVmQueriesReady == strconv.FormatBool(true)
+		node.IsSynced = lag < threshold && isReadyForVMQueries
+
+		log.Info("node status",
+			"address", node.Address,
+			"shard", node.ShardId,
+			"nonce", nodeWithMetrics.Metrics.Nonce,
+			"probable highest nonce", nodeWithMetrics.Metrics.ProbableHighestNonce,
+			"synchronized round", nodeWithMetrics.Metrics.SynchronizedRound,
+			"comparison method", settings.ComparisonMethod,
+			"relative to best node", settings.RelativeToBestNode,
+			"lag", lag,
+			"is synced", node.IsSynced,
+			"is ready for VM Queries", isReadyForVMQueries,
+			"is fallback", node.IsFallback)
+	}
+	nssc.nodesTimeLags = nodesTimeLags
+}
+
+// recordShardsNoncesUnprotected records, for each shard checked by time, the moment its highest nonce reported in the
+// fresh metrics was first seen. The records no longer needed for telling whether a node exceeds the lag threshold are
+// removed, the latest one being always kept
+func (nssc *nodesSyncStateChecker) recordShardsNoncesUnprotected(nodesWithMetrics []*data.NodeWithMetrics, now time.Time) {
+	highestNonces := make(map[uint32]uint64)
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		shardID := nodeWithMetrics.Node.ShardId
+		if nodeWithMetrics.Metrics == nil || nssc.getShardSettings(shardID).ComparisonMethod != TimeComparisonMethod {
+			continue
+		}
+		if !nssc.hasFreshMetricsUnprotected(nodeWithMetrics) {
+			continue
+		}
+
+		highestNonce := highestNonces[shardID]
+		if nodeWithMetrics.Metrics.Nonce > highestNonce {
+			highestNonce = nodeWithMetrics.Metrics.Nonce
+		}
+		if nodeWithMetrics.Metrics.ProbableHighestNonce > highestNonce {
+			highestNonce = nodeWithMetrics.Metrics.ProbableHighestNonce
+		}
+		highestNonces[shardID] = highestNonce
+	}
+
+	for shardID, highestNonce := range highestNonces {
+		records := nssc.shardsNonces[shardID]
+		if len(records) == 0 || highestNonce > records[len(records)-1].nonce {
+			records = append(records, &shardNonceRecord{
+				nonce:  highestNonce,
+				seenAt: now,
+			})
+		}
+
+		// a node which does not have the nonce of the second record is already lagging at least the elapsed time since
+		// that record, so the first record can be dropped once this time exceeds the threshold
+		threshold := time.Duration(nssc.getShardSettings(shardID).LagThreshold) * time.Second
+		for len(records) > 1 && now.Sub(records[1].seenAt) >= threshold {
+			records = records[1:]
+		}
+		nssc.shardsNonces[shardID] = records
+	}
+}
+
+// hasFreshMetricsUnprotected returns true if the metrics of the node were fetched after its last lag computation, as
+// the nodes of the shards which were not checked are provided with the metrics from their latest check
+func (nssc *nodesSyncStateChecker) hasFreshMetricsUnprotected(nodeWithMetrics *data.NodeWithMetrics) bool {
+	timeLag, exists := nssc.nodesTimeLags[computeNodeKey(nodeWithMetrics.Node)]
+
+	return !exists || timeLag.metrics != nodeWithMetrics.Metrics
+}
+
+// computeTimeLagUnprotected returns the time lag of a node, in milliseconds. A node holding the nonce the lag is
+// computed against has no lag, so the empty rounds are not counted. Otherwise, the lag is the time passed since its
+// shard was first seen reaching a nonce higher than the one of the node, so a stuck nonce makes the lag grow with each
+// check. The nodes without fresh metrics keep the lag computed at their latest check
+func (nssc *nodesSyncStateChecker) computeTimeLagUnprotected(
+	nodeWithMetrics *data.NodeWithMetrics,
+	bestNode *shardBestNode,
+	settings ShardSettings,
+	now time.Time,
+) *nodeTimeLag {
+	metrics := nodeWithMetrics.Metrics
+	if !nssc.hasFreshMetricsUnprotected(nodeWithMetrics) {
+		return nssc.nodesTimeLags[computeNodeKey(nodeWithMetrics.Node)]
+	}
+
+	targetNonce := metrics.ProbableHighestNonce
+	if settings.RelativeToBestNode {
+		targetNonce = bestNode.nonce
+	}
+
+	timeLag := &nodeTimeLag{
+		metrics: metrics,
+	}
+	if metrics.Nonce >= targetNonce {
+		return timeLag
+	}
+
+	for index, record := range nssc.shardsNonces[nodeWithMetrics.Node.ShardId] {
+		if record.nonce <= metrics.Nonce {
+			continue
+		}
+
+		elapsed := now.Sub(record.seenAt)
+		if index == 0 {
+			// the nonces between the one of the node and the first record were produced before the first record was
+			// seen, at most one for each round
+			numMissingNonces := record.nonce - metrics.Nonce - 1
+			elapsed += time.Duration(numMissingNonces*metrics.RoundDuration) * time.Millisecond
+		}
+		timeLag.lag = uint64(elapsed.Milliseconds())
+
+		return timeLag
+	}
+
+	return timeLag
+}
+
+func (nssc *nodesSyncStateChecker) getShardSettings(shardID uint32) ShardSettings {
+	settings, exists := nssc.shardsSettings[shardID]
+	if !exists {
+		return nssc.defaultSettings
+	}
+
+	return settings
+}
+
+func computeBestNodes(nodesWithMetrics []*data.NodeWithMetrics) map[uint32]*shardBestNode {
+	bestNodes := make(map[uint32]*shardBestNode)
+	for _, nodeWithMetrics := range nodesWithMetrics {
+		if nodeWithMetrics.Metrics == nil {
+			continue
+		}
+
+		shardID := nodeWithMetrics.Node.ShardId
+		bestNode, exists := bestNodes[shardID]
+		if !exists {
+			bestNode = &shardBestNode{}
+			bestNodes[shardID] = bestNode
+		}
+		if nodeWithMetrics.Metrics.Nonce > bestNode.nonce {
+			bestNode.nonce = nodeWithMetrics.Metrics.Nonce
+		}
+		if nodeWithMetrics.Metrics.SynchronizedRound > bestNode.synchronizedRound {
+			bestNode.synchronizedRound = nodeWithMetrics.Metrics.SynchronizedRound
+		}
+	}
+
+	return bestNodes
+}
+
+// computeLag returns the lag of a node in blocks or in rounds, depending on the comparison method
+func computeLag(metrics *data.NodeStatusResponse, bestNode *shardBestNode, settings ShardSettings) uint64 {
+	switch settings.ComparisonMethod {
+	case RoundComparisonMethod:
+		return computeRoundLag(metrics, bestNode, settings.RelativeToBestNode)
+	default:
+		if settings.RelativeToBestNode {
+			return subtractOrZero(bestNode.nonce, metrics.Nonce)
+		}
+
+		// the probable highest nonce can be lower than the nonce, as the nonce metric can be updated faster
+		return subtractOrZero(metrics.ProbableHighestNonce, metrics.Nonce)
+	}
+}
+
+func computeRoundLag(metrics *data.NodeStatusResponse, bestNode *shardBestNode, relativeToBestNode bool) uint64 {
+	if relativeToBestNode {
+		return subtractOrZero(bestNode.synchronizedRound, metrics.SynchronizedRound)
+	}
+
+	return subtractOrZero(metrics.CurrentRound, metrics.SynchronizedRound)
+}
+
+// the same address can be configured for several shards, for example as observer and as full history node
+func computeNodeKey(node *data.NodeData) string {
+	return fmt.Sprintf("%s/%d", node.Address, node.ShardId)
+}
+
+func subtractOrZero(value uint64, valueToSubtract uint64) uint64 {
+	if value <= valueToSubtract {
+		return 0
+	}
+
+	return value - valueToSubtract
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nssc *nodesSyncStateChecker) IsInterfaceNil() bool {
+	return nssc == nil
+}
@@ -0,0 +1,405 @@
+package syncstate_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/syncstate"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() syncstate.ArgsNodesSyncStateChecker {
+	return syncstate.ArgsNodesSyncStateChecker{
+		ShardIDs: []uint32{0, 1, core.MetachainShardId},
+		DefaultSettings: syncstate.ShardSettings{
+			CheckInterval:    time.Minute,
+			ComparisonMethod: syncstate.NonceComparisonMethod,
+			LagThreshold:     10,
+		},
+	}
+}
+
+func createNodeWithMetrics(address string, shardID uint32, metrics *data.NodeStatusResponse) *data.NodeWithMetrics {
+	if metrics != nil {
+		metrics.AreVmQueriesReady = "true"
+	}
+
+	return &data.NodeWithMetrics{
+		Node:    &data.NodeData{Address: address, ShardId: shardID},
+		Metrics: metrics,
+	}
+}
+
+func TestNewNodesSyncStateChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty shard IDs should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ShardIDs = nil
+		nssc, err := syncstate.NewNodesSyncStateChecker(args)
+		require.Equal(t, syncstate.ErrEmptyShardIDs, err)
+		require.True(t, check.IfNil(nssc))
+	})
+	t.Run("invalid jitter should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.JitterPercent = 51
+		nssc, err := syncstate.NewNodesSyncStateChecker(args)
+		require.True(t, errors.Is(err, syncstate.ErrInvalidJitterPercent))
+		require.True(t, check.IfNil(nssc))
+	})
+	t.Run("invalid default settings should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.CheckInterval = 0
+		_, err := syncstate.NewNodesSyncStateChecker(args)
+		require.True(t, errors.Is(err, syncstate.ErrInvalidCheckInterval))
+
+		args = createMockArgs()
+		args.DefaultSettings.LagThreshold = 0
+		_, err = syncstate.NewNodesSyncStateChecker(args)
+		require.True(t, errors.Is(err, syncstate.ErrInvalidLagThreshold))
+
+		args = createMockArgs()
+		args.DefaultSettings.ComparisonMethod = "epoch"
+		_, err = syncstate.NewNodesSyncStateChecker(args)
+		require.True(t, errors.Is(err, syncstate.ErrInvalidComparisonMethod))
+	})
+	t.Run("invalid shard settings should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ShardsSettings = map[uint32]syncstate.ShardSettings{2: args.DefaultSettings}
+		_, err := syncstate.NewNodesSyncStateChecker(args)
+		require.True(t, errors.Is(err, syncstate.ErrUnknownShard))
+
+		args = createMockArgs()
+		args.ShardsSettings = map[uint32]syncstate.ShardSettings{1: {CheckInterval: time.Second}}
+		_, err = syncstate.NewNodesSyncStateChecker(args)
+		require.True(t, errors.Is(err, syncstate.ErrInvalidLagThreshold))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nssc, err := syncstate.NewNodesSyncStateChecker(createMockArgs())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nssc))
+		require.Equal(t, []uint32{0, 1, core.MetachainShardId}, nssc.GetShardsToCheck())
+		require.Equal(t, time.Duration(0), nssc.GetDelayUntilNextCheck())
+	})
+}
+
+func TestCreateNodesSyncStateChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("duplicated shard settings should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.NodesSyncCheckConfig{
+			Shards: []config.ShardSyncCheckConfig{{ShardId: 0}, {ShardId: 0}},
+		}
+		nssc, err := syncstate.CreateNodesSyncStateChecker(cfg, []uint32{0})
+		require.True(t, errors.Is(err, syncstate.ErrDuplicatedShardSettings))
+		require.True(t, check.IfNil(nssc))
+	})
+	t.Run("zero values should be replaced", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.NodesSyncCheckConfig{
+			Default: config.ShardSyncCheckConfig{
+				LagThreshold: 5,
+			},
+			Shards: []config.ShardSyncCheckConfig{
+				{
+					ShardId:            core.MetachainShardId,
+					CheckIntervalSec:   10,
+					ComparisonMethod:   syncstate.RoundComparisonMethod,
+					RelativeToBestNode: true,
+				},
+			},
+		}
+		nssc, err := syncstate.CreateNodesSyncStateChecker(cfg, []uint32{0, core.MetachainShardId})
+		require.NoError(t, err)
+
+		require.Equal(t, syncstate.ShardSettings{
+			CheckInterval:    time.Minute,
+			ComparisonMethod: syncstate.NonceComparisonMethod,
+			LagThreshold:     5,
+		}, nssc.GetShardSettings(0))
+		require.Equal(t, syncstate.ShardSettings{
+			CheckInterval:      10 * time.Second,
+			ComparisonMethod:   syncstate.RoundComparisonMethod,
+			LagThreshold:       5,
+			RelativeToBestNode: true,
+		}, nssc.GetShardSettings(core.MetachainShardId))
+	})
+}
+
+func TestNodesSyncStateChecker_ScheduleNextChecksShouldApplyJitter(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.JitterPercent = 10
+	args.ShardsSettings = map[uint32]syncstate.ShardSettings{
+		core.MetachainShardId: {
+			CheckInterval:    10 * time.Second,
+			ComparisonMethod: syncstate.NonceComparisonMethod,
+			LagThreshold:     10,
+		},
+	}
+	nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+
+	currentTime := time.Unix(1000, 0)
+	nssc.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+	randomValue := 0.0
+	nssc.SetRandomFunc(func() float64 {
+		return randomValue
+	})
+
+	// the lowest random value shifts the checks earlier by 10% of the interval
+	nssc.ScheduleNextChecks([]uint32{0, core.MetachainShardId})
+	require.Equal(t, []uint32{1}, nssc.GetShardsToCheck())
+
+	randomValue = 0.75
+	nssc.ScheduleNextChecks([]uint32{1})
+	require.Empty(t, nssc.GetShardsToCheck())
+	require.Equal(t, 9*time.Second, nssc.GetDelayUntilNextCheck())
+
+	currentTime = currentTime.Add(9 * time.Second)
+	require.Equal(t, []uint32{core.MetachainShardId}, nssc.GetShardsToCheck())
+	require.Equal(t, time.Duration(0), nssc.GetDelayUntilNextCheck())
+
+	// shard 0 is due after 54 seconds, while shard 1 after 63 seconds
+	currentTime = currentTime.Add(50 * time.Second)
+	require.Equal(t, []uint32{0, core.MetachainShardId}, nssc.GetShardsToCheck())
+}
+
+func TestNodesSyncStateChecker_ComputeSyncState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nonce lag against the own probable highest nonce", func(t *testing.T) {
+		t.Parallel()
+
+		nssc, _ := syncstate.NewNodesSyncStateChecker(createMockArgs())
+		nodes := []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 109}),
+			createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 110}),
+			createNodeWithMetrics("address2", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 90}),
+			createNodeWithMetrics("address3", 0, nil),
+			createNodeWithMetrics("address4", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100}),
+		}
+		nodes[4].Metrics.AreVmQueriesReady = "false"
+		nssc.ComputeSyncState(nodes)
+
+		require.True(t, nodes[0].Node.IsSynced)
+		require.False(t, nodes[1].Node.IsSynced)
+		require.True(t, nodes[2].Node.IsSynced)
+		require.False(t, nodes[3].Node.IsSynced)
+		require.False(t, nodes[4].Node.IsSynced)
+	})
+	t.Run("nonce lag against the best node of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.LagThreshold = 3
+		args.DefaultSettings.RelativeToBestNode = true
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		nodes := []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100}),
+			createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{Nonce: 103, ProbableHighestNonce: 103}),
+			createNodeWithMetrics("address2", 0, &data.NodeStatusResponse{Nonce: 101, ProbableHighestNonce: 101}),
+			createNodeWithMetrics("address3", 1, &data.NodeStatusResponse{Nonce: 10, ProbableHighestNonce: 10}),
+		}
+		nssc.ComputeSyncState(nodes)
+
+		require.False(t, nodes[0].Node.IsSynced)
+		require.True(t, nodes[1].Node.IsSynced)
+		require.True(t, nodes[2].Node.IsSynced)
+		require.True(t, nodes[3].Node.IsSynced)
+	})
+	t.Run("round lag", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.ComparisonMethod = syncstate.RoundComparisonMethod
+		args.DefaultSettings.LagThreshold = 2
+		args.ShardsSettings = map[uint32]syncstate.ShardSettings{
+			1: {
+				CheckInterval:      time.Minute,
+				ComparisonMethod:   syncstate.RoundComparisonMethod,
+				LagThreshold:       2,
+				RelativeToBestNode: true,
+			},
+		}
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		nodes := []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{CurrentRound: 50, SynchronizedRound: 49}),
+			createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{CurrentRound: 50, SynchronizedRound: 48}),
+			createNodeWithMetrics("address2", 1, &data.NodeStatusResponse{CurrentRound: 50, SynchronizedRound: 40}),
+			createNodeWithMetrics("address3", 1, &data.NodeStatusResponse{CurrentRound: 50, SynchronizedRound: 38}),
+		}
+		nssc.ComputeSyncState(nodes)
+
+		require.True(t, nodes[0].Node.IsSynced)
+		require.False(t, nodes[1].Node.IsSynced)
+		require.True(t, nodes[2].Node.IsSynced)
+		require.False(t, nodes[3].Node.IsSynced)
+	})
+	t.Run("time since the shard passed the nonce of the node", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.ComparisonMethod = syncstate.TimeComparisonMethod
+		args.DefaultSettings.LagThreshold = 18
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		nssc.SetGetTimeFunc(func() time.Time {
+			return time.Unix(1000, 0)
+		})
+		nodes := []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100, RoundDuration: 6000}),
+			createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{Nonce: 97, ProbableHighestNonce: 100, RoundDuration: 6000}),
+			createNodeWithMetrics("address2", 0, &data.NodeStatusResponse{Nonce: 96, ProbableHighestNonce: 100, RoundDuration: 6000}),
+		}
+		nssc.ComputeSyncState(nodes)
+
+		require.True(t, nodes[0].Node.IsSynced)
+		require.True(t, nodes[1].Node.IsSynced)
+		require.False(t, nodes[2].Node.IsSynced)
+	})
+	t.Run("time lag should not count the empty rounds", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.ComparisonMethod = syncstate.TimeComparisonMethod
+		args.DefaultSettings.LagThreshold = 18
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		currentTime := time.Unix(1000, 0)
+		nssc.SetGetTimeFunc(func() time.Time {
+			return currentTime
+		})
+		createNodes := func() []*data.NodeWithMetrics {
+			return []*data.NodeWithMetrics{
+				createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100, CurrentRound: 120, SynchronizedRound: 110, RoundDuration: 6000}),
+				createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100, CurrentRound: 120, SynchronizedRound: 110, RoundDuration: 6000}),
+			}
+		}
+		nodes := createNodes()
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+		require.True(t, nodes[1].Node.IsSynced)
+
+		currentTime = currentTime.Add(time.Minute)
+		nodes = createNodes()
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+		require.True(t, nodes[1].Node.IsSynced)
+	})
+	t.Run("time lag should grow while the nonce of the node is stuck", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.ComparisonMethod = syncstate.TimeComparisonMethod
+		args.DefaultSettings.LagThreshold = 18
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		currentTime := time.Unix(1000, 0)
+		nssc.SetGetTimeFunc(func() time.Time {
+			return currentTime
+		})
+		createNodes := func(bestNonce uint64) []*data.NodeWithMetrics {
+			return []*data.NodeWithMetrics{
+				createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: bestNonce, ProbableHighestNonce: bestNonce, RoundDuration: 6000}),
+				createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: bestNonce, RoundDuration: 6000}),
+			}
+		}
+		nodes := createNodes(100)
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[1].Node.IsSynced)
+
+		currentTime = currentTime.Add(6 * time.Second)
+		nodes = createNodes(101)
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+		require.True(t, nodes[1].Node.IsSynced)
+
+		// the shard was not checked, so the latest metrics are provided again
+		currentTime = currentTime.Add(time.Minute)
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[1].Node.IsSynced)
+
+		nodes = createNodes(110)
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+		require.False(t, nodes[1].Node.IsSynced)
+	})
+	t.Run("time lag of a node catching up should not be reset by its advancing nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.ComparisonMethod = syncstate.TimeComparisonMethod
+		args.DefaultSettings.LagThreshold = 18
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		currentTime := time.Unix(1000, 0)
+		nssc.SetGetTimeFunc(func() time.Time {
+			return currentTime
+		})
+		nodes := []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 1000, ProbableHighestNonce: 1000, RoundDuration: 6000}),
+		}
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+
+		currentTime = currentTime.Add(6 * time.Second)
+		nodes = []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 998, ProbableHighestNonce: 1001, RoundDuration: 6000}),
+		}
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+
+		currentTime = currentTime.Add(30 * time.Second)
+		nodes = []*data.NodeWithMetrics{
+			createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 999, ProbableHighestNonce: 1006, RoundDuration: 6000}),
+		}
+		nssc.ComputeSyncState(nodes)
+		require.False(t, nodes[0].Node.IsSynced)
+	})
+	t.Run("time lag against the best node of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DefaultSettings.ComparisonMethod = syncstate.TimeComparisonMethod
+		args.DefaultSettings.LagThreshold = 18
+		args.DefaultSettings.RelativeToBestNode = true
+		nssc, _ := syncstate.NewNodesSyncStateChecker(args)
+		currentTime := time.Unix(1000, 0)
+		nssc.SetGetTimeFunc(func() time.Time {
+			return currentTime
+		})
+		createNodes := func() []*data.NodeWithMetrics {
+			return []*data.NodeWithMetrics{
+				createNodeWithMetrics("address0", 0, &data.NodeStatusResponse{Nonce: 103, ProbableHighestNonce: 103, RoundDuration: 6000}),
+				createNodeWithMetrics("address1", 0, &data.NodeStatusResponse{Nonce: 100, ProbableHighestNonce: 100, RoundDuration: 6000}),
+			}
+		}
+		nodes := createNodes()
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+		require.True(t, nodes[1].Node.IsSynced)
+
+		currentTime = currentTime.Add(30 * time.Second)
+		nodes = createNodes()
+		nssc.ComputeSyncState(nodes)
+		require.True(t, nodes[0].Node.IsSynced)
+		require.False(t, nodes[1].Node.IsSynced)
+	})
+}
@@ -1,10 +1,12 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/http"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -71,6 +73,10 @@ type TransactionProcessor struct {
 	newTxCostProcessor           func() (TransactionCostHandler, error)
 	mergeLogsHandler             LogsMergerHandler
 	shouldAllowEntireTxPoolFetch bool
+	responseCache                ResponseCacheHandler
+	sessionAffinity              SessionAffinityHandler
+	signatureVerifier            TransactionSignatureVerifier
+	txValidator                  TransactionValidator
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -82,6 +88,10 @@ func NewTransactionProcessor(
 	newTxCostProcessor func() (TransactionCostHandler, error),
 	logsMerger LogsMergerHandler,
 	allowEntireTxPoolFetch bool,
+	responseCache ResponseCacheHandler,
+	sessionAffinity SessionAffinityHandler,
+	signatureVerifier TransactionSignatureVerifier,
+	txValidator TransactionValidator,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -101,6 +111,18 @@ func NewTransactionProcessor(
 	if check.IfNil(logsMerger) {
 		return nil, ErrNilLogsMerger
 	}
+	if check.IfNil(responseCache) {
+		return nil, ErrNilResponseCache
+	}
+	if check.IfNil(sessionAffinity) {
+		return nil, ErrNilSessionAffinity
+	}
+	if check.IfNil(signatureVerifier) {
+		return nil, ErrNilTransactionSignatureVerifier
+	}
+	if check.IfNil(txValidator) {
+		return nil, ErrNilTransactionValidator
+	}
 
 	return &TransactionProcessor{
 		proc:                         proc,
@@ -110,16 +132,30 @@ func NewTransactionProcessor(
 		newTxCostProcessor:           newTxCostProcessor,
 		mergeLogsHandler:             logsMerger,
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
+		responseCache:                responseCache,
+		sessionAffinity:              sessionAffinity,
+		signatureVerifier:            signatureVerifier,
+		txValidator:                  txValidator,
 	}, nil
 }
 
 // SendTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tp *TransactionProcessor) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return http.StatusBadRequest, "", err
 	}
 
+	err = tp.txValidator.ValidateTransaction(ctx, tx)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
+	err = tp.checkTransactionSignature(tx)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
 		return http.StatusBadRequest, "", err
@@ -130,7 +166,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 		return http.StatusInternalServerError, "", err
 	}
 
-	observers, err := tp.proc.GetObservers(shardID)
+	observers, err := tp.proc.GetObserversForAddress(shardID, senderBuff)
 	if err != nil {
 		return http.StatusInternalServerError, "", err
 	}
@@ -138,18 +174,20 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 	for _, observer := range observers {
 		txResponse := &data.ResponseTransaction{}
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSendPath, tx, txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, TransactionSendPath, tx, txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
 				shardID,
 				txResponse.Data.TxHash,
 			))
+			tp.sessionAffinity.RecordObserver(ctx, tx.Sender, observer)
+
 			return respCode, txResponse.Data.TxHash, nil
 		}
 
-		// if observer was down (or didn't respond in time), skip to the next one
-		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout {
+		// if observer was down (or didn't respond in time, or its circuit is open), skip to the next one
+		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout || respCode == http.StatusServiceUnavailable {
 			log.LogIfError(err)
 			continue
 		}
@@ -162,12 +200,19 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 }
 
 // SimulateTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tp *TransactionProcessor) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
 	}
 
+	if checkSignature {
+		err = tp.checkTransactionSignature(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
 		return nil, err
@@ -183,7 +228,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	response, err := tp.simulateTransaction(observers, tx, checkSignature)
+	response, err := tp.simulateTransaction(ctx, observers, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
 	}
@@ -211,7 +256,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	responseFromReceiverShard, err := tp.simulateTransaction(observersForReceiverShard, tx, checkSignature)
+	responseFromReceiverShard, err := tp.simulateTransaction(ctx, observersForReceiverShard, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
 	}
@@ -230,6 +275,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 }
 
 func (tp *TransactionProcessor) simulateTransaction(
+	ctx context.Context,
 	observers []*data.NodeData,
 	tx *data.Transaction,
 	checkSignature bool,
@@ -242,7 +288,7 @@ func (tp *TransactionProcessor) simulateTransaction(
 	for _, observer := range observers {
 		txResponse := &data.ResponseTransactionSimulation{}
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, txSimulatePath, tx, txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, txSimulatePath, tx, txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction simulation sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -252,8 +298,8 @@ func (tp *TransactionProcessor) simulateTransaction(
 			return txResponse, nil
 		}
 
-		// if observer was down (or didn't respond in time), skip to the next one
-		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout {
+		// if observer was down (or didn't respond in time, or its circuit is open), skip to the next one
+		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout || respCode == http.StatusServiceUnavailable {
 			log.LogIfError(err)
 			continue
 		}
@@ -265,69 +311,183 @@ func (tp *TransactionProcessor) simulateTransaction(
 	return nil, ErrSendingRequest
 }
 
-// SendMultipleTransactions relays the post request by sending the request to the first available observer and replies back the answer
-func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction) (
+// SendMultipleTransactions relays the transactions, grouped by their senders' shards, to the first available observer
+// of each shard. The shards are handled in parallel and independently, so a shard without available observers does not
+// affect the others. The result of each transaction is reported at its index in the bulk
+func (tp *TransactionProcessor) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (
 	data.MultipleTransactionsResponseData, error,
 ) {
-	// TODO: Analyze and improve the robustness of this function. Currently, an error within `GetObservers`
-	// breaks the function and returns nothing (but an error) even if some transactions were actually sent, successfully.
-
-	totalTxsSent := uint64(0)
-	txsToSend := make([]*data.Transaction, 0)
-	for i := 0; i < len(txs); i++ {
-		currentTx := txs[i]
-		err := tp.checkTransactionFields(currentTx)
+	if len(txs) == 0 {
+		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+	}
+
+	txsResults := make([]*data.MultipleTransactionsTxResult, len(txs))
+	txsByShardID := make(map[uint32][]*data.Transaction)
+	for idx, tx := range txs {
+		tx.Index = idx
+		shardID, err := tp.checkTransactionOfBulk(ctx, tx)
 		if err != nil {
 			log.Warn("invalid tx received",
-				"sender", currentTx.Sender,
-				"receiver", currentTx.Receiver,
+				"sender", tx.Sender,
+				"receiver", tx.Receiver,
 				"error", err)
+			txsResults[idx] = &data.MultipleTransactionsTxResult{
+				Index:  idx,
+				Status: data.TxSendStatusRejectedLocally,
+				Reason: err.Error(),
+			}
 			continue
 		}
-		txsToSend = append(txsToSend, currentTx)
-	}
-	if len(txsToSend) == 0 {
-		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+
+		txsByShardID[shardID] = append(txsByShardID[shardID], tx)
 	}
 
-	txsHashes := make(map[int]string)
-	txsByShardID := tp.groupTxsByShard(txsToSend)
+	// each goroutine only sets the results found at the indexes of its own transactions
+	wg := sync.WaitGroup{}
+	wg.Add(len(txsByShardID))
 	for shardID, groupOfTxs := range txsByShardID {
-		observersInShard, err := tp.proc.GetObservers(shardID)
-		if err != nil {
-			return data.MultipleTransactionsResponseData{}, ErrMissingObserver
+		go func(shardID uint32, groupOfTxs []*data.Transaction) {
+			defer wg.Done()
+			tp.sendTransactionsToShard(ctx, shardID, groupOfTxs, txsResults)
+		}(shardID, groupOfTxs)
+	}
+	wg.Wait()
+
+	response := data.MultipleTransactionsResponseData{
+		TxsHashes:  make(map[int]string),
+		TxsResults: txsResults,
+	}
+	for _, result := range txsResults {
+		if result.Status == data.TxSendStatusAccepted {
+			response.NumOfTxs++
+			response.TxsHashes[result.Index] = result.TxHash
 		}
+	}
+
+	return response, nil
+}
 
-		for _, observer := range observersInShard {
-			txResponse := &data.ResponseMultipleTransactions{}
-			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
-			if respCode == http.StatusOK && err == nil {
-				log.Info("transactions sent",
-					"observer", observer.Address,
-					"shard ID", shardID,
-					"total processed", txResponse.Data.NumOfTxs,
-				)
-				totalTxsSent += txResponse.Data.NumOfTxs
+// checkTransactionOfBulk does the same checks as for a single transaction and returns the sender's shard
+func (tp *TransactionProcessor) checkTransactionOfBulk(ctx context.Context, tx *data.Transaction) (uint32, error) {
+	err := tp.checkTransactionFields(tx)
+	if err != nil {
+		return 0, err
+	}
 
-				for key, hash := range txResponse.Data.TxsHashes {
-					txsHashes[groupOfTxs[key].Index] = hash
-				}
+	err = tp.txValidator.ValidateTransaction(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
 
-				break
-			}
+	err = tp.checkTransactionSignature(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return 0, err
+	}
+
+	return tp.proc.ComputeShardId(senderBuff)
+}
 
+// sendTransactionsToShard sends the transactions of a shard to its first available observer and sets their results
+func (tp *TransactionProcessor) sendTransactionsToShard(
+	ctx context.Context,
+	shardID uint32,
+	txs []*data.Transaction,
+	txsResults []*data.MultipleTransactionsTxResult,
+) {
+	observers, err := tp.proc.GetObservers(shardID)
+	if err != nil {
+		log.Warn("cannot send transactions", "shard ID", shardID, "error", err)
+		setTxsResults(txs, txsResults, shardID, data.TxSendStatusNotSent, fmt.Sprintf("%s: %s", ErrMissingObserver, err))
+		return
+	}
+
+	for _, observer := range observers {
+		txResponse := &data.ResponseMultipleTransactions{}
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, MultipleTransactionsPath, txs, txResponse)
+		if respCode == http.StatusOK && err == nil {
+			log.Info("transactions sent",
+				"observer", observer.Address,
+				"shard ID", shardID,
+				"total processed", txResponse.Data.NumOfTxs,
+			)
+			tp.setAcceptedTxsResults(ctx, shardID, observer, txs, txResponse.Data.TxsHashes, txsResults)
+			return
+		}
+
+		// if observer was down (or didn't respond in time, or its circuit is open), skip to the next one
+		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout || respCode == http.StatusServiceUnavailable {
 			log.LogIfError(err)
+			continue
 		}
+
+		// if the request was bad, all the transactions were rejected
+		reason := http.StatusText(respCode)
+		if err != nil {
+			reason = err.Error()
+		}
+		setTxsResults(txs, txsResults, shardID, data.TxSendStatusRejectedByObserver, reason)
+		return
 	}
 
-	return data.MultipleTransactionsResponseData{
-		NumOfTxs:  totalTxsSent,
-		TxsHashes: txsHashes,
-	}, nil
+	setTxsResults(txs, txsResults, shardID, data.TxSendStatusNotSent, ErrSendingRequest.Error())
+}
+
+// setAcceptedTxsResults sets the results of the transactions sent to an observer which replied successfully. The
+// observer returns the hashes keyed by the indexes of the transactions it received, leaving out the ones it rejected
+func (tp *TransactionProcessor) setAcceptedTxsResults(
+	ctx context.Context,
+	shardID uint32,
+	observer *data.NodeData,
+	txs []*data.Transaction,
+	txsHashes map[int]string,
+	txsResults []*data.MultipleTransactionsTxResult,
+) {
+	for idx, tx := range txs {
+		txHash, found := txsHashes[idx]
+		if !found {
+			txsResults[tx.Index] = &data.MultipleTransactionsTxResult{
+				Index:   tx.Index,
+				Status:  data.TxSendStatusRejectedByObserver,
+				ShardID: &shardID,
+				Reason:  ErrTransactionNotAcceptedByObserver.Error(),
+			}
+			continue
+		}
+
+		txsResults[tx.Index] = &data.MultipleTransactionsTxResult{
+			Index:   tx.Index,
+			Status:  data.TxSendStatusAccepted,
+			TxHash:  txHash,
+			ShardID: &shardID,
+		}
+		tp.sessionAffinity.RecordObserver(ctx, tx.Sender, observer)
+	}
+}
+
+func setTxsResults(
+	txs []*data.Transaction,
+	txsResults []*data.MultipleTransactionsTxResult,
+	shardID uint32,
+	status data.TxSendStatus,
+	reason string,
+) {
+	for _, tx := range txs {
+		txsResults[tx.Index] = &data.MultipleTransactionsTxResult{
+			Index:   tx.Index,
+			Status:  status,
+			ShardID: &shardID,
+			Reason:  reason,
+		}
+	}
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tp *TransactionProcessor) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -338,28 +498,79 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*d
 		return nil, err
 	}
 
-	return newTxCostProcessor.ResolveCostRequest(tx)
+	return newTxCostProcessor.ResolveCostRequest(ctx, tx)
 }
 
 // GetTransaction should return a transaction from observer
-func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
+func (tp *TransactionProcessor) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	cacheKey := fmt.Sprintf("tx_%s_%v", txHash, withResults)
+	cachedTx := &transaction.ApiTransactionResult{}
+	if tp.responseCache.Get(ctx, cacheKey, cachedTx) {
+		return cachedTx, nil
+	}
+
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeFullHistoryNodes, withResults)
 	if err != nil {
 		return nil, err
 	}
 
 	tx.HyperblockNonce = tx.NotarizedAtDestinationInMetaNonce
 	tx.HyperblockHash = tx.NotarizedAtDestinationInMetaHash
+	// checking the completion fetches each of the smart contract results, so it is done only if the response is cached
+	if tp.responseCache.IsEnabled() && IsTransactionCompleted(ctx, tx, tp.getSmartContractResult) {
+		tp.responseCache.Put(cacheKey, tx)
+	}
+
 	return tx, nil
 }
 
+func (tp *TransactionProcessor) getSmartContractResult(ctx context.Context, scrHash string) (*transaction.ApiTransactionResult, error) {
+	return tp.getTxFromObservers(ctx, scrHash, requestTypeFullHistoryNodes, false)
+}
+
+// TransactionGetter fetches a transaction, or a smart contract result, by its hash
+type TransactionGetter func(ctx context.Context, txHash string) (*transaction.ApiTransactionResult, error)
+
+// IsTransactionCompleted returns true if the transaction and all its smart contract results, such as the cross shard
+// calls or the asynchronous callbacks, were executed and notarized by the metachain in their destination shards, so the
+// transaction details can no longer change. As the transaction details do not hold the status of the smart contract
+// results, each of them is fetched with the provided getter, until one is found not completed
+func IsTransactionCompleted(ctx context.Context, tx *transaction.ApiTransactionResult, scrGetter TransactionGetter) bool {
+	if !isExecutedAndNotarizedAtDestination(tx) {
+		return false
+	}
+
+	for _, scr := range tx.SmartContractResults {
+		scrDetails, err := scrGetter(ctx, scr.Hash)
+		if err != nil {
+			log.Trace("cannot get smart contract result", "hash", scr.Hash, "tx hash", tx.Hash, "error", err)
+			return false
+		}
+		if !isExecutedAndNotarizedAtDestination(scrDetails) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isExecutedAndNotarizedAtDestination(tx *transaction.ApiTransactionResult) bool {
+	isExecuted := tx.Status == transaction.TxStatusSuccess ||
+		tx.Status == transaction.TxStatusFail ||
+		tx.Status == transaction.TxStatusInvalid
+	isNotarizedAtDestination := tx.NotarizedAtDestinationInMetaNonce > 0
+
+	return isExecuted && isNotarizedAtDestination
+}
+
 // GetTransactionByHashAndSenderAddress returns a transaction
 func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
+	ctx context.Context,
 	txHash string,
 	sndAddr string,
 	withEvents bool,
 ) (*transaction.ApiTransactionResult, int, error) {
-	tx, err := tp.getTxWithSenderAddr(txHash, sndAddr, withEvents)
+	tx, err := tp.getTxWithSenderAddr(ctx, txHash, sndAddr, withEvents)
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
@@ -387,9 +598,9 @@ func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error
 }
 
 // GetTransactionStatus returns the status of a transaction
-func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tp *TransactionProcessor) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
 	if sender != "" {
-		tx, err := tp.getTxWithSenderAddr(txHash, sender, false)
+		tx, err := tp.getTxWithSenderAddr(ctx, txHash, sender, false)
 		if err != nil {
 			return UnknownStatusTx, err
 		}
@@ -398,7 +609,7 @@ func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender strin
 	}
 
 	// get status of transaction from random observers
-	tx, err := tp.getTxFromObservers(txHash, requestTypeObservers, false)
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeObservers, false)
 	if err != nil {
 		return UnknownStatusTx, errors.ErrTransactionNotFound
 	}
@@ -406,7 +617,7 @@ func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender strin
 	return string(tx.Status), nil
 }
 
-func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxFromObservers(ctx context.Context, txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
 	observersShardIDs := tp.proc.GetShardIDs()
 	for _, observerShardID := range observersShardIDs {
 		nodesInShard, err := tp.getNodesInShard(observerShardID, reqType)
@@ -418,7 +629,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		var withHttpError bool
 		var ok bool
 		for _, observerInShard := range nodesInShard {
-			getTxResponse, ok, withHttpError = tp.getTxFromObserver(observerInShard, txHash, withResults)
+			getTxResponse, ok, withHttpError = tp.getTxFromObserver(ctx, observerInShard, txHash, withResults)
 			if !withHttpError {
 				break
 			}
@@ -451,11 +662,11 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		if observerIsInDestShard {
 			// need to get transaction from source shard and merge scResults
 			// if withEvents is true
-			return tp.alterTxWithScResultsFromSourceIfNeeded(txHash, &getTxResponse.Data.Transaction, withResults), nil
+			return tp.alterTxWithScResultsFromSourceIfNeeded(ctx, txHash, &getTxResponse.Data.Transaction, withResults), nil
 		}
 
 		// get transaction from observer that is in destination shard
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 			return alteredTxFromDest, nil
@@ -469,7 +680,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 	return nil, errors.ErrTransactionNotFound
 }
 
-func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash string, tx *transaction.ApiTransactionResult, withResults bool) *transaction.ApiTransactionResult {
+func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(ctx context.Context, txHash string, tx *transaction.ApiTransactionResult, withResults bool) *transaction.ApiTransactionResult {
 	if !withResults || len(tx.SmartContractResults) == 0 {
 		return tx
 	}
@@ -480,7 +691,7 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
@@ -492,14 +703,14 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	return tx
 }
 
-func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withEvents bool) (*transaction.ApiTransactionResult, error) {
-	observers, sndShardID, err := tp.getShardObserversForSender(sender, requestTypeFullHistoryNodes)
+func (tp *TransactionProcessor) getTxWithSenderAddr(ctx context.Context, txHash, sender string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+	observers, sndShardID, err := tp.getShardObserversForSender(ctx, sender, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withEvents)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withEvents)
 		if !ok {
 			continue
 		}
@@ -516,7 +727,7 @@ func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withE
 			return &getTxResponse.Data.Transaction, nil
 		}
 
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withEvents)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withEvents)
 		if ok {
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withEvents)
 			return alteredTxFromDest, nil
@@ -568,6 +779,7 @@ func (tp *TransactionProcessor) getScResultsUnion(scResults []*transaction.ApiSm
 }
 
 func (tp *TransactionProcessor) getTxFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	txHash string,
 	withResults bool,
@@ -578,7 +790,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 		apiPath += withResultsParam
 	}
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, getTxResponse)
 	if err != nil {
 		log.Trace("cannot get transaction", "address", observer.Address, "error", err)
 
@@ -596,7 +808,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 	return getTxResponse, true, false
 }
 
-func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
+func (tp *TransactionProcessor) getTxFromDestShard(ctx context.Context, txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
 	// cross shard transaction
 	destinationShardObservers, err := tp.proc.GetObservers(dstShardID)
 	if err != nil {
@@ -610,7 +822,7 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 
 	for _, dstObserver := range destinationShardObservers {
 		getTxResponseDst := &data.GetTransactionResponse{}
-		respCode, err := tp.proc.CallGetRestEndPoint(dstObserver.Address, apiPath, getTxResponseDst)
+		respCode, err := tp.proc.CallGetRestEndPoint(ctx, dstObserver.Address, apiPath, getTxResponseDst)
 		if err != nil {
 			log.Trace("cannot get transaction", "address", dstObserver.Address, "error", err)
 			continue
@@ -626,26 +838,6 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 	return nil, false
 }
 
-func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction) map[uint32][]*data.Transaction {
-	txsMap := make(map[uint32][]*data.Transaction)
-	for idx, tx := range txs {
-		senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
-		if err != nil {
-			continue
-		}
-
-		senderShardID, err := tp.proc.ComputeShardId(senderBytes)
-		if err != nil {
-			continue
-		}
-
-		tx.Index = idx
-		txsMap[senderShardID] = append(txsMap[senderShardID], tx)
-	}
-
-	return txsMap
-}
-
 func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) error {
 	_, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
@@ -688,6 +880,20 @@ func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) err
 	return nil
 }
 
+// checkTransactionSignature verifies the signature locally, so the transactions with invalid signatures are not relayed
+// to the observers. The transaction fields should be checked beforehand
+func (tp *TransactionProcessor) checkTransactionSignature(tx *data.Transaction) error {
+	err := tp.signatureVerifier.VerifySignature(tx)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignature.Error(),
+			Reason:  err.Error(),
+		}
+	}
+
+	return nil
+}
+
 // ComputeTransactionHash will compute the hash of a given transaction
 // TODO move to node
 func (tp *TransactionProcessor) ComputeTransactionHash(tx *data.Transaction) (string, error) {
@@ -745,12 +951,12 @@ func (tp *TransactionProcessor) getNodesInShard(shardID uint32, reqType requestT
 }
 
 // GetTransactionsPool should return all transactions from all shards pool
-func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPool(fields)
+	txPool, err := tp.getTxPool(ctx, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -759,12 +965,12 @@ func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.Transa
 }
 
 // GetTransactionsPoolForShard should return transactions pool from one observer from shard
-func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPoolForShard(shardID, fields)
+	txPool, err := tp.getTxPoolForShard(ctx, shardID, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -773,8 +979,8 @@ func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fiel
 }
 
 // GetTransactionsPoolForSender should return transactions for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	txPool, err := tp.getTxPoolForSender(sender, fields)
+func (tp *TransactionProcessor) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	txPool, err := tp.getTxPoolForSender(ctx, sender, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -783,30 +989,52 @@ func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields stri
 }
 
 // GetLastPoolNonceForSender should return last nonce for sender from observer's pool
-func (tp *TransactionProcessor) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return tp.getLastTxPoolNonceForSender(sender)
+func (tp *TransactionProcessor) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return tp.getLastTxPoolNonceForSender(ctx, sender)
 }
 
 // GetTransactionsPoolNonceGapsForSender should return nonce gaps for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return tp.getTxPoolNonceGapsForSender(sender)
+func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return tp.getTxPoolNonceGapsForSender(ctx, sender)
 }
 
-func (tp *TransactionProcessor) getShardObserversForSender(sender string, observersType requestType) ([]*data.NodeData, uint32, error) {
+func (tp *TransactionProcessor) getShardObserversForSender(
+	ctx context.Context,
+	sender string,
+	observersType requestType,
+) ([]*data.NodeData, uint32, error) {
 	sndShardID, err := tp.getShardByAddress(sender)
 	if err != nil {
 		return nil, 0, errors.ErrInvalidSenderAddress
 	}
 
-	observers, err := tp.getNodesInShard(sndShardID, observersType)
+	if observersType == requestTypeFullHistoryNodes {
+		nodes, errGet := tp.getNodesInShard(sndShardID, observersType)
+		return nodes, sndShardID, errGet
+	}
+
+	observers, err := tp.getObserversForSender(sndShardID, sender)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	return observers, sndShardID, nil
+	sortedObservers, _ := tp.sessionAffinity.SortObservers(ctx, sender, observers)
+
+	return sortedObservers, sndShardID, nil
+}
+
+// getObserversForSender returns the observers of the sender's shard, ordered for the requests made for the sender
+func (tp *TransactionProcessor) getObserversForSender(shardID uint32, sender string) ([]*data.NodeData, error) {
+	senderBuff, err := tp.pubKeyConverter.Decode(sender)
+	if err != nil {
+		// the metachain shard ID was provided instead of an address
+		return tp.proc.GetObservers(shardID)
+	}
+
+	return tp.proc.GetObserversForAddress(shardID, senderBuff)
 }
 
-func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	shardIDs := tp.proc.GetShardIDs()
 	txs := &data.TransactionsPool{
 		RegularTransactions:  make([]data.WrappedTransaction, 0),
@@ -814,7 +1042,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 		Rewards:              make([]data.WrappedTransaction, 0),
 	}
 	for _, shard := range shardIDs {
-		intraShardTxs, err := tp.getTxPoolForShard(shard, fields)
+		intraShardTxs, err := tp.getTxPoolForShard(ctx, shard, fields)
 		if err != nil {
 			continue
 		}
@@ -827,7 +1055,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 	return txs, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
 	if err != nil {
 		log.Trace("cannot get observers for shard", "shard", shardID, "error", err)
@@ -835,7 +1063,7 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 	}
 
 	for _, observer := range observers {
-		txs, ok := tp.getTxPoolFromObserver(observer, fields)
+		txs, ok := tp.getTxPoolFromObserver(ctx, observer, fields)
 		if !ok {
 			continue
 		}
@@ -848,13 +1076,14 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 }
 
 func (tp *TransactionProcessor) getTxPoolFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	fields string,
 ) (*data.TransactionsPool, bool) {
 	txsPoolResponse := &data.TransactionsPoolApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
 		log.Trace("cannot get tx pool", "address", observer.Address, "error", err)
 
@@ -872,8 +1101,8 @@ func (tp *TransactionProcessor) getTxPoolFromObserver(
 	return &txsPoolResponse.Data.Transactions, true
 }
 
-func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
+func (tp *TransactionProcessor) getTxPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	observers, _, err := tp.getShardObserversForSender(ctx, sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
 	}
@@ -883,7 +1112,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 	}
 	var ok bool
 	for _, observer := range observers {
-		txsInPool, ok = tp.getTxPoolForSenderFromObserver(observer, sender, fields)
+		txsInPool, ok = tp.getTxPoolForSenderFromObserver(ctx, observer, sender, fields)
 		if ok {
 			break
 		}
@@ -893,6 +1122,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 }
 
 func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 	fields string,
@@ -900,7 +1130,7 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	txsPoolResponse := &data.TransactionsPoolForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
 		log.Trace("cannot get tx pool for sender", "address", observer.Address, "sender", sender, "error", err)
 
@@ -918,14 +1148,14 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	return &txsPoolResponse.Data.TxPool, true
 }
 
-func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint64, error) {
-	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
+func (tp *TransactionProcessor) getLastTxPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	observers, _, err := tp.getShardObserversForSender(ctx, sender, requestTypeObservers)
 	if err != nil {
 		return 0, err
 	}
 
 	for _, observer := range observers {
-		nonce, ok := tp.getLastTxPoolNonceFromObserver(observer, sender)
+		nonce, ok := tp.getLastTxPoolNonceFromObserver(ctx, observer, sender)
 		if !ok {
 			continue
 		}
@@ -937,13 +1167,14 @@ func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint
 }
 
 func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (uint64, bool) {
 	lastNonceResponse := &data.TransactionsPoolLastNonceForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + lastNonceParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, lastNonceResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, lastNonceResponse)
 	if err != nil {
 		log.Trace("cannot get last nonce from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
@@ -961,8 +1192,8 @@ func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
 	return lastNonceResponse.Data.Nonce, true
 }
 
-func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
+func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	observers, _, err := tp.getShardObserversForSender(ctx, sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
 	}
@@ -972,7 +1203,7 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 	}
 	var ok bool
 	for _, observer := range observers {
-		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(observer, sender)
+		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(ctx, observer, sender)
 		if ok {
 			break
 		}
@@ -982,13 +1213,14 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 }
 
 func (tp *TransactionProcessor) getTxPoolNonceGapsFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (*data.TransactionsPoolNonceGaps, bool) {
 	nonceGapsResponse := &data.TransactionsPoolNonceGapsForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + nonceGapsParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, nonceGapsResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, nonceGapsResponse)
 	if err != nil {
 		log.Warn("cannot get nonce gaps from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
@@ -2,13 +2,16 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"net/http"
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -20,6 +23,8 @@ import (
 	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/affinity"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/logsevents"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
@@ -37,7 +42,7 @@ var logsMerger, _ = logsevents.NewLogsMerger(hasher, &marshal.JsonMarshalizer{})
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -46,7 +51,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -55,7 +60,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -64,7 +69,7 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
@@ -73,16 +78,52 @@ func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilLogsMerger, err)
 }
 
+func TestNewTransactionProcessor_NilResponseCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, nil, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilResponseCache, err)
+}
+
+func TestNewTransactionProcessor_NilSessionAffinityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, nil, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilSessionAffinity, err)
+}
+
+func TestNewTransactionProcessor_NilSignatureVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, nil, &mock.TransactionValidatorStub{})
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilTransactionSignatureVerifier, err)
+}
+
+func TestNewTransactionProcessor_NilTransactionValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, nil)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilTransactionValidator, err)
+}
+
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
@@ -93,8 +134,8 @@ func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender: "invalid hex number",
 	})
 
@@ -107,8 +148,8 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{})
 
 	require.Empty(t, txHash)
 	require.NotNil(t, err)
@@ -119,8 +160,8 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chainID",
 	})
 
@@ -146,8 +187,12 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chain",
 		Version: 1,
 	})
@@ -176,9 +221,13 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -214,9 +263,13 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -255,9 +308,13 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 	address := "DEADBEEF"
-	rc, resultedTxHash, err := tp.SendTransaction(&data.Transaction{
+	rc, resultedTxHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -268,8 +325,264 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 	require.Equal(t, http.StatusOK, rc)
 }
 
+func TestTransactionProcessor_SendTransactionWithSessionAffinityShouldReadFromTheAcceptingObserver(t *testing.T) {
+	t.Parallel()
+
+	sessionAffinity, _ := affinity.NewSessionAffinity(affinity.ArgsSessionAffinity{
+		WindowDuration: time.Minute,
+		IdentifyBy:     affinity.AddressIdentification,
+	})
+	addressFail := "address1"
+	addressAccepting := "address2"
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: addressFail, ShardId: 0},
+					{Address: addressAccepting, ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				if address == addressFail {
+					return http.StatusRequestTimeout, errors.New("timeout")
+				}
+
+				return http.StatusOK, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.TransactionsPoolLastNonceForSenderApiResponse)
+				if address == addressAccepting {
+					response.Data.Nonce = 7
+				}
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{},
+		sessionAffinity,
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
+	)
+
+	sender := "DEADBEEF"
+	nonce, err := tp.GetLastPoolNonceForSender(context.Background(), sender)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), nonce)
+
+	rc, _, err := tp.SendTransaction(context.Background(), &data.Transaction{
+		Sender:  sender,
+		ChainID: "chain",
+		Version: 1,
+	})
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, rc)
+
+	nonce, err = tp.GetLastPoolNonceForSender(context.Background(), sender)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), nonce)
+}
+
 // //------- SendMultipleTransactions
 
+func TestTransactionProcessor_SendTransactionInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signatureErr := errors.New("signature mismatch")
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				require.Fail(t, "the transaction should not have been sent")
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{
+			VerifySignatureCalled: func(tx *data.Transaction) error {
+				return signatureErr
+			},
+		},
+		&mock.TransactionValidatorStub{},
+	)
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
+		Receiver: "aaaaaa",
+		Sender:   hex.EncodeToString([]byte("cccccc")),
+		ChainID:  "chain",
+		Version:  1,
+	})
+
+	require.Empty(t, txHash)
+	require.Equal(t, http.StatusBadRequest, rc)
+	require.Equal(t, &apiErrors.ErrInvalidTxFields{
+		Message: apiErrors.ErrInvalidSignature.Error(),
+		Reason:  signatureErr.Error(),
+	}, err)
+}
+
+func TestTransactionProcessor_SendTransactionInvalidFieldsForTheNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validationErr := &apiErrors.ErrInvalidTxFields{
+		Message: apiErrors.ErrInvalidChainID.Error(),
+		Reason:  "chainID \"chain\", expected \"1\"",
+	}
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				require.Fail(t, "the transaction should not have been sent")
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{
+			VerifySignatureCalled: func(tx *data.Transaction) error {
+				require.Fail(t, "the signature should not have been verified")
+				return nil
+			},
+		},
+		&mock.TransactionValidatorStub{
+			ValidateTransactionCalled: func(ctx context.Context, tx *data.Transaction) error {
+				return validationErr
+			},
+		},
+	)
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
+		Receiver: "aaaaaa",
+		Sender:   hex.EncodeToString([]byte("cccccc")),
+		ChainID:  "chain",
+		Version:  1,
+	})
+
+	require.Empty(t, txHash)
+	require.Equal(t, http.StatusBadRequest, rc)
+	require.Equal(t, validationErr, err)
+}
+
+func TestTransactionProcessor_SimulateTransactionShouldVerifySignatureOnlyIfRequested(t *testing.T) {
+	t.Parallel()
+
+	numVerified := 0
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer1", ShardId: 0}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{
+			VerifySignatureCalled: func(tx *data.Transaction) error {
+				numVerified++
+				return errors.New("signature mismatch")
+			},
+		},
+		&mock.TransactionValidatorStub{},
+	)
+	tx := &data.Transaction{
+		Receiver: "aaaaaa",
+		Sender:   hex.EncodeToString([]byte("cccccc")),
+		ChainID:  "chain",
+		Version:  1,
+	}
+
+	_, err := tp.SimulateTransaction(context.Background(), tx, false)
+	require.Nil(t, err)
+	require.Equal(t, 0, numVerified)
+
+	_, err = tp.SimulateTransaction(context.Background(), tx, true)
+	require.Contains(t, err.Error(), apiErrors.ErrInvalidSignature.Error())
+	require.Equal(t, 1, numVerified)
+}
+
+func TestTransactionProcessor_SendMultipleTransactionsShouldSkipInvalidSignatures(t *testing.T) {
+	t.Parallel()
+
+	invalidSender := hex.EncodeToString([]byte("dddddd"))
+	var txsToSend []*data.Transaction
+	txsToSend = append(txsToSend, &data.Transaction{Receiver: "aaaaaa", Sender: hex.EncodeToString([]byte("cccccc")), ChainID: "chain", Version: 1})
+	txsToSend = append(txsToSend, &data.Transaction{Receiver: "bbbbbb", Sender: invalidSender, ChainID: "chain", Version: 1})
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "observer1", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				receivedTxs, ok := value.([]*data.Transaction)
+				require.True(t, ok)
+				require.Equal(t, 1, len(receivedTxs))
+				resp := response.(*data.ResponseMultipleTransactions)
+				resp.Data.NumOfTxs = uint64(len(receivedTxs))
+				resp.Data.TxsHashes = map[int]string{
+					0: "hash1",
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{
+			VerifySignatureCalled: func(tx *data.Transaction) error {
+				if tx.Sender == invalidSender {
+					return errors.New("signature mismatch")
+				}
+				return nil
+			},
+		},
+		&mock.TransactionValidatorStub{},
+	)
+
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.NumOfTxs)
+	require.Equal(t, map[int]string{0: "hash1"}, response.TxsHashes)
+}
+
 func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -306,9 +619,13 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, len(response.TxsHashes), len(txsToSend))
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
@@ -379,9 +696,13 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
 	require.Equal(t, uint32(2), atomic.LoadUint32(&numOfTimesPostEndpointWasCalled))
@@ -394,6 +715,93 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 	)
 }
 
+func TestTransactionProcessor_SendMultipleTransactionsShouldReportTheResultOfEachTransaction(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+	sndrShard2 := hex.EncodeToString([]byte("dddddd"))
+	sndrShard3 := hex.EncodeToString([]byte("eeeeee"))
+	shardIDs := map[string]uint32{sndrShard0: 0, sndrShard1: 1, sndrShard2: 2, sndrShard3: 3}
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: "not hex", ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard1, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard2, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard3, ChainID: "chain", Version: 1},
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return shardIDs[hex.EncodeToString(addressBuff)], nil
+			},
+			GetObserversCalled: func(shardID uint32) ([]*data.NodeData, error) {
+				if shardID == 2 {
+					return nil, errors.New("no observer")
+				}
+				return []*data.NodeData{
+					{Address: fmt.Sprintf("observer%d-down", shardID), ShardId: shardID},
+					{Address: fmt.Sprintf("observer%d", shardID), ShardId: shardID},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				resp := response.(*data.ResponseMultipleTransactions)
+				switch address {
+				case "observer0":
+					// the observer accepts only the second transaction of the shard
+					resp.Data.NumOfTxs = 1
+					resp.Data.TxsHashes = map[int]string{1: "hash4"}
+					return http.StatusOK, nil
+				case "observer1":
+					return http.StatusBadRequest, errors.New("bad request")
+				default:
+					return http.StatusServiceUnavailable, errors.New("observer down")
+				}
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
+	)
+
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.NumOfTxs)
+	require.Equal(t, map[int]string{4: "hash4"}, response.TxsHashes)
+	require.Equal(t, len(txsToSend), len(response.TxsResults))
+
+	expectedStatuses := []data.TxSendStatus{
+		data.TxSendStatusRejectedByObserver,
+		data.TxSendStatusRejectedLocally,
+		data.TxSendStatusRejectedByObserver,
+		data.TxSendStatusNotSent,
+		data.TxSendStatusAccepted,
+		data.TxSendStatusNotSent,
+	}
+	for idx, result := range response.TxsResults {
+		require.Equal(t, idx, result.Index)
+		require.Equal(t, expectedStatuses[idx], result.Status, "index %d", idx)
+	}
+
+	require.Nil(t, response.TxsResults[1].ShardID)
+	require.Contains(t, response.TxsResults[1].Reason, apiErrors.ErrInvalidSenderAddress.Error())
+	require.Equal(t, process.ErrTransactionNotAcceptedByObserver.Error(), response.TxsResults[0].Reason)
+	require.Equal(t, "bad request", response.TxsResults[2].Reason)
+	require.Contains(t, response.TxsResults[3].Reason, process.ErrMissingObserver.Error())
+	require.Equal(t, process.ErrSendingRequest.Error(), response.TxsResults[5].Reason)
+	require.Equal(t, "hash4", response.TxsResults[4].TxHash)
+	require.Equal(t, uint32(0), *response.TxsResults[4].ShardID)
+}
+
 func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -423,9 +831,13 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseData)
@@ -478,9 +890,13 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseDataCrossShard)
@@ -548,9 +964,13 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -603,9 +1023,13 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -671,9 +1095,13 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -740,9 +1168,13 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -762,9 +1194,13 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		marshalizer, funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "blablabla")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "blablabla")
 	assert.Error(t, err)
 	assert.Equal(t, process.UnknownStatusTx, txStatus)
 }
@@ -818,9 +1254,13 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -842,7 +1282,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -865,7 +1305,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -887,7 +1327,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -909,7 +1349,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -932,7 +1372,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -959,7 +1399,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	protoTxHash := hex.EncodeToString(protoTxHashBytes)
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -1036,13 +1476,212 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), false)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 }
 
+func TestTransactionProcessor_GetTransactionCachedShouldNotCallObservers(t *testing.T) {
+	t.Parallel()
+
+	expectedNonce := uint64(37)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{
+			GetCalled: func(key string, value interface{}) bool {
+				require.Equal(t, "tx_hash_true", key)
+				value.(*transaction.ApiTransactionResult).Nonce = expectedNonce
+				return true
+			},
+		},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
+	)
+
+	tx, err := tp.GetTransaction(context.Background(), "hash", true)
+	require.NoError(t, err)
+	require.Equal(t, expectedNonce, tx.Nonce)
+}
+
+func TestTransactionProcessor_GetTransactionShouldCacheOnlyFinalizedTransactions(t *testing.T) {
+	t.Parallel()
+
+	notarizedAtDestinationNonce := uint64(0)
+	cachedKeys := make([]string, 0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				responseGetTx := value.(*data.GetTransactionResponse)
+				responseGetTx.Data.Transaction = transaction.ApiTransactionResult{
+					Status:                            transaction.TxStatusSuccess,
+					NotarizedAtDestinationInMetaNonce: notarizedAtDestinationNonce,
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}) {
+				cachedKeys = append(cachedKeys, key)
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
+	)
+
+	_, err := tp.GetTransaction(context.Background(), "hash", false)
+	require.NoError(t, err)
+	require.Empty(t, cachedKeys)
+
+	notarizedAtDestinationNonce = 10
+	_, err = tp.GetTransaction(context.Background(), "hash", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx_hash_false"}, cachedKeys)
+}
+
+func TestTransactionProcessor_GetTransactionShouldNotCacheTransactionsWithPendingSmartContractResults(t *testing.T) {
+	t.Parallel()
+
+	scrStatus := transaction.TxStatusPending
+	cachedKeys := make([]string, 0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				responseGetTx := value.(*data.GetTransactionResponse)
+				if strings.Contains(path, "scrHash") {
+					responseGetTx.Data.Transaction = transaction.ApiTransactionResult{
+						Hash:                              "scrHash",
+						Status:                            scrStatus,
+						NotarizedAtDestinationInMetaNonce: 11,
+					}
+					return http.StatusOK, nil
+				}
+
+				responseGetTx.Data.Transaction = transaction.ApiTransactionResult{
+					Hash:                              "hash",
+					Status:                            transaction.TxStatusSuccess,
+					NotarizedAtDestinationInMetaNonce: 10,
+					SmartContractResults: []*transaction.ApiSmartContractResult{
+						{Hash: "scrHash"},
+					},
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&mock.ResponseCacheStub{
+			PutCalled: func(key string, value interface{}) {
+				cachedKeys = append(cachedKeys, key)
+			},
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
+	)
+
+	_, err := tp.GetTransaction(context.Background(), "hash", true)
+	require.NoError(t, err)
+	require.Empty(t, cachedKeys)
+
+	scrStatus = transaction.TxStatusSuccess
+	_, err = tp.GetTransaction(context.Background(), "hash", true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx_hash_true"}, cachedKeys)
+}
+
+func TestTransactionProcessor_GetTransactionWithDisabledCacheShouldNotFetchTheSmartContractResults(t *testing.T) {
+	t.Parallel()
+
+	requestedPaths := make([]string, 0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				requestedPaths = append(requestedPaths, path)
+				responseGetTx := value.(*data.GetTransactionResponse)
+				responseGetTx.Data.Transaction = transaction.ApiTransactionResult{
+					Hash:                              "hash",
+					Status:                            transaction.TxStatusSuccess,
+					NotarizedAtDestinationInMetaNonce: 10,
+					SmartContractResults: []*transaction.ApiSmartContractResult{
+						{Hash: "scrHash0"},
+						{Hash: "scrHash1"},
+					},
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		&disabled.ResponseCache{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
+	)
+
+	tx, err := tp.GetTransaction(context.Background(), "hash", true)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tx.SmartContractResults))
+	require.Equal(t, 1, len(requestedPaths))
+	require.False(t, strings.Contains(requestedPaths[0], "scrHash"))
+}
+
 func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttpError(t *testing.T) {
 	t.Parallel()
 
@@ -1086,9 +1725,13 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.True(t, secondObserverWasCalled)
 }
 
@@ -1132,9 +1775,13 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 }
 
 func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterSource(t *testing.T) {
@@ -1229,9 +1876,13 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		&mock.ResponseCacheStub{},
+		&mock.SessionAffinityStub{},
+		&mock.TransactionSignatureVerifierStub{},
+		&mock.TransactionValidatorStub{},
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), true)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), true)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 	assert.Equal(t, 3, len(tx.SmartContractResults))
@@ -1244,10 +1895,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPool, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("")
+		txs, err := tp.GetTransactionsPool(context.Background(), "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1285,10 +1936,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1398,7 +2049,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1406,7 +2057,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTxSh0, scrTxSh1},
 			Rewards:              []data.WrappedTransaction{rewardsTxSh0, rewardsTxSh1},
 		}
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1415,10 +2066,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPoolForShard, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1448,10 +2099,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1543,7 +2194,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1551,7 +2202,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTx0, scrTx1},
 			Rewards:              []data.WrappedTransaction{rewardsTx0, rewardsTx1},
 		}
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1595,18 +2246,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, uint64(0), nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.NotNil(t, nonceGaps)
 		assert.NoError(t, err)
 	})
@@ -1675,18 +2326,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, &mock.ResponseCacheStub{}, &mock.SessionAffinityStub{}, &mock.TransactionSignatureVerifierStub{}, &mock.TransactionValidatorStub{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, &providedPool, txs)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, lastNonce, nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.Nil(t, err)
 		assert.Equal(t, providedGaps, nonceGaps.Gaps)
 	})
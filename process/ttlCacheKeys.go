@@ -0,0 +1,70 @@
+package process
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+)
+
+const (
+	heartbeatsCacheKey          = "heartbeats"
+	validatorStatisticsCacheKey = "validator-statistics"
+	economicsMetricsCacheKey    = "economics-metrics"
+	networkConfigCacheKey       = "network-config"
+	enableEpochsCacheKey        = "enable-epochs"
+	ratingsConfigCacheKey       = "ratings-config"
+	gasConfigsCacheKey          = "gas-configs"
+)
+
+// thresholdCountConsecutiveFails represents the number of consecutive failed refreshes after which a value kept warm
+// by the refresh scheduler is no longer served
+const thresholdCountConsecutiveFails = 10
+
+// refreshedKeyConfig returns the caching settings of a key which is kept warm by the refresh scheduler. The value is
+// shared with the other proxy instances and newValue creates the empty value into which a shared value is decoded
+func refreshedKeyConfig(cacheValidityDuration time.Duration, newValue func() interface{}) cache.KeyConfig {
+	return cache.KeyConfig{
+		TTL:             cacheValidityDuration,
+		StaleDuration:   cacheValidityDuration * (thresholdCountConsecutiveFails - 1),
+		RefreshInterval: cacheValidityDuration,
+		NewValue:        newValue,
+	}
+}
+
+// onDemandKeyConfig returns the caching settings of a key which is only fetched when requested. A stale value is
+// served for one more validity duration while being revalidated. The value is shared with the other proxy instances
+func onDemandKeyConfig(cacheValidityDuration time.Duration, newValue func() interface{}) cache.KeyConfig {
+	return cache.KeyConfig{
+		TTL:           cacheValidityDuration,
+		StaleDuration: cacheValidityDuration,
+		NewValue:      newValue,
+	}
+}
+
+func newGenericApiResponse() interface{} {
+	return &data.GenericAPIResponse{}
+}
+
+func newHeartbeatResponse() interface{} {
+	return &data.HeartbeatResponse{}
+}
+
+func newValidatorStatisticsResponse() interface{} {
+	return &data.ValidatorStatisticsResponse{}
+}
+
+func getGenericApiResponseFromCache(ctx context.Context, cacher TTLCacheHandler, key string) (*data.GenericAPIResponse, error) {
+	value, err := cacher.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	response, ok := value.(*data.GenericAPIResponse)
+	if !ok {
+		return nil, ErrInvalidCachedValue
+	}
+
+	return response, nil
+}
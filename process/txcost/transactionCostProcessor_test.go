@@ -2,6 +2,7 @@ package txcost
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"net/http"
 	"testing"
@@ -128,7 +129,7 @@ func TestTransactionCostProcessor_RezolveCostRequestWith3LevelsOfAsyncCalls(t *t
 		Receiver: rcvTx,
 	}
 
-	res, err := newTxCostProcessor.ResolveCostRequest(tx)
+	res, err := newTxCostProcessor.ResolveCostRequest(context.Background(), tx)
 	require.Nil(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, uint64(14000), res.TxCost)
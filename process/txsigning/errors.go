@@ -0,0 +1,15 @@
+package txsigning
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidValue signals that the value of the transaction is not a valid number
+var ErrInvalidValue = errors.New("invalid transaction value")
+
+// ErrInvalidSenderPublicKey signals that the sender's address is not a valid ed25519 public key
+var ErrInvalidSenderPublicKey = errors.New("invalid sender public key")
+
+// ErrSignatureMismatch signals that the signature was not produced by the sender over the transaction's payload
+var ErrSignatureMismatch = errors.New("the signature does not match the sender and the signed payload")
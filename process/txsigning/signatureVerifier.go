@@ -0,0 +1,126 @@
+package txsigning
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	ed25519SingleSigner "github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// initialTxVersion is the version of the transactions which are always signed over the whole payload, regardless of
+// their options
+const initialTxVersion = uint32(1)
+
+// signatureVerifier checks the ed25519 signatures of the transactions the same way the nodes do, so the transactions
+// with invalid signatures are rejected before being relayed
+type signatureVerifier struct {
+	pubKeyConverter core.PubkeyConverter
+	keyGen          crypto.KeyGenerator
+	singleSigner    crypto.SingleSigner
+	txSignHasher    hashing.Hasher
+}
+
+// NewSignatureVerifier returns a new instance of signatureVerifier
+func NewSignatureVerifier(pubKeyConverter core.PubkeyConverter) (*signatureVerifier, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &signatureVerifier{
+		pubKeyConverter: pubKeyConverter,
+		keyGen:          signing.NewKeyGenerator(ed25519.NewEd25519()),
+		singleSigner:    &ed25519SingleSigner.Ed25519Signer{},
+		txSignHasher:    keccak.NewKeccak(),
+	}, nil
+}
+
+// VerifySignature verifies the signature of the transaction against its sender
+func (sv *signatureVerifier) VerifySignature(tx *data.Transaction) error {
+	senderBytes, err := sv.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return err
+	}
+
+	senderPubKey, err := sv.keyGen.PublicKeyFromByteArray(senderBytes)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSenderPublicKey, err.Error())
+	}
+
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return err
+	}
+
+	signedPayload, err := sv.computeSignedPayload(tx, senderBytes)
+	if err != nil {
+		return err
+	}
+
+	err = sv.singleSigner.Verify(senderPubKey, signedPayload, signature)
+	if err != nil {
+		return fmt.Errorf("%w (signed with hash: %v)", ErrSignatureMismatch, isSignedWithHash(tx))
+	}
+
+	return nil
+}
+
+// computeSignedPayload builds the JSON payload signed by the sender, as the nodes do: the value is normalized and the
+// addresses are encoded again from their bytes. If the transaction is signed with hash, the payload is its keccak hash
+func (sv *signatureVerifier) computeSignedPayload(tx *data.Transaction, senderBytes []byte) ([]byte, error) {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidValue, tx.Value)
+	}
+
+	receiverBytes, err := sv.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	frontendTx := &transaction.FrontendTransaction{
+		Nonce:            tx.Nonce,
+		Value:            value.String(),
+		Receiver:         sv.pubKeyConverter.Encode(receiverBytes),
+		Sender:           sv.pubKeyConverter.Encode(senderBytes),
+		SenderUsername:   tx.SenderUsername,
+		ReceiverUsername: tx.ReceiverUsername,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		ChainID:          tx.ChainID,
+		Version:          tx.Version,
+		Options:          tx.Options,
+	}
+
+	payload, err := json.Marshal(frontendTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSignedWithHash(tx) {
+		return payload, nil
+	}
+
+	return sv.txSignHasher.Compute(string(payload)), nil
+}
+
+func isSignedWithHash(tx *data.Transaction) bool {
+	return tx.Version > initialTxVersion && tx.Options&versioning.MaskSignedWithHash > 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sv *signatureVerifier) IsInterfaceNil() bool {
+	return sv == nil
+}
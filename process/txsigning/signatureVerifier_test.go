@@ -0,0 +1,174 @@
+package txsigning_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	crypto "github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/ed25519/singlesig"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/txsigning"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("txsigning_test"))
+
+// signTransaction signs the transaction the way the wallets do, relying on the nodes' serialization of the transactions
+func signTransaction(t *testing.T, privateKey crypto.PrivateKey, tx *data.Transaction) {
+	senderBytes, err := testPubKeyConverter.Decode(tx.Sender)
+	require.NoError(t, err)
+	receiverBytes, err := testPubKeyConverter.Decode(tx.Receiver)
+	require.NoError(t, err)
+	value, _ := big.NewInt(0).SetString(tx.Value, 10)
+
+	nodeTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       value,
+		RcvAddr:     receiverBytes,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     senderBytes,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Options:     tx.Options,
+	}
+	payload, err := nodeTx.GetDataForSigning(testPubKeyConverter, &marshal.JsonMarshalizer{})
+	require.NoError(t, err)
+
+	if tx.Version > 1 && tx.Options&versioning.MaskSignedWithHash > 0 {
+		payload = keccak.NewKeccak().Compute(string(payload))
+	}
+
+	signature, err := (&singlesig.Ed25519Signer{}).Sign(privateKey, payload)
+	require.NoError(t, err)
+	tx.Signature = hex.EncodeToString(signature)
+}
+
+func createSignedTransaction(t *testing.T, version uint32, options uint32) *data.Transaction {
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privateKey, publicKey := keyGen.GeneratePair()
+	publicKeyBytes, err := publicKey.ToByteArray()
+	require.NoError(t, err)
+
+	tx := &data.Transaction{
+		Nonce:    7,
+		Value:    "1000000000000000000",
+		Receiver: "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx",
+		Sender:   testPubKeyConverter.Encode(publicKeyBytes),
+		GasPrice: 1000000000,
+		GasLimit: 50000,
+		Data:     []byte("hello"),
+		ChainID:  "1",
+		Version:  version,
+		Options:  options,
+	}
+	signTransaction(t, privateKey, tx)
+
+	return tx
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil public key converter should err", func(t *testing.T) {
+		t.Parallel()
+
+		sv, err := txsigning.NewSignatureVerifier(nil)
+		require.Equal(t, txsigning.ErrNilPubKeyConverter, err)
+		require.True(t, check.IfNil(sv))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sv, err := txsigning.NewSignatureVerifier(testPubKeyConverter)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(sv))
+	})
+}
+
+func TestSignatureVerifier_VerifySignature(t *testing.T) {
+	t.Parallel()
+
+	sv, _ := txsigning.NewSignatureVerifier(testPubKeyConverter)
+
+	t.Run("valid signature should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 1, 0)
+		require.NoError(t, sv.VerifySignature(tx))
+	})
+	t.Run("valid signature over the hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 2, versioning.MaskSignedWithHash)
+		require.NoError(t, sv.VerifySignature(tx))
+	})
+	t.Run("valid signature with options but without hash signing should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 2, 2)
+		require.NoError(t, sv.VerifySignature(tx))
+	})
+	t.Run("non-normalized value should be verified as the nodes do", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 1, 0)
+		tx.Value = "0001000000000000000000"
+		require.NoError(t, sv.VerifySignature(tx))
+	})
+	t.Run("modified transaction should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 1, 0)
+		tx.GasLimit++
+		err := sv.VerifySignature(tx)
+		require.True(t, errors.Is(err, txsigning.ErrSignatureMismatch))
+	})
+	t.Run("hash signing flag set after signing should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 2, 0)
+		tx.Options = versioning.MaskSignedWithHash
+		err := sv.VerifySignature(tx)
+		require.True(t, errors.Is(err, txsigning.ErrSignatureMismatch))
+		require.Contains(t, err.Error(), "signed with hash: true")
+	})
+	t.Run("signature of another sender should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 1, 0)
+		otherTx := createSignedTransaction(t, 1, 0)
+		tx.Signature = otherTx.Signature
+		err := sv.VerifySignature(tx)
+		require.True(t, errors.Is(err, txsigning.ErrSignatureMismatch))
+	})
+	t.Run("invalid value should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 1, 0)
+		tx.Value = "1e18"
+		err := sv.VerifySignature(tx)
+		require.True(t, errors.Is(err, txsigning.ErrInvalidValue))
+	})
+	t.Run("invalid signature hex should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, 1, 0)
+		tx.Signature = "not hex"
+		require.Error(t, sv.VerifySignature(tx))
+	})
+}
@@ -0,0 +1,18 @@
+package txtracker
+
+import "errors"
+
+// ErrNilTransactionProvider signals that a nil transaction provider has been provided
+var ErrNilTransactionProvider = errors.New("nil transaction provider")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrInvalidMaxWaitTimeout signals that an invalid maximum wait timeout has been provided
+var ErrInvalidMaxWaitTimeout = errors.New("invalid maximum wait timeout")
+
+// ErrInvalidMaxTrackedTransactions signals that an invalid maximum number of tracked transactions has been provided
+var ErrInvalidMaxTrackedTransactions = errors.New("invalid maximum number of tracked transactions")
+
+// ErrTrackerClosed signals that the transactions tracker has been closed
+var ErrTrackerClosed = errors.New("the transactions tracker is closed")
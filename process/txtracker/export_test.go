@@ -0,0 +1,9 @@
+package txtracker
+
+// NumTracked -
+func (tt *transactionsTracker) NumTracked() int {
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	return len(tt.tracked)
+}
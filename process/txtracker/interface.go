@@ -0,0 +1,12 @@
+package txtracker
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+// TransactionProvider defines what a component which fetches the transactions from the observers should be able to do
+type TransactionProvider interface {
+	GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+}
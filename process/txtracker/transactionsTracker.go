@@ -0,0 +1,245 @@
+package txtracker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+)
+
+var log = logger.GetOrCreate("process/txtracker")
+
+const (
+	minPollInterval = 100 * time.Millisecond
+	requestTimeout  = 10 * time.Second
+)
+
+// ArgsTransactionsTracker holds the arguments needed for creating a new transactions tracker
+type ArgsTransactionsTracker struct {
+	TransactionProvider    TransactionProvider
+	PollInterval           time.Duration
+	MaxWaitTimeout         time.Duration
+	MaxTrackedTransactions int
+}
+
+// trackedTransaction holds the state of a transaction waited for. Its fields are protected by the tracker's mutex
+type trackedTransaction struct {
+	numWaiters  int
+	lastTx      *transaction.ApiTransactionResult
+	isCompleted bool
+	cancelPoll  func()
+	pollStopped chan struct{}
+}
+
+// transactionsTracker waits for the transactions to complete. Each transaction is polled by a single goroutine,
+// regardless of the number of clients waiting for it, which lives as long as there is at least one client waiting and
+// the transaction did not complete
+type transactionsTracker struct {
+	txProvider     TransactionProvider
+	pollInterval   time.Duration
+	maxWaitTimeout time.Duration
+	maxTracked     int
+
+	mutTracked sync.Mutex
+	tracked    map[string]*trackedTransaction
+	isClosed   bool
+}
+
+// NewTransactionsTracker returns a new instance of transactionsTracker
+func NewTransactionsTracker(args ArgsTransactionsTracker) (*transactionsTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionsTracker{
+		txProvider:     args.TransactionProvider,
+		pollInterval:   args.PollInterval,
+		maxWaitTimeout: args.MaxWaitTimeout,
+		maxTracked:     args.MaxTrackedTransactions,
+		tracked:        make(map[string]*trackedTransaction),
+	}, nil
+}
+
+func checkArgs(args ArgsTransactionsTracker) error {
+	if args.TransactionProvider == nil {
+		return ErrNilTransactionProvider
+	}
+	if args.PollInterval < minPollInterval {
+		return fmt.Errorf("%w: provided %v, minimum %v", ErrInvalidPollInterval, args.PollInterval, minPollInterval)
+	}
+	if args.MaxWaitTimeout < args.PollInterval {
+		return fmt.Errorf("%w: provided %v, it should not be lower than the poll interval", ErrInvalidMaxWaitTimeout,
+			args.MaxWaitTimeout)
+	}
+	if args.MaxTrackedTransactions <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxTrackedTransactions, args.MaxTrackedTransactions)
+	}
+
+	return nil
+}
+
+// WaitForCompletion waits until the transaction completes, the timeout elapses or the context is done, whichever comes
+// first, and returns the latest known state of the transaction. The timeout is capped to the maximum wait timeout
+func (tt *transactionsTracker) WaitForCompletion(
+	ctx context.Context,
+	txHash string,
+	options common.TransactionWaitOptions,
+) (*data.TransactionWaitResult, error) {
+	timeout := options.Timeout
+	if timeout <= 0 || timeout > tt.maxWaitTimeout {
+		timeout = tt.maxWaitTimeout
+	}
+
+	tracked, err := tt.startWaiting(txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer tt.stopWaiting(txHash, tracked)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-tracked.pollStopped:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	return tt.createResult(tracked), nil
+}
+
+func (tt *transactionsTracker) startWaiting(txHash string) (*trackedTransaction, error) {
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	if tt.isClosed {
+		return nil, ErrTrackerClosed
+	}
+
+	tracked, found := tt.tracked[txHash]
+	if found {
+		tracked.numWaiters++
+		return tracked, nil
+	}
+	if len(tt.tracked) >= tt.maxTracked {
+		return nil, apiErrors.ErrTooManyTrackedTransactions
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tracked = &trackedTransaction{
+		numWaiters:  1,
+		cancelPoll:  cancel,
+		pollStopped: make(chan struct{}),
+	}
+	tt.tracked[txHash] = tracked
+	go tt.pollLoop(ctx, txHash, tracked)
+
+	log.Debug("started tracking transaction", "hash", txHash, "num tracked", len(tt.tracked))
+
+	return tracked, nil
+}
+
+// stopWaiting stops polling the transaction once no client waits for it anymore. The completed transactions are kept
+// until then, so the clients which start waiting in the meantime get the result right away
+func (tt *transactionsTracker) stopWaiting(txHash string, tracked *trackedTransaction) {
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	tracked.numWaiters--
+	if tracked.numWaiters > 0 {
+		return
+	}
+
+	tracked.cancelPoll()
+	if tt.tracked[txHash] == tracked {
+		delete(tt.tracked, txHash)
+	}
+}
+
+func (tt *transactionsTracker) pollLoop(ctx context.Context, txHash string, tracked *trackedTransaction) {
+	defer close(tracked.pollStopped)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if tt.poll(ctx, txHash, tracked) {
+				log.Debug("tracked transaction completed", "hash", txHash)
+				return
+			}
+			timer.Reset(tt.pollInterval)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll fetches the transaction, along with its smart contract results, and returns true if it completed
+func (tt *transactionsTracker) poll(ctx context.Context, txHash string, tracked *trackedTransaction) bool {
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	tx, err := tt.txProvider.GetTransaction(requestCtx, txHash, true)
+	if err != nil {
+		log.Trace("cannot get tracked transaction", "hash", txHash, "error", err)
+		return false
+	}
+
+	isCompleted := process.IsTransactionCompleted(requestCtx, tx, tt.getSmartContractResult)
+
+	tt.mutTracked.Lock()
+	tracked.lastTx = tx
+	tracked.isCompleted = isCompleted
+	tt.mutTracked.Unlock()
+
+	return isCompleted
+}
+
+func (tt *transactionsTracker) getSmartContractResult(ctx context.Context, scrHash string) (*transaction.ApiTransactionResult, error) {
+	return tt.txProvider.GetTransaction(ctx, scrHash, false)
+}
+
+func (tt *transactionsTracker) createResult(tracked *trackedTransaction) *data.TransactionWaitResult {
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	if tracked.lastTx == nil {
+		return &data.TransactionWaitResult{
+			Status: process.UnknownStatusTx,
+		}
+	}
+
+	return &data.TransactionWaitResult{
+		Transaction: tracked.lastTx,
+		Status:      string(tracked.lastTx.Status),
+		Completed:   tracked.isCompleted,
+	}
+}
+
+// Close stops polling all the transactions. The clients still waiting get the latest known states of the transactions
+func (tt *transactionsTracker) Close() error {
+	tt.mutTracked.Lock()
+	defer tt.mutTracked.Unlock()
+
+	tt.isClosed = true
+	for _, tracked := range tt.tracked {
+		tracked.cancelPoll()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *transactionsTracker) IsInterfaceNil() bool {
+	return tt == nil
+}
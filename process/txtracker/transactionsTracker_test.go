@@ -0,0 +1,282 @@
+package txtracker_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/common"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/txtracker"
+	"github.com/stretchr/testify/require"
+)
+
+const testPollInterval = 100 * time.Millisecond
+
+func createMockArgs(txProvider txtracker.TransactionProvider) txtracker.ArgsTransactionsTracker {
+	return txtracker.ArgsTransactionsTracker{
+		TransactionProvider:    txProvider,
+		PollInterval:           testPollInterval,
+		MaxWaitTimeout:         5 * time.Second,
+		MaxTrackedTransactions: 10,
+	}
+}
+
+// createCompletingTxProvider returns a provider of a transaction which completes at the given fetch and a counter of
+// the fetches
+func createCompletingTxProvider(completedAtFetch uint32) (*mock.TransactionProviderStub, *uint32) {
+	numFetches := uint32(0)
+	return &mock.TransactionProviderStub{
+		GetTransactionCalled: func(_ context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			if !withResults {
+				return nil, errors.New("the transaction should be fetched with its results")
+			}
+
+			if atomic.AddUint32(&numFetches, 1) < completedAtFetch {
+				return &transaction.ApiTransactionResult{Hash: txHash, Status: transaction.TxStatusPending}, nil
+			}
+
+			return &transaction.ApiTransactionResult{
+				Hash:                              txHash,
+				Status:                            transaction.TxStatusSuccess,
+				NotarizedAtDestinationInMetaNonce: 7,
+			}, nil
+		},
+	}, &numFetches
+}
+
+func TestNewTransactionsTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(nil)
+		tt, err := txtracker.NewTransactionsTracker(args)
+		require.Equal(t, txtracker.ErrNilTransactionProvider, err)
+		require.True(t, check.IfNil(tt))
+	})
+	t.Run("poll interval too low should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(&mock.TransactionProviderStub{})
+		args.PollInterval = time.Millisecond
+		_, err := txtracker.NewTransactionsTracker(args)
+		require.True(t, errors.Is(err, txtracker.ErrInvalidPollInterval))
+	})
+	t.Run("max wait timeout lower than the poll interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(&mock.TransactionProviderStub{})
+		args.MaxWaitTimeout = testPollInterval / 2
+		_, err := txtracker.NewTransactionsTracker(args)
+		require.True(t, errors.Is(err, txtracker.ErrInvalidMaxWaitTimeout))
+	})
+	t.Run("invalid max tracked transactions should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(&mock.TransactionProviderStub{})
+		args.MaxTrackedTransactions = 0
+		_, err := txtracker.NewTransactionsTracker(args)
+		require.True(t, errors.Is(err, txtracker.ErrInvalidMaxTrackedTransactions))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tt, err := txtracker.NewTransactionsTracker(createMockArgs(&mock.TransactionProviderStub{}))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(tt))
+	})
+}
+
+func TestTransactionsTracker_WaitForCompletionShouldReturnTheCompletedTransaction(t *testing.T) {
+	t.Parallel()
+
+	txProvider, numFetches := createCompletingTxProvider(3)
+	tt, _ := txtracker.NewTransactionsTracker(createMockArgs(txProvider))
+
+	result, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{})
+	require.NoError(t, err)
+	require.True(t, result.Completed)
+	require.Equal(t, string(transaction.TxStatusSuccess), result.Status)
+	require.Equal(t, "hash", result.Transaction.Hash)
+	require.Equal(t, uint32(3), atomic.LoadUint32(numFetches))
+	require.Equal(t, 0, tt.NumTracked())
+}
+
+func TestTransactionsTracker_WaitForCompletionShouldWaitForTheSmartContractResults(t *testing.T) {
+	t.Parallel()
+
+	scrHash := "scrHash"
+	numScrFetches := uint32(0)
+	scrCompletedAtFetch := uint32(1000)
+	txProvider := &mock.TransactionProviderStub{
+		GetTransactionCalled: func(_ context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			if txHash != scrHash {
+				return &transaction.ApiTransactionResult{
+					Hash:                              txHash,
+					Status:                            transaction.TxStatusSuccess,
+					NotarizedAtDestinationInMetaNonce: 7,
+					SmartContractResults: []*transaction.ApiSmartContractResult{
+						{Hash: scrHash},
+					},
+				}, nil
+			}
+
+			// the asynchronous callback was not executed in the source shard yet
+			if atomic.AddUint32(&numScrFetches, 1) < atomic.LoadUint32(&scrCompletedAtFetch) {
+				return &transaction.ApiTransactionResult{Hash: scrHash, Status: transaction.TxStatusPending}, nil
+			}
+
+			return &transaction.ApiTransactionResult{
+				Hash:                              scrHash,
+				Status:                            transaction.TxStatusSuccess,
+				NotarizedAtDestinationInMetaNonce: 9,
+			}, nil
+		},
+	}
+	tt, _ := txtracker.NewTransactionsTracker(createMockArgs(txProvider))
+
+	result, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{Timeout: 250 * time.Millisecond})
+	require.NoError(t, err)
+	require.False(t, result.Completed)
+	require.Equal(t, string(transaction.TxStatusSuccess), result.Status)
+
+	atomic.StoreUint32(&numScrFetches, 0)
+	atomic.StoreUint32(&scrCompletedAtFetch, 3)
+	result, err = tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{})
+	require.NoError(t, err)
+	require.True(t, result.Completed)
+	require.GreaterOrEqual(t, atomic.LoadUint32(&numScrFetches), uint32(3))
+}
+
+func TestTransactionsTracker_WaitForCompletionShouldReturnTheLatestStateOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pending transaction", func(t *testing.T) {
+		t.Parallel()
+
+		txProvider, _ := createCompletingTxProvider(1000)
+		tt, _ := txtracker.NewTransactionsTracker(createMockArgs(txProvider))
+
+		result, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{Timeout: 250 * time.Millisecond})
+		require.NoError(t, err)
+		require.False(t, result.Completed)
+		require.Equal(t, string(transaction.TxStatusPending), result.Status)
+		require.Equal(t, "hash", result.Transaction.Hash)
+		require.Equal(t, 0, tt.NumTracked())
+	})
+	t.Run("transaction not found", func(t *testing.T) {
+		t.Parallel()
+
+		tt, _ := txtracker.NewTransactionsTracker(createMockArgs(&mock.TransactionProviderStub{
+			GetTransactionCalled: func(_ context.Context, _ string, _ bool) (*transaction.ApiTransactionResult, error) {
+				return nil, apiErrors.ErrTransactionNotFound
+			},
+		}))
+
+		result, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{Timeout: 250 * time.Millisecond})
+		require.NoError(t, err)
+		require.False(t, result.Completed)
+		require.Equal(t, process.UnknownStatusTx, result.Status)
+		require.Nil(t, result.Transaction)
+	})
+	t.Run("the context of the request is done", func(t *testing.T) {
+		t.Parallel()
+
+		txProvider, _ := createCompletingTxProvider(1000)
+		tt, _ := txtracker.NewTransactionsTracker(createMockArgs(txProvider))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+		defer cancel()
+
+		result, err := tt.WaitForCompletion(ctx, "hash", common.TransactionWaitOptions{})
+		require.NoError(t, err)
+		require.False(t, result.Completed)
+	})
+}
+
+func TestTransactionsTracker_WaitForCompletionShouldPollOncePerTransaction(t *testing.T) {
+	t.Parallel()
+
+	txProvider, numFetches := createCompletingTxProvider(3)
+	tt, _ := txtracker.NewTransactionsTracker(createMockArgs(txProvider))
+
+	numWaiters := 5
+	wg := sync.WaitGroup{}
+	wg.Add(numWaiters)
+	for i := 0; i < numWaiters; i++ {
+		go func() {
+			defer wg.Done()
+
+			result, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{})
+			require.NoError(t, err)
+			require.True(t, result.Completed)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, uint32(3), atomic.LoadUint32(numFetches))
+	require.Equal(t, 0, tt.NumTracked())
+}
+
+func TestTransactionsTracker_WaitForCompletionTooManyTrackedTransactionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProvider, _ := createCompletingTxProvider(1000)
+	args := createMockArgs(txProvider)
+	args.MaxTrackedTransactions = 1
+	tt, _ := txtracker.NewTransactionsTracker(args)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		_, _ = tt.WaitForCompletion(ctx, "hash1", common.TransactionWaitOptions{})
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		return tt.NumTracked() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := tt.WaitForCompletion(context.Background(), "hash2", common.TransactionWaitOptions{})
+	require.Equal(t, apiErrors.ErrTooManyTrackedTransactions, err)
+
+	cancel()
+	<-done
+	require.Equal(t, 0, tt.NumTracked())
+}
+
+func TestTransactionsTracker_Close(t *testing.T) {
+	t.Parallel()
+
+	txProvider, _ := createCompletingTxProvider(1000)
+	tt, _ := txtracker.NewTransactionsTracker(createMockArgs(txProvider))
+
+	done := make(chan struct{})
+	go func() {
+		result, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{})
+		require.NoError(t, err)
+		require.False(t, result.Completed)
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		return tt.NumTracked() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, tt.Close())
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "the waiting client should have been released")
+	}
+
+	_, err := tt.WaitForCompletion(context.Background(), "hash", common.TransactionWaitOptions{})
+	require.Equal(t, txtracker.ErrTrackerClosed, err)
+}
@@ -0,0 +1,6 @@
+package txvalidation
+
+import "errors"
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
@@ -0,0 +1,13 @@
+package txvalidation
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// NetworkConfigProvider defines what a component which provides the network config should be able to do
+type NetworkConfigProvider interface {
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	IsInterfaceNil() bool
+}
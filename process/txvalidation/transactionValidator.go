@@ -0,0 +1,176 @@
+package txvalidation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/txvalidation")
+
+// maxDataFieldSize is the maximum size of the data field accepted by the nodes
+const maxDataFieldSize = core.MegabyteSize
+
+// transactionValidator checks the transactions against the network config before they are relayed, the same way the
+// nodes check them, so the transactions which would be rejected anyway do not reach the observers. The network config
+// is taken from the provider's cache and only parsed again when the provider returns a new response
+type transactionValidator struct {
+	networkConfigProvider NetworkConfigProvider
+
+	mutNetworkConfig    sync.Mutex
+	lastConfigResponse  *data.GenericAPIResponse
+	lastParsedNetConfig *data.NetworkConfig
+}
+
+// NewTransactionValidator returns a new instance of transactionValidator
+func NewTransactionValidator(networkConfigProvider NetworkConfigProvider) (*transactionValidator, error) {
+	if check.IfNil(networkConfigProvider) {
+		return nil, ErrNilNetworkConfigProvider
+	}
+
+	return &transactionValidator{
+		networkConfigProvider: networkConfigProvider,
+	}, nil
+}
+
+// ValidateTransaction returns an *errors.ErrInvalidTxFields describing the first invalid field of the transaction, if
+// any. If the network config cannot be fetched, only the checks which do not depend on it are done and the rest is
+// left to the observers
+func (tv *transactionValidator) ValidateTransaction(ctx context.Context, tx *data.Transaction) error {
+	err := checkValue(tx)
+	if err != nil {
+		return err
+	}
+
+	err = checkDataField(tx)
+	if err != nil {
+		return err
+	}
+
+	networkConfig, err := tv.getNetworkConfig(ctx)
+	if err != nil {
+		log.Debug("cannot get the network config, the transaction will only be validated by the observers",
+			"error", err)
+		return nil
+	}
+
+	return checkAgainstNetworkConfig(tx, networkConfig)
+}
+
+func checkValue(tx *data.Transaction) error {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidTransactionValue.Error(),
+			Reason:  fmt.Sprintf("value %q is not a number", tx.Value),
+		}
+	}
+	if value.Sign() < 0 {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidTransactionValue.Error(),
+			Reason:  fmt.Sprintf("value %s is negative", tx.Value),
+		}
+	}
+
+	return nil
+}
+
+func checkDataField(tx *data.Transaction) error {
+	if len(tx.Data) > maxDataFieldSize {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrDataFieldTooLarge.Error(),
+			Reason:  fmt.Sprintf("data has %d bytes, maximum %d", len(tx.Data), maxDataFieldSize),
+		}
+	}
+
+	return nil
+}
+
+func checkAgainstNetworkConfig(tx *data.Transaction, networkConfig *data.NetworkConfig) error {
+	cfg := networkConfig.Config
+
+	if tx.ChainID != cfg.ChainID {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidChainID.Error(),
+			Reason:  fmt.Sprintf("chainID %q, expected %q", tx.ChainID, cfg.ChainID),
+		}
+	}
+	if tx.Version < cfg.MinTransactionVersion {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidTransactionVersion.Error(),
+			Reason:  fmt.Sprintf("version %d, minimum %d", tx.Version, cfg.MinTransactionVersion),
+		}
+	}
+	if tx.GasPrice < cfg.MinGasPrice {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInsufficientGasPrice.Error(),
+			Reason:  fmt.Sprintf("gasPrice %d, minimum %d", tx.GasPrice, cfg.MinGasPrice),
+		}
+	}
+
+	minGasLimit := cfg.MinGasLimit + uint64(len(tx.Data))*cfg.GasPerDataByte
+	if tx.GasLimit < minGasLimit {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInsufficientGasLimit.Error(),
+			Reason: fmt.Sprintf("gasLimit %d, minimum %d (%d + %d data bytes * %d)", tx.GasLimit, minGasLimit,
+				cfg.MinGasLimit, len(tx.Data), cfg.GasPerDataByte),
+		}
+	}
+
+	return nil
+}
+
+func (tv *transactionValidator) getNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	response, err := tv.networkConfigProvider.GetNetworkConfigMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tv.mutNetworkConfig.Lock()
+	defer tv.mutNetworkConfig.Unlock()
+
+	if response == tv.lastConfigResponse {
+		return tv.lastParsedNetConfig, nil
+	}
+
+	networkConfig, err := parseNetworkConfig(response)
+	if err != nil {
+		return nil, err
+	}
+
+	tv.lastConfigResponse = response
+	tv.lastParsedNetConfig = networkConfig
+
+	return networkConfig, nil
+}
+
+func parseNetworkConfig(response *data.GenericAPIResponse) (*data.NetworkConfig, error) {
+	networkConfigBytes, err := json.Marshal(&response.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig := &data.NetworkConfig{}
+	err = json.Unmarshal(networkConfigBytes, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(networkConfig.Config.ChainID) == 0 {
+		return nil, fmt.Errorf("the network config does not contain the chain ID")
+	}
+
+	return networkConfig, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tv *transactionValidator) IsInterfaceNil() bool {
+	return tv == nil
+}
@@ -0,0 +1,182 @@
+package txvalidation_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/txvalidation"
+	"github.com/stretchr/testify/require"
+)
+
+func createNetworkConfigResponse() *data.GenericAPIResponse {
+	return &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"config": map[string]interface{}{
+				"erd_chain_id":                "1",
+				"erd_min_transaction_version": 1,
+				"erd_min_gas_price":           1000000000,
+				"erd_min_gas_limit":           50000,
+				"erd_gas_per_data_byte":       1500,
+			},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+}
+
+func createNetworkConfigProvider() *mock.NetworkConfigProviderStub {
+	response := createNetworkConfigResponse()
+	return &mock.NetworkConfigProviderStub{
+		GetNetworkConfigMetricsCalled: func(_ context.Context) (*data.GenericAPIResponse, error) {
+			return response, nil
+		},
+	}
+}
+
+func createValidTransaction() *data.Transaction {
+	return &data.Transaction{
+		Nonce:    7,
+		Value:    "1000000000000000000",
+		Receiver: "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx",
+		Sender:   "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+		GasPrice: 1000000000,
+		GasLimit: 57500,
+		Data:     []byte("hello"),
+		ChainID:  "1",
+		Version:  1,
+	}
+}
+
+func requireInvalidField(t *testing.T, err error, expectedMessage error) {
+	invalidFieldsErr, ok := err.(*apiErrors.ErrInvalidTxFields)
+	require.True(t, ok, "unexpected error %v", err)
+	require.Equal(t, expectedMessage.Error(), invalidFieldsErr.Message)
+}
+
+func TestNewTransactionValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		tv, err := txvalidation.NewTransactionValidator(nil)
+		require.Equal(t, txvalidation.ErrNilNetworkConfigProvider, err)
+		require.True(t, check.IfNil(tv))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tv, err := txvalidation.NewTransactionValidator(createNetworkConfigProvider())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(tv))
+	})
+}
+
+func TestTransactionValidator_ValidateTransaction(t *testing.T) {
+	t.Parallel()
+
+	tv, _ := txvalidation.NewTransactionValidator(createNetworkConfigProvider())
+
+	t.Run("valid transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, tv.ValidateTransaction(context.Background(), createValidTransaction()))
+	})
+	t.Run("non-numeric value should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Value = "1e18"
+		requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInvalidTransactionValue)
+	})
+	t.Run("negative value should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Value = "-1"
+		requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInvalidTransactionValue)
+	})
+	t.Run("data field too large should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Data = []byte(strings.Repeat("a", 1024*1024+1))
+		tx.GasLimit = 10_000_000_000
+		requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrDataFieldTooLarge)
+	})
+	t.Run("wrong chain ID should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.ChainID = "D"
+		requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInvalidChainID)
+	})
+	t.Run("version below the minimum should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.Version = 0
+		requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInvalidTransactionVersion)
+	})
+	t.Run("gas price below the minimum should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.GasPrice = 999999999
+		requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInsufficientGasPrice)
+	})
+	t.Run("gas limit below the data based minimum should err", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createValidTransaction()
+		tx.GasLimit = 57499
+		err := tv.ValidateTransaction(context.Background(), tx)
+		requireInvalidField(t, err, apiErrors.ErrInsufficientGasLimit)
+		require.Contains(t, err.Error(), "minimum 57500")
+	})
+}
+
+func TestTransactionValidator_ValidateTransactionWithoutNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	tv, _ := txvalidation.NewTransactionValidator(&mock.NetworkConfigProviderStub{
+		GetNetworkConfigMetricsCalled: func(_ context.Context) (*data.GenericAPIResponse, error) {
+			return nil, errors.New("no observer online")
+		},
+	})
+
+	tx := createValidTransaction()
+	tx.ChainID = "D"
+	require.NoError(t, tv.ValidateTransaction(context.Background(), tx))
+
+	tx.Value = "-1"
+	requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInvalidTransactionValue)
+}
+
+func TestTransactionValidator_ValidateTransactionShouldUseTheLatestNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	firstResponse := createNetworkConfigResponse()
+	secondResponse := createNetworkConfigResponse()
+	secondResponse.Data.(map[string]interface{})["config"].(map[string]interface{})["erd_chain_id"] = "T"
+	tv, _ := txvalidation.NewTransactionValidator(&mock.NetworkConfigProviderStub{
+		GetNetworkConfigMetricsCalled: func(_ context.Context) (*data.GenericAPIResponse, error) {
+			if atomic.AddUint32(&numCalls, 1) <= 2 {
+				return firstResponse, nil
+			}
+			return secondResponse, nil
+		},
+	})
+
+	tx := createValidTransaction()
+	require.NoError(t, tv.ValidateTransaction(context.Background(), tx))
+	require.NoError(t, tv.ValidateTransaction(context.Background(), tx))
+	requireInvalidField(t, tv.ValidateTransaction(context.Background(), tx), apiErrors.ErrInvalidChainID)
+}
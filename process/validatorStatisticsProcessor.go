@@ -2,10 +2,12 @@ package process
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
@@ -14,16 +16,14 @@ const ValidatorStatisticsPath = "/validator/statistics"
 
 // ValidatorStatisticsProcessor is able to process validator statistics data requests
 type ValidatorStatisticsProcessor struct {
-	proc                  Processor
-	cacher                ValidatorStatisticsCacheHandler
-	cacheValidityDuration time.Duration
-	cancelFunc            func()
+	proc   Processor
+	cacher TTLCacheHandler
 }
 
 // NewValidatorStatisticsProcessor creates a new instance of ValidatorStatisticsProcessor
 func NewValidatorStatisticsProcessor(
 	proc Processor,
-	cacher ValidatorStatisticsCacheHandler,
+	cacher TTLCacheHandler,
 	cacheValidityDuration time.Duration,
 ) (*ValidatorStatisticsProcessor, error) {
 	if check.IfNil(proc) {
@@ -35,28 +35,59 @@ func NewValidatorStatisticsProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
-	hbp := &ValidatorStatisticsProcessor{
-		proc:                  proc,
-		cacher:                cacher,
-		cacheValidityDuration: cacheValidityDuration,
+	vsp := &ValidatorStatisticsProcessor{
+		proc:   proc,
+		cacher: cacher,
 	}
 
-	return hbp, nil
+	err := cacher.Register(validatorStatisticsCacheKey, refreshedKeyConfig(cacheValidityDuration, newValidatorStatisticsResponse), vsp.fetchValidatorStatistics)
+	if err != nil {
+		return nil, err
+	}
+
+	return vsp, nil
 }
 
-// GetValidatorStatistics will simply forward the validator statistics data from an observer
-func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
-	valStatsToReturn, err := vsp.cacher.LoadValStats()
-	if err == nil {
-		return &data.ValidatorStatisticsResponse{Statistics: valStatsToReturn}, nil
+// PrepareReload checks the validator statistics cache validity duration from the provided configs and returns the
+// function which applies it
+func (vsp *ValidatorStatisticsProcessor) PrepareReload(configs *config.ReloadableConfigs) (func(), error) {
+	cacheValidityDuration := time.Duration(configs.GeneralConfig.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
+	if cacheValidityDuration <= 0 {
+		return nil, fmt.Errorf("%w for validator statistics", ErrInvalidCacheValidityDuration)
+	}
+
+	return func() {
+		err := vsp.cacher.UpdateKeyConfig(validatorStatisticsCacheKey, refreshedKeyConfig(cacheValidityDuration, newValidatorStatisticsResponse))
+		log.LogIfError(err)
+	}, nil
+}
+
+// GetValidatorStatistics will return the validator statistics data, as cached from the observers
+func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
+	value, err := vsp.cacher.Get(ctx, validatorStatisticsCacheKey)
+	if err != nil {
+		return nil, err
+	}
+
+	valStats, ok := value.(*data.ValidatorStatisticsResponse)
+	if !ok {
+		return nil, ErrInvalidCachedValue
 	}
 
-	log.Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
+	return valStats, nil
+}
 
-	return vsp.getValidatorStatisticsFromApi()
+func (vsp *ValidatorStatisticsProcessor) fetchValidatorStatistics(ctx context.Context) (interface{}, error) {
+	valStats, err := vsp.getValidatorStatisticsFromApi(ctx)
+	if err != nil {
+		log.Warn("validator statistics: get from API", "error", err.Error())
+		return nil, err
+	}
+
+	return valStats, nil
 }
 
-func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId)
 	if errFetchObs != nil {
 		return nil, errFetchObs
@@ -65,7 +96,7 @@ func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	var valStatsResponse data.ValidatorStatisticsApiResponse
 	var err error
 	for _, observer := range observers {
-		_, err = vsp.proc.CallGetRestEndPoint(observer.Address, ValidatorStatisticsPath, &valStatsResponse)
+		_, err = vsp.proc.CallGetRestEndPoint(ctx, observer.Address, ValidatorStatisticsPath, &valStatsResponse)
 		if err == nil {
 			log.Info("validator statistics fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
@@ -75,55 +106,7 @@ func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	return nil, ErrValidatorStatisticsNotAvailable
 }
 
-// StartCacheUpdate will start the updating of the cache from the API at a given period
-func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
-	if vsp.cancelFunc != nil {
-		log.Error("ValidatorStatisticsProcessor - cache update already started")
-		return
-	}
-
-	var ctx context.Context
-	ctx, vsp.cancelFunc = context.WithCancel(context.Background())
-
-	go func(ctx context.Context) {
-		timer := time.NewTimer(vsp.cacheValidityDuration)
-		defer timer.Stop()
-
-		vsp.handleCacheUpdate()
-
-		for {
-			timer.Reset(vsp.cacheValidityDuration)
-
-			select {
-			case <-timer.C:
-				vsp.handleCacheUpdate()
-			case <-ctx.Done():
-				log.Debug("finishing ValidatorStatisticsProcessor cache update...")
-				return
-			}
-		}
-	}(ctx)
-}
-
-func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate() {
-	valStats, err := vsp.getValidatorStatisticsFromApi()
-	if err != nil {
-		log.Warn("validator statistics: get from API", "error", err.Error())
-	}
-
-	if valStats != nil {
-		err = vsp.cacher.StoreValStats(valStats.Statistics)
-		if err != nil {
-			log.Warn("validator statistics: store in cache", "error", err.Error())
-		}
-	}
-}
-
-// Close will handle the closing of the cache update go routine
-func (vsp *ValidatorStatisticsProcessor) Close() error {
-	if vsp.cancelFunc != nil {
-		vsp.cancelFunc()
-	}
-
-	return nil
+// IsInterfaceNil returns true if there is no value under the interface
+func (vsp *ValidatorStatisticsProcessor) IsInterfaceNil() bool {
+	return vsp == nil
 }
@@ -1,13 +1,14 @@
 package process_test
 
 import (
-	"sync/atomic"
+	"context"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +16,7 @@ import (
 func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.TTLCacheStub{}, time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -33,7 +34,7 @@ func TestNewValidatorStatisticsProcessor_NilCacherShouldErr(t *testing.T) {
 func TestNewValidatorStatisticsProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, -time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, -time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
@@ -42,7 +43,7 @@ func TestNewValidatorStatisticsProcessor_InvalidCacheValidityDurationShouldErr(t
 func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, time.Second)
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -51,10 +52,10 @@ func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T)
 func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.TTLCacheStub{}, time.Second)
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -76,13 +77,13 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataOkValuesShouldPa
 			return 0, nil
 		},
 	},
-		&mock.ValStatsCacherMock{},
+		&mock.TTLCacheStub{},
 		time.Second,
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
@@ -103,13 +104,13 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsNoMetaObserverShould
 			return 0, nil
 		},
 	},
-		&mock.ValStatsCacherMock{},
+		&mock.TTLCacheStub{},
 		time.Second,
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, res)
 	assert.Error(t, err)
 }
@@ -119,7 +120,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 
 	httpWasCalled := false
 	// set nil hbts response in cache
-	cacher := &mock.ValStatsCacherMock{Data: nil}
+	cacher := &mock.TTLCacheStub{}
 	hp, err := process.NewValidatorStatisticsProcessor(
 		&mock.ProcessorStub{
 			GetObserversCalled: func(_ uint32) ([]*data.NodeData, error) {
@@ -135,7 +136,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetValidatorStatistics()
+	_, err = hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -146,47 +147,36 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 	valStatsMap := map[string]*data.ValidatorApiResponse{
 		"key0": {TempRating: 50.7},
 	}
-	cacher := &mock.ValStatsCacherMock{Data: valStatsMap}
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	cacher := &mock.TTLCacheStub{
+		GetCalled: func(_ context.Context, key string) (interface{}, error) {
+			return &data.ValidatorStatisticsResponse{Statistics: valStatsMap}, nil
+		},
+	}
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(_ uint32) ([]*data.NodeData, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}, cacher, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res.Statistics, valStatsMap)
 }
-
-func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
+func TestValidatorStatisticsProcessor_ShouldRegisterRefreshedCacheKey(t *testing.T) {
 	t.Parallel()
 
-	numOfTimesHttpWasCalled := int32(0)
-	cacher := &mock.ValStatsCacherMock{}
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{
-		GetObserversCalled: func(_ uint32) ([]*data.NodeData, error) {
-			return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
+	var registeredConfig cache.KeyConfig
+	cacher := &mock.TTLCacheStub{
+		RegisterCalled: func(key string, config cache.KeyConfig, fetch cache.FetchHandler) error {
+			registeredConfig = config
+			return nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
-			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
-			return 0, nil
-		},
-	},
-		cacher,
-		25*time.Millisecond)
-
+	}
+	_, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, 25*time.Millisecond)
 	assert.Nil(t, err)
-	hp.StartCacheUpdate()
-
-	// cache will become invalid after 25 ms so check if it renews its data
-
-	// >25 => update
-	time.Sleep(30 * time.Millisecond)
-	assert.Equal(t, int32(2), atomic.LoadInt32(&numOfTimesHttpWasCalled))
-
-	// > 25 => update
-	time.Sleep(30 * time.Millisecond)
-	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
-
-	// < 25 => don't update
-	time.Sleep(5 * time.Millisecond)
-	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+	assert.Equal(t, 25*time.Millisecond, registeredConfig.TTL)
+	assert.Equal(t, 25*time.Millisecond, registeredConfig.RefreshInterval)
 }
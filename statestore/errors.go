@@ -0,0 +1,24 @@
+package statestore
+
+import "errors"
+
+// ErrInvalidStateStoreType signals that an unknown state store type has been provided
+var ErrInvalidStateStoreType = errors.New("invalid state store type")
+
+// ErrEmptyRedisAddress signals that an empty Redis address has been provided
+var ErrEmptyRedisAddress = errors.New("empty Redis address")
+
+// ErrInvalidPoolSize signals that an invalid connections pool size has been provided
+var ErrInvalidPoolSize = errors.New("invalid connections pool size")
+
+// ErrInvalidTimeout signals that an invalid timeout has been provided
+var ErrInvalidTimeout = errors.New("invalid timeout")
+
+// ErrUnexpectedReply signals that the Redis server answered with an unexpected reply
+var ErrUnexpectedReply = errors.New("unexpected Redis reply")
+
+// ErrStateStoreClosed signals that an operation was requested after the state store was closed
+var ErrStateStoreClosed = errors.New("state store is closed")
+
+// ErrInvalidStoredValue signals that the value stored under a key cannot be used for the requested operation
+var ErrInvalidStoredValue = errors.New("invalid stored value")
@@ -0,0 +1,16 @@
+package statestore
+
+import "time"
+
+// SetTimeHandler -
+func (ms *memoryStore) SetTimeHandler(handler func() time.Time) {
+	ms.getTimeHandler = handler
+}
+
+// NumEntries -
+func (ms *memoryStore) NumEntries() int {
+	ms.mutEntries.Lock()
+	defer ms.mutEntries.Unlock()
+
+	return len(ms.entries)
+}
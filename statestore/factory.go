@@ -0,0 +1,41 @@
+package statestore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+const (
+	// MemoryStoreType is the type of the state store which keeps the state in the memory of the proxy instance
+	MemoryStoreType = "memory"
+
+	// RedisStoreType is the type of the state store which keeps the state on a server speaking the Redis protocol
+	RedisStoreType = "redis"
+)
+
+// CreateStateStore creates the state store defined by the provided config. An empty type means the memory store
+func CreateStateStore(cfg config.StateStoreConfig) (StateStoreHandler, error) {
+	switch cfg.Type {
+	case MemoryStoreType, "":
+		return NewMemoryStore(), nil
+	case RedisStoreType:
+		return NewRedisStore(ArgsRedisStore{
+			Address:          cfg.Redis.Address,
+			Password:         cfg.Redis.Password,
+			Database:         cfg.Redis.Database,
+			KeyPrefix:        cfg.Redis.KeyPrefix,
+			PoolSize:         cfg.Redis.PoolSize,
+			DialTimeout:      time.Duration(cfg.Redis.DialTimeoutMs) * time.Millisecond,
+			OperationTimeout: time.Duration(cfg.Redis.OperationTimeoutMs) * time.Millisecond,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStateStoreType, cfg.Type)
+	}
+}
+
+// IsShared returns true if the state store defined by the provided config is shared between the proxy instances
+func IsShared(cfg config.StateStoreConfig) bool {
+	return cfg.Type == RedisStoreType
+}
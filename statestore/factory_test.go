@@ -0,0 +1,52 @@
+package statestore_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStateStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("memory store", func(t *testing.T) {
+		t.Parallel()
+
+		for _, storeType := range []string{"", statestore.MemoryStoreType} {
+			cfg := config.StateStoreConfig{Type: storeType}
+			store, err := statestore.CreateStateStore(cfg)
+			require.Nil(t, err)
+			require.Equal(t, "*statestore.memoryStore", fmt.Sprintf("%T", store))
+			require.False(t, statestore.IsShared(cfg))
+		}
+	})
+	t.Run("redis store", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.StateStoreConfig{
+			Type: statestore.RedisStoreType,
+			Redis: config.RedisConfig{
+				Address:            "127.0.0.1:6379",
+				PoolSize:           1,
+				DialTimeoutMs:      100,
+				OperationTimeoutMs: 100,
+			},
+		}
+		store, err := statestore.CreateStateStore(cfg)
+		require.Nil(t, err)
+		require.Equal(t, "*statestore.redisStore", fmt.Sprintf("%T", store))
+		require.True(t, statestore.IsShared(cfg))
+	})
+	t.Run("unknown type should err", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := statestore.CreateStateStore(config.StateStoreConfig{Type: "unknown"})
+		require.True(t, check.IfNil(store))
+		require.True(t, errors.Is(err, statestore.ErrInvalidStateStoreType))
+	})
+}
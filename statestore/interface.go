@@ -0,0 +1,16 @@
+package statestore
+
+import (
+	"context"
+	"time"
+)
+
+// StateStoreHandler defines the operations of a key-value store holding the state which can be shared between the
+// proxy instances (rate limiter counters and cached responses)
+type StateStoreHandler interface {
+	Increment(ctx context.Context, key string, expiration time.Duration) (uint64, error)
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, expiration time.Duration) error
+	Close() error
+	IsInterfaceNil() bool
+}
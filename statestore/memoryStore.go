@@ -0,0 +1,124 @@
+package statestore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// sweepInterval represents the minimum interval between two removals of the expired entries
+const sweepInterval = time.Second
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// memoryStore is the state store which keeps the state in the memory of the current proxy instance
+type memoryStore struct {
+	mutEntries     sync.Mutex
+	entries        map[string]*memoryEntry
+	lastSweep      time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewMemoryStore returns a new instance of memoryStore
+func NewMemoryStore() *memoryStore {
+	return &memoryStore{
+		entries:        make(map[string]*memoryEntry),
+		getTimeHandler: time.Now,
+	}
+}
+
+// Increment increments the counter stored under the provided key and returns its new value. The counter expires after
+// the provided duration passes from its last increment. As with Redis, the counter is stored in its decimal form, so
+// it can also be read with Get
+func (ms *memoryStore) Increment(_ context.Context, key string, expiration time.Duration) (uint64, error) {
+	ms.mutEntries.Lock()
+	defer ms.mutEntries.Unlock()
+
+	now := ms.getTimeHandler()
+	ms.sweepExpiredEntries(now)
+
+	counter := uint64(0)
+	entry, found := ms.entries[key]
+	if found && !entry.isExpired(now) {
+		var err error
+		counter, err = strconv.ParseUint(string(entry.value), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: value is not a counter", ErrInvalidStoredValue)
+		}
+	}
+
+	counter++
+	ms.entries[key] = &memoryEntry{
+		value:     []byte(strconv.FormatUint(counter, 10)),
+		expiresAt: now.Add(expiration),
+	}
+
+	return counter, nil
+}
+
+// Get returns the value stored under the provided key
+func (ms *memoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	ms.mutEntries.Lock()
+	defer ms.mutEntries.Unlock()
+
+	entry, found := ms.entries[key]
+	if !found || entry.isExpired(ms.getTimeHandler()) {
+		return nil, false, nil
+	}
+
+	value := make([]byte, len(entry.value))
+	copy(value, entry.value)
+
+	return value, true, nil
+}
+
+// Set stores the provided value under the provided key, for the provided duration
+func (ms *memoryStore) Set(_ context.Context, key string, value []byte, expiration time.Duration) error {
+	ms.mutEntries.Lock()
+	defer ms.mutEntries.Unlock()
+
+	now := ms.getTimeHandler()
+	ms.sweepExpiredEntries(now)
+
+	storedValue := make([]byte, len(value))
+	copy(storedValue, value)
+	ms.entries[key] = &memoryEntry{
+		value:     storedValue,
+		expiresAt: now.Add(expiration),
+	}
+
+	return nil
+}
+
+// sweepExpiredEntries removes the expired entries, at most once every sweep interval. Must be called under mutex
+func (ms *memoryStore) sweepExpiredEntries(now time.Time) {
+	if now.Sub(ms.lastSweep) < sweepInterval {
+		return
+	}
+	ms.lastSweep = now
+
+	for key, entry := range ms.entries {
+		if entry.isExpired(now) {
+			delete(ms.entries, key)
+		}
+	}
+}
+
+func (entry *memoryEntry) isExpired(now time.Time) bool {
+	return !now.Before(entry.expiresAt)
+}
+
+// Close does nothing as there are no resources to release
+func (ms *memoryStore) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ms *memoryStore) IsInterfaceNil() bool {
+	return ms == nil
+}
@@ -0,0 +1,91 @@
+package statestore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_Increment(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ms := statestore.NewMemoryStore()
+	require.False(t, check.IfNil(ms))
+	ms.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	for i := 1; i <= 3; i++ {
+		counter, err := ms.Increment(context.Background(), "key", time.Second)
+		require.Nil(t, err)
+		require.Equal(t, uint64(i), counter)
+	}
+
+	counter, _ := ms.Increment(context.Background(), "other key", time.Second)
+	require.Equal(t, uint64(1), counter)
+
+	value, found, _ := ms.Get(context.Background(), "key")
+	require.True(t, found)
+	require.Equal(t, []byte("3"), value)
+
+	_ = ms.Set(context.Background(), "not a counter", []byte("value"), time.Second)
+	_, err := ms.Increment(context.Background(), "not a counter", time.Second)
+	require.True(t, errors.Is(err, statestore.ErrInvalidStoredValue))
+
+	currentTime = currentTime.Add(time.Second)
+	counter, _ = ms.Increment(context.Background(), "key", time.Second)
+	require.Equal(t, uint64(1), counter)
+}
+
+func TestMemoryStore_SetGet(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ms := statestore.NewMemoryStore()
+	ms.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	value, found, err := ms.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.False(t, found)
+	require.Nil(t, value)
+
+	providedValue := []byte("value")
+	err = ms.Set(context.Background(), "key", providedValue, time.Minute)
+	require.Nil(t, err)
+
+	// the stored value must not be altered by the caller
+	providedValue[0] = 'V'
+	value, found, _ = ms.Get(context.Background(), "key")
+	require.True(t, found)
+	require.Equal(t, []byte("value"), value)
+
+	currentTime = currentTime.Add(time.Minute)
+	_, found, _ = ms.Get(context.Background(), "key")
+	require.False(t, found)
+}
+
+func TestMemoryStore_ExpiredEntriesShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ms := statestore.NewMemoryStore()
+	ms.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	_ = ms.Set(context.Background(), "key0", []byte("value"), time.Second)
+	_, _ = ms.Increment(context.Background(), "key1", time.Second)
+	require.Equal(t, 2, ms.NumEntries())
+
+	currentTime = currentTime.Add(2 * time.Second)
+	_, _ = ms.Increment(context.Background(), "key2", time.Second)
+	require.Equal(t, 1, ms.NumEntries())
+}
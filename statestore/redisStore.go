@@ -0,0 +1,279 @@
+package statestore
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("statestore")
+
+// ArgsRedisStore holds the arguments needed for creating a new Redis state store
+type ArgsRedisStore struct {
+	Address          string
+	Password         string
+	Database         int
+	KeyPrefix        string
+	PoolSize         int
+	DialTimeout      time.Duration
+	OperationTimeout time.Duration
+}
+
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+}
+
+// redisStore is the state store which keeps the state on a server speaking the Redis protocol, so it can be shared
+// between several proxy instances. The connections towards the server are pooled
+type redisStore struct {
+	address          string
+	password         string
+	database         int
+	keyPrefix        string
+	dialTimeout      time.Duration
+	operationTimeout time.Duration
+	pool             chan *redisConn
+	mutClosed        sync.RWMutex
+	isClosed         bool
+}
+
+// NewRedisStore returns a new instance of redisStore
+func NewRedisStore(args ArgsRedisStore) (*redisStore, error) {
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyRedisAddress
+	}
+	if args.PoolSize <= 0 {
+		return nil, ErrInvalidPoolSize
+	}
+	if args.DialTimeout <= 0 || args.OperationTimeout <= 0 {
+		return nil, ErrInvalidTimeout
+	}
+
+	return &redisStore{
+		address:          args.Address,
+		password:         args.Password,
+		database:         args.Database,
+		keyPrefix:        args.KeyPrefix,
+		dialTimeout:      args.DialTimeout,
+		operationTimeout: args.OperationTimeout,
+		pool:             make(chan *redisConn, args.PoolSize),
+	}, nil
+}
+
+// Increment increments the counter stored under the provided key and returns its new value. The counter expires after
+// the provided duration passes from its last increment
+func (rs *redisStore) Increment(ctx context.Context, key string, expiration time.Duration) (uint64, error) {
+	fullKey := []byte(rs.keyPrefix + key)
+	replies, err := rs.execute(ctx,
+		[][]byte{[]byte("INCR"), fullKey},
+		[][]byte{[]byte("PEXPIRE"), fullKey, formatMilliseconds(expiration)},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	counter, ok := replies[0].(int64)
+	if !ok || counter < 0 {
+		return 0, fmt.Errorf("%w for INCR: %v", ErrUnexpectedReply, replies[0])
+	}
+
+	return uint64(counter), nil
+}
+
+// Get returns the value stored under the provided key
+func (rs *redisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	replies, err := rs.execute(ctx, [][]byte{[]byte("GET"), []byte(rs.keyPrefix + key)})
+	if err != nil {
+		return nil, false, err
+	}
+	if replies[0] == nil {
+		return nil, false, nil
+	}
+
+	value, ok := replies[0].([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("%w for GET: %v", ErrUnexpectedReply, replies[0])
+	}
+
+	return value, true, nil
+}
+
+// Set stores the provided value under the provided key, for the provided duration
+func (rs *redisStore) Set(ctx context.Context, key string, value []byte, expiration time.Duration) error {
+	_, err := rs.execute(ctx, [][]byte{
+		[]byte("SET"), []byte(rs.keyPrefix + key), value, []byte("PX"), formatMilliseconds(expiration),
+	})
+
+	return err
+}
+
+// execute sends the provided commands, pipelined, on a pooled connection and returns their replies. An error reply
+// of any command is returned as error
+func (rs *redisStore) execute(ctx context.Context, commands ...[][]byte) ([]interface{}, error) {
+	rs.mutClosed.RLock()
+	defer rs.mutClosed.RUnlock()
+
+	if rs.isClosed {
+		return nil, ErrStateStoreClosed
+	}
+
+	conn, err := rs.getConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := rs.executeOnConnection(ctx, conn, commands)
+	if err != nil {
+		_ = conn.conn.Close()
+		return nil, err
+	}
+
+	rs.putConnection(conn)
+
+	return replies, nil
+}
+
+func (rs *redisStore) executeOnConnection(ctx context.Context, conn *redisConn, commands [][][]byte) ([]interface{}, error) {
+	err := conn.conn.SetDeadline(rs.computeDeadline(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, command := range commands {
+		err = writeCommand(conn.writer, command...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = conn.writer.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	replies := make([]interface{}, 0, len(commands))
+	for range commands {
+		reply, errRead := readReply(conn.reader)
+		if errRead != nil {
+			return nil, errRead
+		}
+		replies = append(replies, reply)
+	}
+
+	// the error replies are checked only after all the replies were read, so the connection can be reused
+	for _, reply := range replies {
+		errReply, isError := reply.(redisError)
+		if isError {
+			return nil, errReply
+		}
+	}
+
+	return replies, nil
+}
+
+func (rs *redisStore) computeDeadline(ctx context.Context) time.Time {
+	deadline := time.Now().Add(rs.operationTimeout)
+	ctxDeadline, hasDeadline := ctx.Deadline()
+	if hasDeadline && ctxDeadline.Before(deadline) {
+		return ctxDeadline
+	}
+
+	return deadline
+}
+
+func (rs *redisStore) getConnection(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-rs.pool:
+		return conn, nil
+	default:
+	}
+
+	dialer := &net.Dialer{Timeout: rs.dialTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", rs.address)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &redisConn{
+		conn:   netConn,
+		reader: bufio.NewReader(netConn),
+		writer: bufio.NewWriter(netConn),
+	}
+
+	err = rs.initConnection(ctx, conn)
+	if err != nil {
+		_ = netConn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (rs *redisStore) initConnection(ctx context.Context, conn *redisConn) error {
+	commands := make([][][]byte, 0, 2)
+	if len(rs.password) > 0 {
+		commands = append(commands, [][]byte{[]byte("AUTH"), []byte(rs.password)})
+	}
+	if rs.database != 0 {
+		commands = append(commands, [][]byte{[]byte("SELECT"), []byte(strconv.Itoa(rs.database))})
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+
+	_, err := rs.executeOnConnection(ctx, conn, commands)
+
+	return err
+}
+
+func (rs *redisStore) putConnection(conn *redisConn) {
+	select {
+	case rs.pool <- conn:
+	default:
+		_ = conn.conn.Close()
+	}
+}
+
+func formatMilliseconds(duration time.Duration) []byte {
+	milliseconds := duration.Milliseconds()
+	if milliseconds <= 0 {
+		milliseconds = 1
+	}
+
+	return []byte(strconv.FormatInt(milliseconds, 10))
+}
+
+// Close closes the pooled connections. The operations requested afterwards will fail
+func (rs *redisStore) Close() error {
+	rs.mutClosed.Lock()
+	defer rs.mutClosed.Unlock()
+
+	if rs.isClosed {
+		return nil
+	}
+	rs.isClosed = true
+
+	for {
+		select {
+		case conn := <-rs.pool:
+			err := conn.conn.Close()
+			if err != nil {
+				log.Debug("redisStore: cannot close connection", "error", err)
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *redisStore) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,208 @@
+package statestore_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/statestore"
+	proxyTesting "github.com/ElrondNetwork/elrond-proxy-go/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRedisStore(address string) statestore.ArgsRedisStore {
+	return statestore.ArgsRedisStore{
+		Address:          address,
+		KeyPrefix:        "test:",
+		PoolSize:         2,
+		DialTimeout:      time.Second,
+		OperationTimeout: time.Second,
+	}
+}
+
+func startRedisServerStandIn(t *testing.T, password string) *proxyTesting.RedisServerStandIn {
+	server, err := proxyTesting.NewRedisServerStandIn(password)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	return server
+}
+
+func TestNewRedisStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should err", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := statestore.NewRedisStore(createMockArgsRedisStore(""))
+		require.True(t, check.IfNil(rs))
+		require.Equal(t, statestore.ErrEmptyRedisAddress, err)
+	})
+	t.Run("invalid pool size should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRedisStore("127.0.0.1:6379")
+		args.PoolSize = 0
+		rs, err := statestore.NewRedisStore(args)
+		require.True(t, check.IfNil(rs))
+		require.Equal(t, statestore.ErrInvalidPoolSize, err)
+	})
+	t.Run("invalid timeout should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRedisStore("127.0.0.1:6379")
+		args.OperationTimeout = 0
+		rs, err := statestore.NewRedisStore(args)
+		require.True(t, check.IfNil(rs))
+		require.Equal(t, statestore.ErrInvalidTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := statestore.NewRedisStore(createMockArgsRedisStore("127.0.0.1:6379"))
+		require.False(t, check.IfNil(rs))
+		require.Nil(t, err)
+	})
+}
+
+func TestRedisStore_IncrementShouldBeSharedBetweenInstances(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "")
+	rs0, _ := statestore.NewRedisStore(createMockArgsRedisStore(server.Address()))
+	rs1, _ := statestore.NewRedisStore(createMockArgsRedisStore(server.Address()))
+	defer func() {
+		_ = rs0.Close()
+		_ = rs1.Close()
+	}()
+
+	counter, err := rs0.Increment(context.Background(), "key", time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), counter)
+
+	counter, err = rs1.Increment(context.Background(), "key", time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), counter)
+}
+
+func TestRedisStore_IncrementedCounterShouldExpire(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "")
+	rs, _ := statestore.NewRedisStore(createMockArgsRedisStore(server.Address()))
+	defer func() {
+		_ = rs.Close()
+	}()
+
+	_, _ = rs.Increment(context.Background(), "key", 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	counter, err := rs.Increment(context.Background(), "key", time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), counter)
+}
+
+func TestRedisStore_SetGet(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "password")
+	args := createMockArgsRedisStore(server.Address())
+	args.Password = "password"
+	args.Database = 1
+	rs, _ := statestore.NewRedisStore(args)
+	defer func() {
+		_ = rs.Close()
+	}()
+
+	value, found, err := rs.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.False(t, found)
+	require.Nil(t, value)
+
+	providedValue := []byte("a value\r\nwith separators")
+	err = rs.Set(context.Background(), "key", providedValue, time.Minute)
+	require.Nil(t, err)
+
+	value, found, err = rs.Get(context.Background(), "key")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, providedValue, value)
+}
+
+func TestRedisStore_WrongPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "password")
+	args := createMockArgsRedisStore(server.Address())
+	args.Password = "wrong password"
+	rs, _ := statestore.NewRedisStore(args)
+	defer func() {
+		_ = rs.Close()
+	}()
+
+	_, _, err := rs.Get(context.Background(), "key")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "WRONGPASS")
+}
+
+func TestRedisStore_ServerDownShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "")
+	rs, _ := statestore.NewRedisStore(createMockArgsRedisStore(server.Address()))
+	defer func() {
+		_ = rs.Close()
+	}()
+
+	err := rs.Set(context.Background(), "key", []byte("value"), time.Minute)
+	require.Nil(t, err)
+
+	_ = server.Close()
+
+	_, _, err = rs.Get(context.Background(), "key")
+	require.NotNil(t, err)
+}
+
+func TestRedisStore_ClosedStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "")
+	rs, _ := statestore.NewRedisStore(createMockArgsRedisStore(server.Address()))
+
+	_ = rs.Close()
+
+	_, err := rs.Increment(context.Background(), "key", time.Minute)
+	require.True(t, errors.Is(err, statestore.ErrStateStoreClosed))
+}
+
+func TestRedisStore_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStandIn(t, "")
+	rs, _ := statestore.NewRedisStore(createMockArgsRedisStore(server.Address()))
+	defer func() {
+		_ = rs.Close()
+	}()
+
+	numOperations := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func() {
+			defer wg.Done()
+
+			_, err := rs.Increment(context.Background(), "key", time.Minute)
+			require.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	counter, err := rs.Increment(context.Background(), "key", time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, uint64(numOperations+1), counter)
+}
@@ -0,0 +1,116 @@
+package statestore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// redisError is an error reply sent by the Redis server
+type redisError string
+
+// Error returns the message of the error reply
+func (re redisError) Error() string {
+	return string(re)
+}
+
+// writeCommand writes a command, as an array of bulk strings, in the RESP format
+func writeCommand(writer *bufio.Writer, args ...[]byte) error {
+	_, err := fmt.Fprintf(writer, "*%d\r\n", len(args))
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		_, err = fmt.Fprintf(writer, "$%d\r\n", len(arg))
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(arg)
+		if err != nil {
+			return err
+		}
+		_, err = writer.WriteString("\r\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readReply reads a RESP reply. Simple strings and bulk strings are returned as []byte, integers as int64, arrays as
+// []interface{}, null replies as nil and error replies as redisError
+func readReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := readLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("%w: empty line", ErrUnexpectedReply)
+	}
+
+	payload := string(line[1:])
+	switch line[0] {
+	case '+':
+		return []byte(payload), nil
+	case '-':
+		return redisError(payload), nil
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		length, errParse := strconv.Atoi(payload)
+		if errParse != nil {
+			return nil, errParse
+		}
+		if length < 0 {
+			return nil, nil
+		}
+
+		buff := make([]byte, length+2)
+		_, err = io.ReadFull(reader, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return buff[:length], nil
+	case '*':
+		length, errParse := strconv.Atoi(payload)
+		if errParse != nil {
+			return nil, errParse
+		}
+		if length < 0 {
+			return nil, nil
+		}
+
+		elements := make([]interface{}, 0, length)
+		for i := 0; i < length; i++ {
+			element, errRead := readReply(reader)
+			if errRead != nil {
+				return nil, errRead
+			}
+			elements = append(elements, element)
+		}
+
+		return elements, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", ErrUnexpectedReply, line[0])
+	}
+}
+
+func readLine(reader *bufio.Reader) ([]byte, error) {
+	line, err := reader.ReadSlice('\n')
+	if err != nil {
+		if errors.Is(err, bufio.ErrBufferFull) {
+			return nil, fmt.Errorf("%w: line too long", ErrUnexpectedReply)
+		}
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("%w: malformed line", ErrUnexpectedReply)
+	}
+
+	return line[:len(line)-2], nil
+}
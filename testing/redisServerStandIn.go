@@ -0,0 +1,290 @@
+package testing
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type standInEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// RedisServerStandIn is an in-process server speaking the Redis protocol, used for testing the components which keep
+// their state in Redis. It only implements the commands used by the proxy: PING, AUTH, SELECT, GET, SET (with the PX
+// option), INCR, PEXPIRE, DEL and FLUSHALL
+type RedisServerStandIn struct {
+	listener    net.Listener
+	password    string
+	mutEntries  sync.Mutex
+	entries     map[string]*standInEntry
+	numCommands uint64
+	mutConns    sync.Mutex
+	conns       map[net.Conn]struct{}
+	wg          sync.WaitGroup
+}
+
+// NewRedisServerStandIn starts a new Redis server stand-in on a random local port. If the provided password is not
+// empty, the clients must authenticate before sending other commands
+func NewRedisServerStandIn(password string) (*RedisServerStandIn, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	server := &RedisServerStandIn{
+		listener: listener,
+		password: password,
+		entries:  make(map[string]*standInEntry),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	server.wg.Add(1)
+	go server.acceptConnections()
+
+	return server, nil
+}
+
+// Address returns the host:port on which the server listens
+func (server *RedisServerStandIn) Address() string {
+	return server.listener.Addr().String()
+}
+
+// NumCommands returns the number of commands processed so far
+func (server *RedisServerStandIn) NumCommands() uint64 {
+	server.mutEntries.Lock()
+	defer server.mutEntries.Unlock()
+
+	return server.numCommands
+}
+
+func (server *RedisServerStandIn) acceptConnections() {
+	defer server.wg.Done()
+
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		server.mutConns.Lock()
+		server.conns[conn] = struct{}{}
+		server.mutConns.Unlock()
+
+		server.wg.Add(1)
+		go server.serveConnection(conn)
+	}
+}
+
+func (server *RedisServerStandIn) serveConnection(conn net.Conn) {
+	defer func() {
+		server.mutConns.Lock()
+		delete(server.conns, conn)
+		server.mutConns.Unlock()
+
+		_ = conn.Close()
+		server.wg.Done()
+	}()
+
+	reader := bufio.NewReader(conn)
+	writer := bufio.NewWriter(conn)
+	isAuthenticated := len(server.password) == 0
+	for {
+		args, err := readStandInCommand(reader)
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(args[0])
+		switch {
+		case command == "AUTH":
+			isAuthenticated = len(args) == 2 && args[1] == server.password
+			if isAuthenticated {
+				_, _ = writer.WriteString("+OK\r\n")
+			} else {
+				_, _ = writer.WriteString("-WRONGPASS invalid password\r\n")
+			}
+		case !isAuthenticated:
+			_, _ = writer.WriteString("-NOAUTH Authentication required.\r\n")
+		default:
+			_, _ = writer.WriteString(server.executeCommand(command, args[1:]))
+		}
+
+		if reader.Buffered() == 0 {
+			err = writer.Flush()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (server *RedisServerStandIn) executeCommand(command string, args []string) string {
+	server.mutEntries.Lock()
+	defer server.mutEntries.Unlock()
+
+	server.numCommands++
+	now := time.Now()
+
+	switch command {
+	case "PING":
+		return "+PONG\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	case "FLUSHALL":
+		server.entries = make(map[string]*standInEntry)
+		return "+OK\r\n"
+	case "GET":
+		if len(args) != 1 {
+			return wrongNumberOfArguments(command)
+		}
+		entry := server.getEntry(args[0], now)
+		if entry == nil {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(entry.value), entry.value)
+	case "SET":
+		if len(args) != 2 && len(args) != 4 {
+			return wrongNumberOfArguments(command)
+		}
+		entry := &standInEntry{value: []byte(args[1])}
+		if len(args) == 4 {
+			milliseconds, err := strconv.ParseInt(args[3], 10, 64)
+			if strings.ToUpper(args[2]) != "PX" || err != nil || milliseconds <= 0 {
+				return "-ERR syntax error\r\n"
+			}
+			entry.expiresAt = now.Add(time.Duration(milliseconds) * time.Millisecond)
+		}
+		server.entries[args[0]] = entry
+		return "+OK\r\n"
+	case "INCR":
+		if len(args) != 1 {
+			return wrongNumberOfArguments(command)
+		}
+		entry := server.getEntry(args[0], now)
+		if entry == nil {
+			entry = &standInEntry{value: []byte("0")}
+			server.entries[args[0]] = entry
+		}
+		counter, err := strconv.ParseInt(string(entry.value), 10, 64)
+		if err != nil {
+			return "-ERR value is not an integer or out of range\r\n"
+		}
+		counter++
+		entry.value = []byte(strconv.FormatInt(counter, 10))
+		return fmt.Sprintf(":%d\r\n", counter)
+	case "PEXPIRE":
+		if len(args) != 2 {
+			return wrongNumberOfArguments(command)
+		}
+		milliseconds, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return "-ERR value is not an integer or out of range\r\n"
+		}
+		entry := server.getEntry(args[0], now)
+		if entry == nil {
+			return ":0\r\n"
+		}
+		entry.expiresAt = now.Add(time.Duration(milliseconds) * time.Millisecond)
+		return ":1\r\n"
+	case "DEL":
+		numDeleted := 0
+		for _, key := range args {
+			if server.getEntry(key, now) != nil {
+				numDeleted++
+			}
+			delete(server.entries, key)
+		}
+		return fmt.Sprintf(":%d\r\n", numDeleted)
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", command)
+	}
+}
+
+// getEntry returns the entry stored under the provided key, if not expired. Must be called under mutex
+func (server *RedisServerStandIn) getEntry(key string, now time.Time) *standInEntry {
+	entry, found := server.entries[key]
+	if !found {
+		return nil
+	}
+	if !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt) {
+		delete(server.entries, key)
+		return nil
+	}
+
+	return entry
+}
+
+func wrongNumberOfArguments(command string) string {
+	return fmt.Sprintf("-ERR wrong number of arguments for '%s' command\r\n", strings.ToLower(command))
+}
+
+func readStandInCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := readStandInLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[0] != '*' {
+		return nil, fmt.Errorf("unexpected command line %q", line)
+	}
+
+	numArgs, err := strconv.Atoi(line[1:])
+	if err != nil || numArgs <= 0 {
+		return nil, fmt.Errorf("invalid number of arguments %q", line)
+	}
+
+	args := make([]string, 0, numArgs)
+	for i := 0; i < numArgs; i++ {
+		line, err = readStandInLine(reader)
+		if err != nil {
+			return nil, err
+		}
+		if len(line) < 2 || line[0] != '$' {
+			return nil, fmt.Errorf("unexpected argument line %q", line)
+		}
+
+		length, errParse := strconv.Atoi(line[1:])
+		if errParse != nil || length < 0 {
+			return nil, fmt.Errorf("invalid argument length %q", line)
+		}
+
+		buff := make([]byte, length+2)
+		_, err = io.ReadFull(reader, buff)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(buff[:length]))
+	}
+
+	return args, nil
+}
+
+func readStandInLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(line, "\r\n"), nil
+}
+
+// Close stops the server and closes all the client connections
+func (server *RedisServerStandIn) Close() error {
+	err := server.listener.Close()
+
+	server.mutConns.Lock()
+	for conn := range server.conns {
+		_ = conn.Close()
+	}
+	server.mutConns.Unlock()
+
+	server.wg.Wait()
+
+	return err
+}
@@ -30,6 +30,11 @@ func (acp *apiConfigParser) GetConfigForVersion(version string) (*data.ApiRoutes
 	return loadApiConfig(filePath)
 }
 
+// IsInterfaceNil returns true if there is no value under the interface
+func (acp *apiConfigParser) IsInterfaceNil() bool {
+	return acp == nil
+}
+
 func checkDirectoryPath(baseDirectory string) error {
 	file, err := core.OpenFile(baseDirectory)
 	if err != nil {
@@ -28,6 +28,9 @@ type FacadeArgs struct {
 	PubKeyConverter              core.PubkeyConverter
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
+	HyperblocksNotifier          facade.HyperblocksNotifier
+	TransactionsTracker          facade.TransactionsTracker
+	NonceManager                 facade.NonceManager
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -79,16 +82,18 @@ func addVersionV1_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistry
 		return err
 	}
 
-	apiConfig, err := apiConfigParser.GetConfigForVersion("v1_0")
+	apiConfigName := "v1_0"
+	apiConfig, err := apiConfigParser.GetConfigForVersion(apiConfigName)
 	if err != nil {
 		return err
 	}
 
 	return versionRegistry.AddVersion("v1.0",
 		&data.VersionData{
-			Facade:     v1_0Facade,
-			ApiHandler: apiHandler,
-			ApiConfig:  *apiConfig,
+			Facade:        v1_0Facade,
+			ApiHandler:    apiHandler,
+			ApiConfig:     *apiConfig,
+			ApiConfigName: apiConfigName,
 		},
 	)
 }
@@ -109,6 +114,9 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ElrondProxy
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		HyperblocksNotifier:          facadeArgs.HyperblocksNotifier,
+		TransactionsTracker:          facadeArgs.TransactionsTracker,
+		NonceManager:                 facadeArgs.NonceManager,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -167,6 +175,9 @@ func createVersionV_nextFacade(facadeArgs FacadeArgs) (data.FacadeHandler, error
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		HyperblocksNotifier:          facadeArgs.HyperblocksNotifier,
+		TransactionsTracker:          facadeArgs.TransactionsTracker,
+		NonceManager:                 facadeArgs.NonceManager,
 	}
 
 	commonFacade, err := createVersionedFacade(v_nextHandlerArgs)
@@ -205,5 +216,8 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.PubKeyConverter,
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
+		args.HyperblocksNotifier,
+		args.TransactionsTracker,
+		args.NonceManager,
 	)
 }